@@ -0,0 +1,912 @@
+// Copyright 2019 hhorai. All rights reserved.
+// Use of this source code is governed by a MIT license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+)
+
+// tOpenType is the type field of CLASS, e.g. NGAP-PROTOCOL-IES.&Value. The
+// value is kept as the encoded octets.
+const tOpenType = tClassField + 1
+
+type generator struct {
+	m          *modules
+	pkg        string
+	perPath    string
+	expand     map[string]bool
+	types      map[string]*typeAssignment
+	values     map[string]*valueAssignment
+	classes    map[string]*classAssignment
+	objectSets map[string]*objectSetAssignment
+	contained  map[string]bool // types in OCTET STRING (CONTAINING ...)
+
+	buf     bytes.Buffer
+	usesFmt bool
+}
+
+// goName converts the ASN.1 name to the exported Go name, e.g. GlobalGNB-ID
+// to GlobalGNBID and iE-Extensions to IEExtensions.
+func goName(s string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(s, "-") {
+		if part == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]))
+		b.WriteString(part[1:])
+	}
+	return b.String()
+}
+
+// constName converts the name of the value to the unexported Go name. The
+// procedure codes id-X are named procCodeX, and the other ones keep their
+// first part, e.g. idGlobalRANNodeID and maxnoofBPLMNs.
+func constName(v *valueAssignment) string {
+	parts := strings.SplitN(v.name, "-", 2)
+	prefix, rest := parts[0], ""
+	if len(parts) == 2 {
+		rest = goName(parts[1])
+	}
+	if v.typeName == "ProcedureCode" && prefix == "id" {
+		prefix = "procCode"
+	}
+	return prefix + rest
+}
+
+func newGenerator(m *modules, pkg, perPath string, expand []string) (
+	g *generator) {
+
+	g = &generator{
+		m:          m,
+		pkg:        pkg,
+		perPath:    perPath,
+		expand:     map[string]bool{},
+		types:      map[string]*typeAssignment{},
+		values:     map[string]*valueAssignment{},
+		classes:    map[string]*classAssignment{},
+		objectSets: map[string]*objectSetAssignment{},
+		contained:  map[string]bool{},
+	}
+	for _, e := range expand {
+		g.expand[e] = true
+	}
+	for _, t := range m.types {
+		g.types[t.name] = t
+	}
+	for _, v := range m.values {
+		g.values[v.name] = v
+	}
+	for _, c := range m.classes {
+		g.classes[c.name] = c
+	}
+	for _, s := range m.objectSets {
+		g.objectSets[s.name] = s
+	}
+	return
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// bound returns the Go expression of the bound.
+func (g *generator) bound(s string) string {
+	if v, ok := g.values[s]; ok {
+		return constName(v)
+	}
+	return s
+}
+
+func (g *generator) sizeArgs(t *asnType) string {
+	lb, ub := "0", "per.NoUpperBound"
+	if t.size.hasLB {
+		lb = g.bound(t.size.lb)
+	}
+	if t.size.hasUB {
+		ub = g.bound(t.size.ub)
+	}
+	return fmt.Sprintf("%s, %s, %v", lb, ub, t.size.ext)
+}
+
+// effective resolves the reference to the field of CLASS.
+func (g *generator) effective(t *asnType) (e *asnType, err error) {
+	if t.kind != tClassField {
+		e = t
+		return
+	}
+	c, ok := g.classes[t.class]
+	if ok == false {
+		err = fmt.Errorf("unknown CLASS %s", t.class)
+		return
+	}
+	for _, f := range c.fields {
+		if f.name != t.field {
+			continue
+		}
+		if f.typ == nil {
+			e = &asnType{kind: tOpenType}
+		} else {
+			e, err = g.effective(f.typ)
+		}
+		return
+	}
+	err = fmt.Errorf("unknown field %s.%s", t.class, t.field)
+	return
+}
+
+// hoist gives the name to the structured types in the components and the
+// elements, so that every structured type has its Go type and methods. It
+// also records the types of the contents of OCTET STRING.
+func (g *generator) hoist() {
+	var types []*typeAssignment
+	var walk func(name string, t *asnType)
+	walk = func(name string, t *asnType) {
+		inline := func(sub string, c *asnType) *asnType {
+			switch c.kind {
+			case tEnumerated, tSequence, tSequenceOf, tChoice:
+				walk(sub, c)
+				types = append(types, &typeAssignment{name: sub, typ: c})
+				return &asnType{kind: tReference, ref: sub}
+			}
+			return c
+		}
+		for _, c := range t.components {
+			if c.typ.containing != "" {
+				g.contained[c.typ.containing] = true
+			}
+			c.typ = inline(name+"-"+c.name, c.typ)
+		}
+		if t.elem != nil {
+			t.elem = inline(name+"-Item", t.elem)
+		}
+	}
+	for _, ta := range g.m.types {
+		if ta.typ.containing != "" {
+			g.contained[ta.typ.containing] = true
+		}
+		walk(ta.name, ta.typ)
+		types = append(types, ta)
+		g.types[ta.name] = ta
+	}
+	for _, ta := range types {
+		g.types[ta.name] = ta
+	}
+	g.m.types = types
+}
+
+// goType returns the Go type of t. The structured types have been hoisted.
+func (g *generator) goType(t *asnType) (s string, err error) {
+	t, err = g.effective(t)
+	if err != nil {
+		return
+	}
+	switch t.kind {
+	case tBoolean:
+		s = "bool"
+	case tNull:
+		s = "struct{}"
+	case tInteger:
+		s = "int"
+	case tBitString:
+		s = "per.BitString"
+	case tOctetString, tOpenType:
+		s = "[]byte"
+	case tString:
+		s = "string"
+	case tReference:
+		if _, ok := g.types[t.ref]; ok == false {
+			err = fmt.Errorf("unknown type %s", t.ref)
+			return
+		}
+		s = goName(t.ref)
+	default:
+		err = fmt.Errorf("unexpected type kind %d", t.kind)
+	}
+	return
+}
+
+// encExpr returns the expression which writes expr of t and returns error.
+// named is true if expr is the Go type defined for t, which is converted
+// to the type of the argument.
+func (g *generator) encExpr(t *asnType, expr string, named bool) (
+	s string, err error) {
+
+	t, err = g.effective(t)
+	if err != nil {
+		return
+	}
+	conv := func(typ string) string {
+		if named == true {
+			return typ + "(" + expr + ")"
+		}
+		return expr
+	}
+	switch t.kind {
+	case tBoolean:
+		s = fmt.Sprintf("w.EncBoolean(%s)", conv("bool"))
+	case tNull:
+		s = "w.EncNull()"
+	case tInteger:
+		v := t.value
+		switch {
+		case v.hasLB && v.hasUB:
+			s = fmt.Sprintf("w.EncInteger(%s, %s, %s, %v)", conv("int"),
+				g.bound(v.lb), g.bound(v.ub), v.ext)
+		case v.ext:
+			err = fmt.Errorf("extensible INTEGER without bounds " +
+				"is not supported")
+		case v.hasLB:
+			s = fmt.Sprintf("w.EncSemiConstrainedWholeNumber(%s, %s)",
+				conv("int"), g.bound(v.lb))
+		default:
+			s = fmt.Sprintf("w.EncUnconstrainedWholeNumber(%s)",
+				conv("int"))
+		}
+	case tEnumerated:
+		s = fmt.Sprintf("w.EncEnumerated(%s, 0, %d, %v)", conv("int"),
+			len(t.enums)-1, t.ext)
+	case tBitString:
+		s = fmt.Sprintf("w.EncBitString(%s.Bytes, %s.BitLength, %s)",
+			expr, expr, g.sizeArgs(t))
+	case tOctetString:
+		s = fmt.Sprintf("w.EncOctetString(%s, %s)", expr, g.sizeArgs(t))
+	case tString:
+		if t.name == "UTF8String" {
+			s = fmt.Sprintf("w.EncUTF8String(%s)", conv("string"))
+			break
+		}
+		s = fmt.Sprintf("w.Enc%s(%s, \"\", %s)", t.name, conv("string"),
+			g.sizeArgs(t))
+	case tOpenType:
+		s = fmt.Sprintf("w.EncOpenType(func(w *per.BitWriter) error {\n"+
+			"w.WriteOctets(%s)\nreturn nil\n})", expr)
+	case tReference:
+		s = expr + ".MarshalPER(w)"
+	default:
+		err = fmt.Errorf("unexpected type kind %d", t.kind)
+	}
+	return
+}
+
+// decStmt returns the statement which reads the value of t into target
+// and sets err. target is the raw Go type given by goType, or the pointer
+// to it if ptr is true.
+func (g *generator) decStmt(t *asnType, target string, ptr bool) (
+	s string, err error) {
+
+	t, err = g.effective(t)
+	if err != nil {
+		return
+	}
+	lhs := target
+	if ptr == true {
+		lhs = "*" + target
+	}
+	var stmt string
+	switch t.kind {
+	case tBoolean:
+		stmt = lhs + ", err = r.DecBoolean()"
+	case tNull:
+		stmt = "err = r.DecNull()"
+	case tInteger:
+		v := t.value
+		switch {
+		case v.hasLB && v.hasUB:
+			stmt = fmt.Sprintf("%s, err = r.DecInteger(%s, %s, %v)", lhs,
+				g.bound(v.lb), g.bound(v.ub), v.ext)
+		case v.ext:
+			err = fmt.Errorf("extensible INTEGER without bounds " +
+				"is not supported")
+		case v.hasLB:
+			stmt = fmt.Sprintf(
+				"%s, err = r.DecSemiConstrainedWholeNumber(%s)",
+				lhs, g.bound(v.lb))
+		default:
+			stmt = lhs + ", err = r.DecUnconstrainedWholeNumber()"
+		}
+	case tBitString:
+		stmt = fmt.Sprintf("%s.Bytes, %s.BitLength, err = "+
+			"r.DecBitString(%s)", target, target, g.sizeArgs(t))
+	case tOctetString:
+		stmt = fmt.Sprintf("%s, err = r.DecOctetString(%s)", lhs,
+			g.sizeArgs(t))
+	case tString:
+		if t.name == "UTF8String" {
+			stmt = lhs + ", err = r.DecUTF8String()"
+			break
+		}
+		stmt = fmt.Sprintf("%s, err = r.Dec%s(\"\", %s)", lhs, t.name,
+			g.sizeArgs(t))
+	case tOpenType:
+		stmt = fmt.Sprintf("err = r.DecOpenType("+
+			"func(r *per.BitReader) (err error) {\n"+
+			"%s, err = r.ReadOctets(r.Len() / 8)\nreturn\n})", lhs)
+	case tReference:
+		stmt = "err = " + target + ".UnmarshalPER(r)"
+	default:
+		err = fmt.Errorf("unexpected type kind %d", t.kind)
+	}
+	s = stmt
+	return
+}
+
+// check returns the statement which runs stmt, and returns err with the
+// field path on error. path is the Go expression of the name added to the
+// path, e.g. "tAC" quoted.
+func check(stmt, path string) string {
+	return fmt.Sprintf("if %s; err != nil {\n"+
+		"err = per.WithPath(err, %s)\nreturn\n}\n", stmt, path)
+}
+
+// index is the Go expression of the path of the i-th item in name, which
+// is followed by suffix.
+func (g *generator) index(name, i, suffix string) string {
+	g.usesFmt = true
+	return fmt.Sprintf("fmt.Sprintf(\"%s[%%d]%s\", %s)", name, suffix, i)
+}
+
+// generate returns the Go source of all the types and the values.
+func (g *generator) generate(sources []string) (src []byte, err error) {
+	g.hoist()
+
+	var body bytes.Buffer
+	g.genValues()
+	for _, ta := range g.m.types {
+		if err = g.genType(ta); err != nil {
+			err = fmt.Errorf("%s: %v", ta.name, err)
+			return
+		}
+	}
+	body, g.buf = g.buf, body
+
+	g.printf("// Code generated by asn1gen. DO NOT EDIT.\n")
+	g.printf("// Source: %s\n\n", strings.Join(sources, " "))
+	g.printf("package %s\n\nimport (\n", g.pkg)
+	g.printf("%q\n", g.perPath)
+	if g.usesFmt {
+		g.printf("\"fmt\"\n")
+	}
+	g.printf(")\n\n")
+	g.buf.Write(body.Bytes())
+
+	src, err = format.Source(g.buf.Bytes())
+	if err != nil {
+		err = fmt.Errorf("invalid generated code: %v", err)
+	}
+	return
+}
+
+func (g *generator) genValues() {
+	module := ""
+	for _, v := range g.m.values {
+		if v.module != module {
+			if module != "" {
+				g.printf(")\n\n")
+			}
+			module = v.module
+			g.printf("// %s\nconst (\n", module)
+		}
+		g.printf("%s = %s\n", constName(v), g.bound(v.value))
+	}
+	if module != "" {
+		g.printf(")\n\n")
+	}
+}
+
+func (g *generator) genType(ta *typeAssignment) (err error) {
+	name := goName(ta.name)
+	if ta.module != "" {
+		g.printf("// %s is %s in %s.\n", name, ta.name, ta.module)
+	} else {
+		g.printf("// %s is the type of %s.\n", name, ta.name)
+	}
+
+	t, err := g.effective(ta.typ)
+	if err != nil {
+		return
+	}
+	switch t.kind {
+	case tSequence:
+		err = g.genSequence(name, ta.name, t)
+	case tChoice:
+		err = g.genChoice(name, t)
+	case tSequenceOf:
+		err = g.genSequenceOf(name, t)
+	case tEnumerated:
+		g.genEnumerated(name, t)
+	case tReference:
+		var ref string
+		if ref, err = g.goType(t); err != nil {
+			return
+		}
+		g.printf("type %s %s\n\n", name, ref)
+		g.printf("func (v %s) MarshalPER(w *per.BitWriter) error {\n"+
+			"return %s(v).MarshalPER(w)\n}\n\n", name, ref)
+		g.printf("func (v *%s) UnmarshalPER(r *per.BitReader) error {\n"+
+			"return (*%s)(v).UnmarshalPER(r)\n}\n\n", name, ref)
+	default:
+		err = g.genPrimitive(name, t)
+	}
+	return
+}
+
+func (g *generator) genPrimitive(name string, t *asnType) (err error) {
+	typ, err := g.goType(t)
+	if err != nil {
+		return
+	}
+	enc, err := g.encExpr(t, "v", true)
+	if err != nil {
+		return
+	}
+	g.printf("type %s %s\n\n", name, typ)
+	g.printf("func (v %s) MarshalPER(w *per.BitWriter) error {\n"+
+		"return %s\n}\n\n", name, enc)
+
+	g.printf("func (v *%s) UnmarshalPER(r *per.BitReader) (err error) {\n",
+		name)
+	switch t.kind {
+	case tBoolean, tInteger, tString:
+		// the value is converted from the basic type.
+		var dec string
+		if dec, err = g.decStmt(t, "x", false); err != nil {
+			return
+		}
+		dec = strings.Replace(dec, "x, err =", "x, err :=", 1)
+		g.printf("%s\nif err != nil {\nreturn\n}\n*v = %s(x)\nreturn\n}\n\n",
+			dec, name)
+		return
+	}
+	dec, err := g.decStmt(t, "v", t.kind != tBitString)
+	if err != nil {
+		return
+	}
+	g.printf("%s\nreturn\n}\n\n", dec)
+	return
+}
+
+func (g *generator) genEnumerated(name string, t *asnType) {
+	g.printf("type %s int\n\n", name)
+	g.printf("const (\n")
+	for i, e := range append(t.enums, t.additions...) {
+		if i == 0 {
+			g.printf("%s%s %s = iota\n", name, goName(e), name)
+		} else {
+			g.printf("%s%s\n", name, goName(e))
+		}
+	}
+	g.printf(")\n\n")
+
+	g.printf("func (v %s) MarshalPER(w *per.BitWriter) error {\n"+
+		"return w.EncEnumerated(int(v), 0, %d, %v)\n}\n\n",
+		name, len(t.enums)-1, t.ext)
+	g.printf("func (v *%s) UnmarshalPER(r *per.BitReader) (err error) {\n"+
+		"x, err := r.DecEnumerated(0, %d, %v)\n"+
+		"*v = %s(x)\nreturn\n}\n\n", name, len(t.enums)-1, t.ext, name)
+}
+
+func (g *generator) genSequenceOf(name string, t *asnType) (err error) {
+	elem, err := g.goType(t.elem)
+	if err != nil {
+		return
+	}
+	enc, err := g.encExpr(t.elem, "v[i]", false)
+	if err != nil {
+		return
+	}
+	dec, err := g.decStmt(t.elem, "(*v)[i]", false)
+	if err != nil {
+		return
+	}
+
+	g.printf("type %s []%s\n\n", name, elem)
+	g.printf("func (v %s) MarshalPER(w *per.BitWriter) (err error) {\n",
+		name)
+	g.printf("if err = w.EncSequenceOf(len(v), %s); err != nil {\n"+
+		"return\n}\n", g.sizeArgs(t))
+	g.printf("for i := range v {\n%s}\nreturn\n}\n\n",
+		check("err = "+enc, g.index("", "i", "")))
+
+	g.printf("func (v *%s) UnmarshalPER(r *per.BitReader) (err error) {\n",
+		name)
+	g.printf("n, err := r.DecSequenceOf(%s)\nif err != nil {\nreturn\n}\n",
+		g.sizeArgs(t))
+	g.printf("*v = make(%s, n)\nfor i := range *v {\n%s}\nreturn\n}\n\n",
+		name, check(dec, g.index("", "i", "")))
+	return
+}
+
+func (g *generator) genChoice(name string, t *asnType) (err error) {
+	n := len(t.components)
+	var fields, count, encs, decs bytes.Buffer
+	for i, c := range t.components {
+		var typ, enc, dec string
+		if typ, err = g.goType(c.typ); err != nil {
+			return
+		}
+		field := "v." + goName(c.name)
+		e := field
+		if c.typ.kind != tReference && c.typ.kind != tBitString {
+			e = "*" + field
+		}
+		if enc, err = g.encExpr(c.typ, e, false); err != nil {
+			return
+		}
+		if dec, err = g.decStmt(c.typ, field, true); err != nil {
+			return
+		}
+
+		fmt.Fprintf(&fields, "%s *%s\n", goName(c.name), typ)
+		fmt.Fprintf(&count, "if %s != nil {\nchosen++\n}\n", field)
+		fmt.Fprintf(&encs, "case %s != nil:\n"+
+			"if err = w.EncChoice(%d, 0, %d, %v); err != nil {\n"+
+			"return\n}\n%s", field, i, n-1, t.ext,
+			check("err = "+enc, fmt.Sprintf("%q", c.name)))
+		fmt.Fprintf(&decs, "case %d:\n%s = new(%s)\n%s", i, field, typ,
+			check(dec, fmt.Sprintf("%q", c.name)))
+	}
+	if t.ext == true {
+		// the unknown alternative after the extension marker.
+		fmt.Fprintf(&decs, "default:\nerr = r.DecOpenType(nil)\n")
+	}
+
+	g.printf("type %s struct {\n%s}\n\n", name, fields.String())
+	g.printf("func (v %s) MarshalPER(w *per.BitWriter) (err error) {\n",
+		name)
+	if n > 1 {
+		// the alternatives are exclusive, as in per.Marshal.
+		g.printf("chosen := 0\n%sif chosen > 1 {\n"+
+			"err = &per.ConstraintError{Offset: w.Len(),\n"+
+			"Constraint: \"more than one alternative is chosen\"}\n"+
+			"return\n}\n", count.String())
+	}
+	g.printf("switch {\n%sdefault:\n"+
+		"err = &per.ConstraintError{Offset: w.Len(),\n"+
+		"Constraint: \"no alternative is chosen\"}\n}\n"+
+		"return\n}\n\n", encs.String())
+	g.printf("func (v *%s) UnmarshalPER(r *per.BitReader) (err error) {\n"+
+		"index, err := r.DecChoice(0, %d, %v)\n"+
+		"if err != nil {\nreturn\n}\n"+
+		"*v = %s{}\nswitch index {\n%s}\nreturn\n}\n\n",
+		name, n-1, t.ext, name, decs.String())
+	return
+}
+
+// protocolIE is an object of the object set given to the container of
+// the protocol IEs, e.g. the IEs of NGSetupRequest.
+type protocolIE struct {
+	id          string
+	criticality string
+	typ         *asnType
+	field       string
+	optional    bool
+}
+
+// objectValues returns the values in obj by the field names of the class.
+func (g *generator) objectValues(class string, obj object) (
+	values map[string]string, err error) {
+
+	c, ok := g.classes[class]
+	if ok == false {
+		err = fmt.Errorf("unknown CLASS %s", class)
+		return
+	}
+	values = map[string]string{}
+	for i := 0; i < len(obj); {
+		matched := false
+		for _, item := range c.syntax {
+			n := len(item.words)
+			if i+n >= len(obj) {
+				continue
+			}
+			if strings.Join(obj[i:i+n], " ") !=
+				strings.Join(item.words, " ") {
+				continue
+			}
+			values[item.field] = obj[i+n]
+			i += n + 1
+			matched = true
+			break
+		}
+		if matched == false {
+			err = fmt.Errorf("invalid object of %s at %q", class, obj[i])
+			return
+		}
+	}
+	return
+}
+
+// protocolIEs returns the IEs in the object set.
+func (g *generator) protocolIEs(name string) (ies []protocolIE, err error) {
+	set, ok := g.objectSets[name]
+	if ok == false {
+		err = fmt.Errorf("unknown object set %s", name)
+		return
+	}
+	if len(set.refs) > 0 {
+		err = fmt.Errorf("%s: the reference in object set "+
+			"is not supported", name)
+		return
+	}
+	for _, obj := range set.objects {
+		var values map[string]string
+		if values, err = g.objectValues(set.class, obj); err != nil {
+			return
+		}
+		id := values["&id"]
+		if _, ok := g.values[id]; ok == false {
+			err = fmt.Errorf("%s: unknown id %s", name, id)
+			return
+		}
+		typ := values["&Value"]
+		if _, ok := g.types[typ]; ok == false {
+			err = fmt.Errorf("%s: unknown type %s", name, typ)
+			return
+		}
+		ies = append(ies, protocolIE{
+			id:          constName(g.values[id]),
+			criticality: values["&criticality"],
+			typ:         &asnType{kind: tReference, ref: typ},
+			field:       goName(strings.TrimPrefix(id, "id-")),
+			optional:    values["&presence"] != "mandatory",
+		})
+	}
+	return
+}
+
+// containerTypes returns the size constraint of the container, the types
+// of id and criticality in the protocol IE, and the names of the
+// components of the protocol IE.
+func (g *generator) containerTypes(ref string) (container *asnType,
+	id, criticality string, names []string, err error) {
+
+	ta, ok := g.types[ref]
+	if ok == false {
+		err = fmt.Errorf("unknown type %s", ref)
+		return
+	}
+	container = ta.typ
+	if container.kind != tSequenceOf ||
+		container.elem.kind != tReference {
+		err = fmt.Errorf("%s is not SEQUENCE OF protocol IEs", ref)
+		return
+	}
+	field, ok := g.types[container.elem.ref]
+	if ok == false || field.typ.kind != tSequence ||
+		len(field.typ.components) != 3 {
+		err = fmt.Errorf("%s is not the protocol IE", container.elem.ref)
+		return
+	}
+	var ts [2]*asnType
+	for i := range ts {
+		ts[i], err = g.effective(field.typ.components[i].typ)
+		if err != nil {
+			return
+		}
+		if ts[i].kind != tReference {
+			err = fmt.Errorf("%s: %s is not the defined type", ref,
+				field.typ.components[i].name)
+			return
+		}
+	}
+	id, criticality = goName(ts[0].ref), goName(ts[1].ref)
+	for _, c := range field.typ.components {
+		names = append(names, c.name)
+	}
+	return
+}
+
+// genSequence writes the struct of SEQUENCE. The errors of the message,
+// i.e. the SEQUENCE which has the container of the protocol IEs, have the
+// path beginning with asnName. The SEQUENCE in OCTET STRING (CONTAINING
+// ...), e.g. PDUSessionResourceSetupRequestTransfer, is not the message,
+// and the caller which decodes the octets adds its name to the path.
+func (g *generator) genSequence(name, asnName string, t *asnType) (
+	err error) {
+
+	var fields, encs, decs bytes.Buffer
+	message := false
+	optnum := 0
+	for _, c := range t.components {
+		if c.optional == true {
+			optnum++
+		}
+	}
+
+	opt := optnum
+	var optflags bytes.Buffer
+	for _, c := range t.components {
+		field := "v." + goName(c.name)
+
+		if c.typ.kind == tReference && g.expand[c.typ.ref] {
+			if len(c.typ.params) != 1 {
+				err = fmt.Errorf("%s: invalid parameter", c.name)
+				return
+			}
+			err = g.genProtocolIEs(c.name, c.typ, &fields, &encs, &decs)
+			if err != nil {
+				return
+			}
+			message = g.contained[asnName] == false
+			continue
+		}
+
+		var typ, enc, dec string
+		if typ, err = g.goType(c.typ); err != nil {
+			return
+		}
+		if c.optional == false {
+			fmt.Fprintf(&fields, "%s %s\n", goName(c.name), typ)
+			if enc, err = g.encExpr(c.typ, field, false); err != nil {
+				return
+			}
+			if dec, err = g.decStmt(c.typ, field, false); err != nil {
+				return
+			}
+			path := fmt.Sprintf("%q", c.name)
+			encs.WriteString(check("err = "+enc, path))
+			decs.WriteString(check(dec, path))
+			continue
+		}
+
+		opt--
+		fmt.Fprintf(&fields, "%s *%s\n", goName(c.name), typ)
+		fmt.Fprintf(&optflags, "if %s != nil {\noptflag |= 1 << %d\n}\n",
+			field, opt)
+		e := field
+		if c.typ.kind != tReference && c.typ.kind != tBitString {
+			e = "*" + field
+		}
+		if enc, err = g.encExpr(c.typ, e, false); err != nil {
+			return
+		}
+		if dec, err = g.decStmt(c.typ, field, true); err != nil {
+			return
+		}
+		path := fmt.Sprintf("%q", c.name)
+		fmt.Fprintf(&encs, "if %s != nil {\n%s}\n", field,
+			check("err = "+enc, path))
+		fmt.Fprintf(&decs, "if optflag&(1<<%d) != 0 {\n%s = new(%s)\n%s}\n",
+			opt, field, typ, check(dec, path))
+	}
+
+	g.printf("type %s struct {\n%s}\n\n", name, fields.String())
+
+	named := ""
+	if message == true {
+		named = fmt.Sprintf("defer func() {\n"+
+			"err = per.WithPath(err, %q)\n}()\n", asnName)
+	}
+	g.printf("func (v %s) MarshalPER(w *per.BitWriter) (err error) {\n%s",
+		name, named)
+	if optnum > 0 {
+		g.printf("optflag := uint(0)\n%s", optflags.String())
+		g.printf("if err = w.EncSequence(%v, %d, optflag); err != nil {\n"+
+			"return\n}\n", t.ext, optnum)
+	} else {
+		g.printf("if err = w.EncSequence(%v, 0, 0); err != nil {\n"+
+			"return\n}\n", t.ext)
+	}
+	g.printf("%sreturn\n}\n\n", encs.String())
+
+	g.printf("func (v *%s) UnmarshalPER(r *per.BitReader) (err error) {\n%s",
+		name, named)
+	switch {
+	case t.ext && optnum > 0:
+		g.printf("ext, optflag, err := r.DecSequence(true, %d)\n", optnum)
+	case t.ext:
+		g.printf("ext, _, err := r.DecSequence(true, 0)\n")
+	case optnum > 0:
+		g.printf("_, optflag, err := r.DecSequence(false, %d)\n", optnum)
+	default:
+		g.printf("_, _, err = r.DecSequence(false, 0)\n")
+	}
+	g.printf("if err != nil {\nreturn\n}\n*v = %s{}\n%s", name,
+		decs.String())
+	if t.ext == true {
+		// the extension additions are unknown.
+		g.printf("if ext == true {\n" +
+			"_, err = r.DecExtensionAdditions()\n}\n")
+	}
+	g.printf("return\n}\n\n")
+	return
+}
+
+// genProtocolIEs writes the fields and the code for the container of the
+// protocol IEs, where name is the name of the component which has the
+// container. Each IE in the object set is the field of the struct, and
+// the IEs which are not in the set are skipped by the decoder. The path
+// of the error in the value has the type of the value, e.g.
+// protocolIEs[1].value.SupportedTAList.
+func (g *generator) genProtocolIEs(name string, t *asnType,
+	fields, encs, decs *bytes.Buffer) (err error) {
+
+	container, id, criticality, names, err := g.containerTypes(t.ref)
+	if err != nil {
+		return
+	}
+	ies, err := g.protocolIEs(t.params[0])
+	if err != nil {
+		return
+	}
+	path := func(component string) string {
+		return g.index(name, "i", "."+component)
+	}
+
+	mandatory := 0
+	var count, cases, checks bytes.Buffer
+	for _, ie := range ies {
+		var typ string
+		if typ, err = g.goType(ie.typ); err != nil {
+			return
+		}
+		field := "v." + ie.field
+		value := path(names[2] + "." + ie.typ.ref)
+		enc := check(fmt.Sprintf("err = %s(%s).MarshalPER(w)", id, ie.id),
+			path(names[0])) +
+			check(fmt.Sprintf("err = %s%s.MarshalPER(w)", criticality,
+				goName(ie.criticality)), path(names[1])) +
+			check(fmt.Sprintf("err = w.EncOpenType(%s.MarshalPER)", field),
+				value) + "i++\n"
+
+		if ie.optional == false {
+			mandatory++
+			fmt.Fprintf(fields, "%s %s\n", ie.field, typ)
+			encs.WriteString(enc)
+			fmt.Fprintf(&cases, "case %s:\n%shas%s = true\n", ie.id,
+				check(fmt.Sprintf("err = r.DecOpenType(%s.UnmarshalPER)",
+					field), value), ie.field)
+			fmt.Fprintf(&checks, "if has%s == false {\n"+
+				"err = &per.MissingIEError{IE: %q}\n"+
+				"err = per.WithPath(err, %q)\nreturn\n}\n",
+				ie.field, ie.field, name)
+			continue
+		}
+		fmt.Fprintf(fields, "%s *%s\n", ie.field, typ)
+		fmt.Fprintf(&count, "if %s != nil {\nn++\n}\n", field)
+		fmt.Fprintf(encs, "if %s != nil {\n%s}\n", field, enc)
+		fmt.Fprintf(&cases, "case %s:\n%s = new(%s)\n%s",
+			ie.id, field, typ,
+			check(fmt.Sprintf("err = r.DecOpenType(%s.UnmarshalPER)",
+				field), value))
+	}
+
+	// the number of IEs has to be written before them.
+	enc := encs.String()
+	encs.Reset()
+	fmt.Fprintf(encs, "n := %d\n%s", mandatory, count.String())
+	fmt.Fprintf(encs, "%si := 0\n%s",
+		check(fmt.Sprintf("err = w.EncSequenceOf(n, %s)",
+			g.sizeArgs(container)), fmt.Sprintf("%q", name)), enc)
+
+	fmt.Fprintf(decs, "n, err := r.DecSequenceOf(%s)\n"+
+		"if err != nil {\nerr = per.WithPath(err, %q)\nreturn\n}\n",
+		g.sizeArgs(container), name)
+	if mandatory > 0 {
+		decs.WriteString("var ")
+		sep := ""
+		for _, ie := range ies {
+			if ie.optional == false {
+				fmt.Fprintf(decs, "%shas%s", sep, ie.field)
+				sep = ", "
+			}
+		}
+		decs.WriteString(" bool\n")
+	}
+	fmt.Fprintf(decs, "for i := 0; i < n; i++ {\n"+
+		"var id %s\nvar criticality %s\n%s%s"+
+		"switch id {\n%sdefault:\n%s}\n}\n%s",
+		id, criticality,
+		check("err = id.UnmarshalPER(r)", path(names[0])),
+		check("err = criticality.UnmarshalPER(r)", path(names[1])),
+		cases.String(),
+		check("err = r.DecOpenType(nil)", path(names[2])),
+		checks.String())
+	return
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestGoName(t *testing.T) {
+	cases := []struct {
+		name   string
+		expect string
+	}{
+		{"GlobalGNB-ID", "GlobalGNBID"},
+		{"iE-Extensions", "IEExtensions"},
+		{"ues-retained", "UesRetained"},
+		{"NGAP-PDU", "NGAPPDU"},
+	}
+	for _, c := range cases {
+		if actual := goName(c.name); actual != c.expect {
+			t.Errorf("expect: %s, actual %s", c.expect, actual)
+		}
+	}
+
+	values := []struct {
+		v      valueAssignment
+		expect string
+	}{
+		{valueAssignment{name: "id-NGSetup", typeName: "ProcedureCode"},
+			"procCodeNGSetup"},
+		{valueAssignment{name: "id-AMF-UE-NGAP-ID",
+			typeName: "ProtocolIE-ID"}, "idAMFUENGAPID"},
+		{valueAssignment{name: "maxnoofE-RABs", typeName: "INTEGER"},
+			"maxnoofERABs"},
+	}
+	for _, c := range values {
+		if actual := constName(&c.v); actual != c.expect {
+			t.Errorf("expect: %s, actual %s", c.expect, actual)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	m := &modules{}
+	if err := parse(testModule, m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	src, err := newGenerator(m, "test", "per", nil).generate(
+		[]string{"test.asn"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, expect := range []string{
+		"maxnoofItems = 16",
+		"type Name string",
+		`w.EncPrintableString(string(v), "", 1, 150, true)`,
+		"type List []Item",
+		"w.EncSequenceOf(len(v), 1, maxnoofItems, false)",
+		"type ItemKind int",
+		"ItemKindC\n",
+		"w.EncInteger(v.Value, -5, 5, true)",
+		"Name   *Name",
+		"optflag |= 1 << 0",
+		"w.EncSequence(true, 1, optflag)",
+		`err = per.WithPath(err, "kind")`,
+		`err = per.WithPath(err, fmt.Sprintf("[%d]", i))`,
+	} {
+		if bytes.Contains(src, []byte(expect)) == false {
+			t.Errorf("expect %q in the generated code", expect)
+		}
+	}
+}
+
+func TestGenerateError(t *testing.T) {
+	cases := []string{
+		"X ::= SEQUENCE { a Unknown }",
+		"X ::= SEQUENCE { a INTEGER (0.., ...) }",
+		"X ::= SEQUENCE { a ProtocolIE-Container {{Unknown}} }",
+	}
+	for _, c := range cases {
+		m := &modules{}
+		src := "Test DEFINITIONS ::= BEGIN " + c + " END"
+		if err := parse(src, m); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		_, err := newGenerator(m, "test", "per",
+			[]string{"ProtocolIE-Container"}).generate(nil)
+		if err == nil {
+			t.Errorf("expect error for %q", c)
+		}
+	}
+}
+
+// TestNGAP checks that the generated code in package ngap is up to date.
+func TestNGAP(t *testing.T) {
+	files, err := asnFiles([]string{"../../ngap/asn1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	m := &modules{}
+	var names []string
+	for _, file := range files {
+		src, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err = parse(string(src), m); err != nil {
+			t.Fatalf("%s: unexpected error: %v", file, err)
+		}
+		names = append(names, file[strings.LastIndex(file, "/")+1:])
+	}
+	src, err := newGenerator(m, "ngap", "../encoding/per",
+		[]string{"ProtocolIE-Container"}).generate(names)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expect, err := ioutil.ReadFile("../../ngap/asn1_gen.go")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if bytes.Equal(src, expect) == false {
+		t.Errorf("ngap/asn1_gen.go is out of date, run go generate")
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2019 hhorai. All rights reserved.
+// Use of this source code is governed by a MIT license that can be found
+// in the LICENSE file.
+
+// Command asn1gen generates the Go types and their PER encoders and
+// decoders from ASN.1 modules, e.g. NGAP in 3GPP TS 38.413.
+//
+// Usage:
+//
+//	asn1gen -package ngap -o asn1_gen.go asn1
+//
+// The arguments are the ASN.1 files, or the directories which have them
+// as *.asn. The generated code has
+//
+//   - the constants of the values, e.g. procCodeNGSetup for id-NGSetup,
+//     idGlobalRANNodeID and maxnoofBPLMNs.
+//   - the Go type of each ASN.1 type, whose MarshalPER and UnmarshalPER
+//     methods call the encoders and the decoders of package per.
+//
+// SEQUENCE and CHOICE are structs, and the OPTIONAL components and the
+// alternatives of CHOICE are pointers. The container of the protocol IEs
+// given by -expand, e.g. ProtocolIE-Container {{NGSetupRequestIEs}}, is
+// expanded to the fields named after the IE ids in the object set.
+//
+// The errors of the encoders and the decoders have the ASN.1 path of the
+// field, e.g. NGSetupRequest.protocolIEs[1].value.SupportedTAList[0].tAC,
+// by per.WithPath.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func main() {
+	pkg := flag.String("package", "main", "package name of the generated code")
+	out := flag.String("o", "", "output file (default stdout)")
+	perPath := flag.String("per", "../encoding/per",
+		"import path of package per")
+	expand := flag.String("expand", "ProtocolIE-Container",
+		"comma separated containers of protocol IEs to be expanded")
+	flag.Parse()
+
+	if err := run(*pkg, *out, *perPath, strings.Split(*expand, ","),
+		flag.Args()); err != nil {
+		fmt.Fprintf(os.Stderr, "asn1gen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// asnFiles returns the files in args, where each directory is replaced
+// with *.asn in it.
+func asnFiles(args []string) (files []string, err error) {
+	for _, arg := range args {
+		var fi os.FileInfo
+		if fi, err = os.Stat(arg); err != nil {
+			return
+		}
+		if fi.IsDir() == false {
+			files = append(files, arg)
+			continue
+		}
+		var matches []string
+		if matches, err = filepath.Glob(filepath.Join(arg, "*.asn")); err != nil {
+			return
+		}
+		sort.Strings(matches)
+		files = append(files, matches...)
+	}
+	if len(files) == 0 {
+		err = fmt.Errorf("no ASN.1 files")
+	}
+	return
+}
+
+func run(pkg, out, perPath string, expand, args []string) (err error) {
+	files, err := asnFiles(args)
+	if err != nil {
+		return
+	}
+
+	m := &modules{}
+	for _, file := range files {
+		var src []byte
+		if src, err = ioutil.ReadFile(file); err != nil {
+			return
+		}
+		if err = parse(string(src), m); err != nil {
+			return fmt.Errorf("%s: %v", file, err)
+		}
+	}
+
+	var names []string
+	for _, file := range files {
+		names = append(names, filepath.Base(file))
+	}
+	src, err := newGenerator(m, pkg, perPath, expand).generate(names)
+	if err != nil {
+		return
+	}
+
+	if out == "" {
+		_, err = os.Stdout.Write(src)
+		return
+	}
+	err = ioutil.WriteFile(out, src, 0644)
+	return
+}
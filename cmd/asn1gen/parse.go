@@ -0,0 +1,772 @@
+// Copyright 2019 hhorai. All rights reserved.
+// Use of this source code is governed by a MIT license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// the kinds of asnType
+const (
+	tBoolean = iota
+	tNull
+	tInteger
+	tEnumerated
+	tBitString
+	tOctetString
+	tString
+	tSequence
+	tSequenceOf
+	tChoice
+	tReference
+	tClassField
+)
+
+// bounds is the range of the value or the size constraint. The bounds are
+// kept as they are written, i.e. the number or the value reference.
+type bounds struct {
+	lb, ub       string
+	hasLB, hasUB bool
+	ext          bool
+}
+
+type asnType struct {
+	kind int
+
+	name string // the string type, e.g. PrintableString
+
+	ref    string   // tReference
+	params []string // the actual parameters of tReference
+
+	class string // tClassField, e.g. NGAP-PROTOCOL-IES
+	field string // tClassField, e.g. &id
+
+	value bounds // tInteger
+	size  bounds // tBitString, tOctetString, tString and tSequenceOf
+
+	containing string // tOctetString, the type of the contents
+
+	enums     []string // tEnumerated
+	additions []string // tEnumerated after "..."
+
+	components []*component // tSequence and tChoice
+	ext        bool         // tSequence, tChoice and tEnumerated
+
+	elem *asnType // tSequenceOf
+}
+
+type component struct {
+	name     string
+	typ      *asnType
+	optional bool
+}
+
+type typeAssignment struct {
+	module string
+	name   string
+	params []string
+	typ    *asnType
+}
+
+type valueAssignment struct {
+	module   string
+	name     string
+	typeName string
+	value    string
+}
+
+type classField struct {
+	name string
+	typ  *asnType // nil for the type field, e.g. &Value
+}
+
+// syntaxItem is a part of WITH SYNTAX, e.g. "PROCEDURE CODE &procedureCode".
+type syntaxItem struct {
+	words []string
+	field string
+}
+
+type classAssignment struct {
+	name   string
+	fields []*classField
+	syntax []syntaxItem
+}
+
+// object is a list of the tokens in the braces. They are interpreted with
+// WITH SYNTAX of its class.
+type object []string
+
+type objectSetAssignment struct {
+	module  string
+	name    string
+	class   string
+	objects []object
+	refs    []string // other object sets or objects
+	ext     bool
+}
+
+type objectAssignment struct {
+	module string
+	name   string
+	class  string
+	object object
+}
+
+type modules struct {
+	types      []*typeAssignment
+	values     []*valueAssignment
+	classes    []*classAssignment
+	objectSets []*objectSetAssignment
+	objects    []*objectAssignment
+}
+
+// token is the lexical item of ASN.1.
+type token struct {
+	text string
+	line int
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func tokenize(src string) (tokens []token, err error) {
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case strings.HasPrefix(src[i:], "--"):
+			// the comment ends with "--" or the end of line.
+			i += 2
+			for i < len(src) && src[i] != '\n' {
+				if strings.HasPrefix(src[i:], "--") {
+					i += 2
+					break
+				}
+				i++
+			}
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i:], "*/")
+			if end < 0 {
+				err = fmt.Errorf("line %d: unterminated comment", line)
+				return
+			}
+			line += strings.Count(src[i:i+end], "\n")
+			i += end + 2
+		case isLetter(c) || c == '&' && i+1 < len(src) && isLetter(src[i+1]):
+			j := i + 1
+			for j < len(src) {
+				if isLetter(src[j]) || isDigit(src[j]) {
+					j++
+					continue
+				}
+				// a hyphen is a part of the name, but not two of them.
+				if src[j] == '-' && j+1 < len(src) &&
+					(isLetter(src[j+1]) || isDigit(src[j+1])) {
+					j++
+					continue
+				}
+				break
+			}
+			tokens = append(tokens, token{src[i:j], line})
+			i = j
+		case isDigit(c):
+			j := i + 1
+			for j < len(src) && isDigit(src[j]) {
+				j++
+			}
+			tokens = append(tokens, token{src[i:j], line})
+			i = j
+		default:
+			n := 1
+			for _, s := range []string{"::=", "...", "..", "[[", "]]"} {
+				if strings.HasPrefix(src[i:], s) {
+					n = len(s)
+					break
+				}
+			}
+			if strings.IndexByte("{}()[],|;.@-!<>^:\"", c) < 0 {
+				err = fmt.Errorf("line %d: unexpected character %q",
+					line, c)
+				return
+			}
+			tokens = append(tokens, token{src[i : i+n], line})
+			i += n
+		}
+	}
+	return
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	module string
+	m      *modules
+}
+
+func (p *parser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos].text
+}
+
+func (p *parser) next() string {
+	s := p.peek()
+	p.pos++
+	return s
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	line := 0
+	if p.pos < len(p.tokens) {
+		line = p.tokens[p.pos].line
+	} else if len(p.tokens) > 0 {
+		line = p.tokens[len(p.tokens)-1].line
+	}
+	return fmt.Errorf("%s: line %d: %s", p.module, line,
+		fmt.Sprintf(format, args...))
+}
+
+func (p *parser) expect(s string) error {
+	if t := p.next(); t != s {
+		p.pos--
+		return p.errorf("expected %q, found %q", s, t)
+	}
+	return nil
+}
+
+// skipBlock skips the tokens up to the matching close bracket. The open
+// bracket has been read already.
+func (p *parser) skipBlock(open, close string) (tokens []string, err error) {
+	depth := 1
+	for {
+		t := p.next()
+		switch t {
+		case "":
+			err = p.errorf("missing %q", close)
+			return
+		case open:
+			depth++
+		case close:
+			depth--
+			if depth == 0 {
+				return
+			}
+		}
+		tokens = append(tokens, t)
+	}
+}
+
+// parse reads one ASN.1 module in src and adds the assignments to m.
+func parse(src string, m *modules) (err error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return
+	}
+	p := &parser{tokens: tokens, m: m}
+
+	p.module = p.next()
+	if p.peek() == "{" {
+		p.next()
+		if _, err = p.skipBlock("{", "}"); err != nil {
+			return
+		}
+	}
+	if err = p.expect("DEFINITIONS"); err != nil {
+		return
+	}
+	for p.peek() != "::=" && p.peek() != "" {
+		p.next()
+	}
+	if err = p.expect("::="); err != nil {
+		return
+	}
+	if err = p.expect("BEGIN"); err != nil {
+		return
+	}
+
+	for {
+		switch p.peek() {
+		case "END":
+			return
+		case "":
+			err = p.errorf("missing END")
+			return
+		case "IMPORTS", "EXPORTS":
+			for p.peek() != ";" && p.peek() != "" {
+				p.next()
+			}
+			p.next()
+		default:
+			if err = p.parseAssignment(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func isUpper(s string) bool {
+	return s != "" && s[0] >= 'A' && s[0] <= 'Z'
+}
+
+func (p *parser) parseAssignment() (err error) {
+	name := p.next()
+
+	if isUpper(name) == false {
+		// value or object
+		typeName := p.next()
+		if err = p.expect("::="); err != nil {
+			return
+		}
+		if p.peek() == "{" {
+			p.next()
+			var tokens []string
+			if tokens, err = p.skipBlock("{", "}"); err != nil {
+				return
+			}
+			p.m.objects = append(p.m.objects, &objectAssignment{
+				module: p.module, name: name, class: typeName,
+				object: tokens})
+			return
+		}
+		value := p.next()
+		if value == "-" {
+			value += p.next()
+		}
+		p.m.values = append(p.m.values, &valueAssignment{
+			module: p.module, name: name, typeName: typeName,
+			value: value})
+		return
+	}
+
+	var params []string
+	if p.peek() == "{" {
+		p.next()
+		var tokens []string
+		if tokens, err = p.skipBlock("{", "}"); err != nil {
+			return
+		}
+		for i, t := range tokens {
+			if i+1 == len(tokens) || tokens[i+1] == "," {
+				params = append(params, t)
+			}
+		}
+	}
+
+	if p.peek() != "::=" {
+		// object set
+		class := p.next()
+		if err = p.expect("::="); err != nil {
+			return
+		}
+		err = p.parseObjectSet(name, class)
+		return
+	}
+	p.next()
+
+	if p.peek() == "CLASS" {
+		p.next()
+		err = p.parseClass(name)
+		return
+	}
+
+	t, err := p.parseType()
+	if err != nil {
+		return
+	}
+	p.m.types = append(p.m.types, &typeAssignment{
+		module: p.module, name: name, params: params, typ: t})
+	return
+}
+
+func (p *parser) parseClass(name string) (err error) {
+	c := &classAssignment{name: name}
+	if err = p.expect("{"); err != nil {
+		return
+	}
+	for p.peek() != "}" {
+		f := &classField{name: p.next()}
+		if strings.HasPrefix(f.name, "&") == false {
+			err = p.errorf("invalid field %q of CLASS", f.name)
+			return
+		}
+		if isUpper(f.name[1:]) == false {
+			if f.typ, err = p.parseType(); err != nil {
+				return
+			}
+		}
+		for p.peek() != "," && p.peek() != "}" && p.peek() != "" {
+			p.next() // UNIQUE, OPTIONAL or DEFAULT value
+		}
+		if p.peek() == "," {
+			p.next()
+		}
+		c.fields = append(c.fields, f)
+	}
+	p.next()
+
+	if p.peek() == "WITH" {
+		p.next()
+		if err = p.expect("SYNTAX"); err != nil {
+			return
+		}
+		if err = p.expect("{"); err != nil {
+			return
+		}
+		var tokens []string
+		if tokens, err = p.skipBlock("{", "}"); err != nil {
+			return
+		}
+		var words []string
+		for _, t := range tokens {
+			switch {
+			case t == "[" || t == "]":
+			case strings.HasPrefix(t, "&"):
+				c.syntax = append(c.syntax, syntaxItem{words, t})
+				words = nil
+			default:
+				words = append(words, t)
+			}
+		}
+	}
+	p.m.classes = append(p.m.classes, c)
+	return
+}
+
+func (p *parser) parseObjectSet(name, class string) (err error) {
+	s := &objectSetAssignment{module: p.module, name: name, class: class}
+	if err = p.expect("{"); err != nil {
+		return
+	}
+	for {
+		t := p.next()
+		switch t {
+		case "}":
+			p.m.objectSets = append(p.m.objectSets, s)
+			return
+		case "":
+			err = p.errorf("missing \"}\"")
+			return
+		case "|", ",":
+		case "...":
+			s.ext = true
+		case "{":
+			var tokens []string
+			if tokens, err = p.skipBlock("{", "}"); err != nil {
+				return
+			}
+			s.objects = append(s.objects, tokens)
+		default:
+			s.refs = append(s.refs, t)
+		}
+	}
+}
+
+var stringTypes = map[string]bool{
+	"PrintableString": true,
+	"VisibleString":   true,
+	"IA5String":       true,
+	"UTF8String":      true,
+}
+
+func (p *parser) parseType() (t *asnType, err error) {
+	t = &asnType{}
+	s := p.next()
+	switch {
+	case s == "BOOLEAN":
+		t.kind = tBoolean
+	case s == "NULL":
+		t.kind = tNull
+	case s == "INTEGER":
+		t.kind = tInteger
+		if p.peek() == "{" {
+			p.next()
+			if _, err = p.skipBlock("{", "}"); err != nil {
+				return
+			}
+		}
+	case s == "ENUMERATED":
+		t.kind = tEnumerated
+		err = p.parseEnumerated(t)
+	case s == "BIT" || s == "OCTET":
+		if err = p.expect("STRING"); err != nil {
+			return
+		}
+		t.kind = tOctetString
+		if s == "BIT" {
+			t.kind = tBitString
+			if p.peek() == "{" {
+				p.next()
+				if _, err = p.skipBlock("{", "}"); err != nil {
+					return
+				}
+			}
+		}
+	case stringTypes[s]:
+		t.kind = tString
+		t.name = s
+	case s == "SEQUENCE" && p.peek() == "{":
+		t.kind = tSequence
+		err = p.parseComponents(t)
+	case s == "SEQUENCE":
+		t.kind = tSequenceOf
+		if p.peek() == "SIZE" {
+			// SEQUENCE SIZE(...) OF without the parentheses
+			p.next()
+			if err = p.expect("("); err != nil {
+				return
+			}
+			if err = p.parseBounds(&t.size); err != nil {
+				return
+			}
+		}
+		for p.peek() == "(" {
+			if err = p.parseConstraint(t); err != nil {
+				return
+			}
+		}
+		if err = p.expect("OF"); err != nil {
+			return
+		}
+		t.elem, err = p.parseType()
+		return
+	case s == "CHOICE":
+		t.kind = tChoice
+		err = p.parseComponents(t)
+	case isUpper(s) && p.peek() == ".":
+		p.next()
+		t.kind = tClassField
+		t.class = s
+		t.field = p.next()
+	case isUpper(s):
+		t.kind = tReference
+		t.ref = s
+		if p.peek() == "{" {
+			p.next()
+			var tokens []string
+			if tokens, err = p.skipBlock("{", "}"); err != nil {
+				return
+			}
+			for _, tok := range tokens {
+				if tok != "{" && tok != "}" && tok != "," {
+					t.params = append(t.params, tok)
+				}
+			}
+		}
+	default:
+		err = p.errorf("unsupported type %q", s)
+	}
+	if err != nil {
+		return
+	}
+
+	for p.peek() == "(" {
+		if err = p.parseConstraint(t); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (p *parser) parseEnumerated(t *asnType) (err error) {
+	if err = p.expect("{"); err != nil {
+		return
+	}
+	for {
+		s := p.next()
+		switch s {
+		case "}":
+			return
+		case "":
+			err = p.errorf("missing \"}\"")
+			return
+		case ",":
+		case "...":
+			t.ext = true
+		case "(":
+			if _, err = p.skipBlock("(", ")"); err != nil {
+				return
+			}
+		default:
+			if t.ext == true {
+				t.additions = append(t.additions, s)
+			} else {
+				t.enums = append(t.enums, s)
+			}
+		}
+	}
+}
+
+func (p *parser) parseComponents(t *asnType) (err error) {
+	if err = p.expect("{"); err != nil {
+		return
+	}
+	for {
+		s := p.next()
+		switch s {
+		case "}":
+			return
+		case "":
+			err = p.errorf("missing \"}\"")
+			return
+		case ",":
+		case "...":
+			if t.ext == true {
+				err = p.errorf("the second extension marker " +
+					"is not supported")
+				return
+			}
+			t.ext = true
+		case "[[":
+			err = p.errorf("extension addition group is not supported")
+			return
+		default:
+			if t.ext == true {
+				err = p.errorf("extension addition %q "+
+					"is not supported", s)
+				return
+			}
+			c := &component{name: s}
+			if c.typ, err = p.parseType(); err != nil {
+				return
+			}
+			switch p.peek() {
+			case "OPTIONAL":
+				p.next()
+				c.optional = true
+			case "DEFAULT":
+				p.next()
+				p.next()
+				c.optional = true
+			}
+			t.components = append(t.components, c)
+		}
+	}
+}
+
+// parseConstraint reads the constraint in the parentheses. The table
+// constraints and the contents constraints are not PER-visible, and they
+// are skipped except the type of the contents.
+func (p *parser) parseConstraint(t *asnType) (err error) {
+	if err = p.expect("("); err != nil {
+		return
+	}
+	switch s := p.peek(); {
+	case s == "":
+		err = p.errorf("missing \")\"")
+		return
+	case s == "SIZE":
+		p.next()
+		if err = p.expect("("); err != nil {
+			return
+		}
+		if err = p.parseBounds(&t.size); err != nil {
+			return
+		}
+	case s == "CONTAINING":
+		p.next()
+		t.containing = p.next()
+	case s == "-" || s == "MIN" || isDigit(s[0]) ||
+		isLetter(s[0]) && isUpper(s) == false:
+		err = p.parseBounds(&t.value)
+		return
+	}
+
+	if p.peek() == "," && p.pos+1 < len(p.tokens) &&
+		p.tokens[p.pos+1].text == "..." {
+		p.next()
+		p.next()
+		t.size.ext = true
+	}
+	_, err = p.skipBlock("(", ")")
+	return
+}
+
+// parseBounds reads "lb..ub" or "value", optionally followed by ", ...",
+// and the close parenthesis. The union of them like "1..30|40|50" is
+// PER-visible as the smallest range which has all of them, i.e. "1..50".
+func (p *parser) parseBounds(b *bounds) (err error) {
+	bound := func() string {
+		s := p.next()
+		if s == "-" {
+			s += p.next()
+		}
+		return s
+	}
+
+	lb := bound()
+	ub := lb
+	if p.peek() == ".." {
+		p.next()
+		ub = bound()
+	}
+	for p.peek() == "|" {
+		p.next()
+		l := bound()
+		u := l
+		if p.peek() == ".." {
+			p.next()
+			u = bound()
+		}
+		if lb, err = p.union(lb, l, "MIN"); err != nil {
+			return
+		}
+		if ub, err = p.union(ub, u, "MAX"); err != nil {
+			return
+		}
+	}
+	if lb != "MIN" {
+		b.lb, b.hasLB = lb, true
+	}
+	if ub != "MAX" {
+		b.ub, b.hasUB = ub, true
+	}
+	if p.peek() == "," {
+		p.next()
+		if p.peek() == "..." {
+			p.next()
+			b.ext = true
+		}
+	}
+	_, err = p.skipBlock("(", ")")
+	return
+}
+
+// union returns the lower one of the bounds a and b if limit is "MIN",
+// otherwise the upper one. The bounds in the union have to be the numbers
+// since the values of the references are not known to the parser.
+func (p *parser) union(a, b, limit string) (v string, err error) {
+	if a == limit || b == limit {
+		v = limit
+		return
+	}
+	x, err := strconv.Atoi(a)
+	if err != nil {
+		err = p.errorf("unsupported bound %q in union", a)
+		return
+	}
+	y, err := strconv.Atoi(b)
+	if err != nil {
+		err = p.errorf("unsupported bound %q in union", b)
+		return
+	}
+	v = a
+	if limit == "MIN" && y < x || limit == "MAX" && y > x {
+		v = b
+	}
+	return
+}
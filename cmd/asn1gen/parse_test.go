@@ -0,0 +1,162 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	src := "id-NGSetup ProcedureCode ::= 21 -- comment -- maxnoof\n" +
+		"X ::= INTEGER (-1..maxnoof-X, ...) -- to the end of line\n" +
+		"/* block\ncomment */ Y ::= NGAP-IES.&id ({Set}{@id})"
+	tokens, err := tokenize(src)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var actual []string
+	for _, tok := range tokens {
+		actual = append(actual, tok.text)
+	}
+	expect := []string{
+		"id-NGSetup", "ProcedureCode", "::=", "21", "maxnoof",
+		"X", "::=", "INTEGER", "(", "-", "1", "..", "maxnoof-X", ",",
+		"...", ")",
+		"Y", "::=", "NGAP-IES", ".", "&id", "(", "{", "Set", "}", "{",
+		"@", "id", "}", ")"}
+	if reflect.DeepEqual(actual, expect) == false {
+		t.Errorf("expect: %q, actual %q", expect, actual)
+	}
+	if tokens[len(tokens)-1].line != 4 {
+		t.Errorf("line expect: %d, actual %d", 4, tokens[len(tokens)-1].line)
+	}
+}
+
+const testModule = `
+Test { itu-t (0) test (1) }
+DEFINITIONS AUTOMATIC TAGS ::=
+BEGIN
+IMPORTS
+	A, B FROM Other;
+
+maxnoofItems INTEGER ::= 16
+
+Name ::= PrintableString (SIZE(1..150, ...))
+
+List ::= SEQUENCE (SIZE(1..maxnoofItems)) OF Item
+
+Item ::= SEQUENCE {
+	value		INTEGER (-5..5, ...),
+	octets		OCTET STRING (SIZE(3)),
+	kind		ENUMERATED { a, b, ..., c },
+	name		Name		OPTIONAL,
+	...
+}
+
+IEs TEST-IES ::= {
+	{ ID id-A	CRITICALITY reject	TYPE Name	PRESENCE mandatory }|
+	{ ID id-B	CRITICALITY ignore	TYPE List	PRESENCE optional },
+	...
+}
+
+END
+`
+
+func TestParse(t *testing.T) {
+	m := &modules{}
+	if err := parse(testModule, m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(m.values) != 1 || m.values[0].name != "maxnoofItems" ||
+		m.values[0].value != "16" {
+		t.Errorf("unexpected values: %+v", m.values)
+	}
+	if len(m.types) != 3 {
+		t.Fatalf("the number of types expect: %d, actual %d",
+			3, len(m.types))
+	}
+
+	name := m.types[0].typ
+	if name.kind != tString || name.name != "PrintableString" ||
+		name.size != (bounds{"1", "150", true, true, true}) {
+		t.Errorf("unexpected Name: %+v", name)
+	}
+
+	list := m.types[1].typ
+	if list.kind != tSequenceOf || list.elem.ref != "Item" ||
+		list.size != (bounds{"1", "maxnoofItems", true, true, false}) {
+		t.Errorf("unexpected List: %+v", list)
+	}
+
+	item := m.types[2].typ
+	if item.kind != tSequence || item.ext == false ||
+		len(item.components) != 4 {
+		t.Fatalf("unexpected Item: %+v", item)
+	}
+	if v := item.components[0].typ.value; v !=
+		(bounds{"-5", "5", true, true, true}) {
+		t.Errorf("unexpected value: %+v", v)
+	}
+	if kind := item.components[2].typ; reflect.DeepEqual(kind.enums,
+		[]string{"a", "b"}) == false || kind.ext == false ||
+		reflect.DeepEqual(kind.additions, []string{"c"}) == false {
+		t.Errorf("unexpected kind: %+v", kind)
+	}
+	if item.components[3].optional == false {
+		t.Errorf("name expect OPTIONAL")
+	}
+
+	if len(m.objectSets) != 1 || len(m.objectSets[0].objects) != 2 ||
+		m.objectSets[0].ext == false {
+		t.Errorf("unexpected object set: %+v", m.objectSets)
+	}
+}
+
+func TestParseUnion(t *testing.T) {
+	m := &modules{}
+	src := "Test DEFINITIONS ::= BEGIN " +
+		"X ::= INTEGER (1..30|40|50|181, ...) " +
+		"Y ::= INTEGER (5|-1..MAX) END"
+	if err := parse(src, m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	x := m.types[0].typ.value
+	if x != (bounds{"1", "181", true, true, true}) {
+		t.Errorf("unexpected X: %+v", x)
+	}
+	y := m.types[1].typ.value
+	if y != (bounds{"-1", "", true, false, false}) {
+		t.Errorf("unexpected Y: %+v", y)
+	}
+}
+
+func TestParseContaining(t *testing.T) {
+	m := &modules{}
+	src := "Test DEFINITIONS ::= BEGIN " +
+		"X ::= SEQUENCE { a OCTET STRING (CONTAINING Y) } END"
+	if err := parse(src, m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	a := m.types[0].typ.components[0].typ
+	if a.kind != tOctetString || a.containing != "Y" {
+		t.Errorf("unexpected a: %+v", a)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	cases := []string{
+		"Test DEFINITIONS ::= BEGIN X ::= INTEGER (0..1 END",
+		"Test DEFINITIONS ::= BEGIN X ::= SEQUENCE { a BOOLEAN ",
+		"Test DEFINITIONS ::= BEGIN X ::= SEQUENCE { a BOOLEAN, ..., " +
+			"b BOOLEAN } END",
+		"Test DEFINITIONS ::= BEGIN X ::= SEQUENCE { [[ a BOOLEAN ]] } END",
+		"Test ::= BEGIN END",
+		"Test DEFINITIONS ::= BEGIN X ::= BOOLEAN",
+		"Test DEFINITIONS ::= BEGIN X ::= INTEGER (0|maxnoof) END",
+	}
+	for _, c := range cases {
+		if err := parse(c, &modules{}); err == nil {
+			t.Errorf("expect error for %q", c)
+		}
+	}
+}
@@ -0,0 +1,828 @@
+// Copyright 2019 hhorai. All rights reserved.
+// Use of this source code is governed by a MIT license that can be found
+// in the LICENSE file.
+
+package per
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// BitWriter is a buffer for composing PER encoded fields. It keeps track
+// of the current bit offset so that the fields which have to be
+// octet-aligned in ALIGNED variant are aligned automatically.
+// The zero value is an empty writer ready to use for ALIGNED variant. Set
+// Variant to Unaligned before writing for UNALIGNED variant.
+type BitWriter struct {
+	Variant Variant
+	buf     []uint8
+	len     int // in bits
+}
+
+// NewBitWriter returns an empty BitWriter.
+func NewBitWriter() *BitWriter {
+	return &BitWriter{}
+}
+
+// Len returns the number of bits written so far.
+func (w *BitWriter) Len() int {
+	return w.len
+}
+
+// Bytes returns the written bits. The last octet is padded with zero bits.
+func (w *BitWriter) Bytes() []uint8 {
+	return w.buf
+}
+
+func (w *BitWriter) writeBit(b uint8) {
+	if w.len%8 == 0 {
+		w.buf = append(w.buf, 0)
+	}
+	if b&0x01 == 0x01 {
+		w.buf[w.len/8] |= 0x80 >> uint(w.len%8)
+	}
+	w.len++
+}
+
+// putBits writes n bits starting at the bit offset off of in.
+func (w *BitWriter) putBits(in []uint8, off, n int) {
+	for i := off; i < off+n; i++ {
+		w.writeBit(in[i/8] >> uint(7-i%8))
+	}
+}
+
+// WriteBits writes the leftmost inlen bits of in, e.g. the preamble
+// returned by EncSequence.
+func (w *BitWriter) WriteBits(in []uint8, inlen int) {
+	w.putBits(in, 0, inlen)
+}
+
+// WriteUint writes the n least significant bits of v.
+func (w *BitWriter) WriteUint(v uint64, n int) {
+	for i := n - 1; i >= 0; i-- {
+		w.writeBit(uint8(v >> uint(i)))
+	}
+}
+
+// WriteOctets writes the octets from the current bit offset. It does not
+// align the offset, call Align beforehand if needed.
+func (w *BitWriter) WriteOctets(in []uint8) {
+	if w.len%8 == 0 {
+		w.buf = append(w.buf, in...)
+		w.len += len(in) * 8
+		return
+	}
+	for _, b := range in {
+		w.WriteUint(uint64(b), 8)
+	}
+}
+
+// Align pads zero bits up to the next octet boundary. It does nothing in
+// UNALIGNED variant.
+func (w *BitWriter) Align() {
+	w.len += w.Variant.padding(w.len)
+}
+
+// EncConstrainedWholeNumber writes the value as
+// 10.5 Encoding of constrained whole number.
+func (w *BitWriter) EncConstrainedWholeNumber(input, min, max int) (
+	err error) {
+
+	inputRange := max - min + 1
+	if w.Variant == Unaligned && inputRange > 1 {
+		if input < min || input > max {
+			err = &RangeError{Offset: w.len, Value: input, Min: min,
+				Max: max}
+			return
+		}
+		// 10.5.7 the minimum bit-field in UNALIGNED variant
+		w.WriteUint(uint64(input-min), bits.Len64(uint64(max-min)))
+		return
+	}
+
+	if inputRange > 65536 {
+		err = w.encIndefiniteLengthWholeNumber(input, min, max)
+		return
+	}
+
+	v, bitlen, err := EncConstrainedWholeNumber(input, min, max)
+	if err != nil {
+		return
+	}
+	if inputRange < 256 {
+		w.WriteUint(uint64(input-min), bitlen)
+		return
+	}
+	// the one-octet and two-octet cases are octet-aligned.
+	w.Align()
+	w.WriteOctets(v)
+	return
+}
+
+// encIndefiniteLengthWholeNumber writes the value of the range over 64K.
+// The number of octets is written as a constrained whole number from 1 to
+// the number of octets to hold the range, and then the value is written
+// in the minimum number of octets.
+func (w *BitWriter) encIndefiniteLengthWholeNumber(input, min, max int) (
+	err error) {
+
+	if input < min || input > max {
+		err = &RangeError{Offset: w.len, Value: input, Min: min, Max: max}
+		return
+	}
+
+	v := nonNegativeBinaryInteger(uint64(input - min))
+	maxOctets := (bits.Len64(uint64(max-min)) + 7) / 8
+	err = w.EncConstrainedWholeNumber(len(v), 1, maxOctets)
+	if err != nil {
+		return
+	}
+	w.Align()
+	w.WriteOctets(v)
+	return
+}
+
+// EncSemiConstrainedWholeNumber writes the value as
+// 10.7 Encoding of a semi-constrained whole number.
+func (w *BitWriter) EncSemiConstrainedWholeNumber(input, lb int) (
+	err error) {
+
+	if input < lb {
+		err = &RangeError{Offset: w.len, Value: input, Min: lb,
+			Max: NoUpperBound}
+		return
+	}
+
+	v := nonNegativeBinaryInteger(uint64(input - lb))
+	err = w.EncLengthDeterminant(len(v), 0)
+	if err != nil {
+		return
+	}
+	w.WriteOctets(v)
+	return
+}
+
+// EncUnconstrainedWholeNumber writes the value as
+// 10.8 Encoding of an unconstrained whole number.
+func (w *BitWriter) EncUnconstrainedWholeNumber(input int) (err error) {
+
+	v := twosComplementBinaryInteger(int64(input))
+	err = w.EncLengthDeterminant(len(v), 0)
+	if err != nil {
+		return
+	}
+	w.WriteOctets(v)
+	return
+}
+
+// EncNormallySmallWholeNumber writes the value as
+// 10.6 Encoding of a normally small non-negative whole number
+func (w *BitWriter) EncNormallySmallWholeNumber(input int) (err error) {
+	if input < 0 {
+		err = &RangeError{Offset: w.len, Value: input, Min: 0,
+			Max: NoUpperBound}
+		return
+	}
+	if input < 64 {
+		w.writeBit(0)
+		w.WriteUint(uint64(input), 6)
+		return
+	}
+	w.writeBit(1)
+	err = w.EncSemiConstrainedWholeNumber(input, 0)
+	return
+}
+
+// EncLengthDeterminant writes the length as
+// 10.9 General rules for encoding a length determinant
+// Only the header of the first fragment is written for the length of 16K
+// or more, use EncFragments to write the fragmented items.
+func (w *BitWriter) EncLengthDeterminant(input, max int) (err error) {
+
+	if max != 0 && max < 65536 {
+		err = w.EncConstrainedWholeNumber(input, 0, max)
+		return
+	}
+
+	v, _, err := EncLengthDeterminant(input, max)
+	if err != nil {
+		return
+	}
+	w.Align()
+	w.WriteOctets(v)
+	return
+}
+
+// EncFragments writes the length determinant of n items and the items
+// themselves, which are written by put(from, to) for the items in
+// [from, to). The length of 16K or more is split into the fragments of
+// 64K, 48K, 32K or 16K items followed by the length of the rest, which can
+// be 0.
+// 10.9.3.8 fragmentation
+func (w *BitWriter) EncFragments(n int, put func(from, to int) error) (
+	err error) {
+
+	from := 0
+	for {
+		size := FragmentSize(n - from)
+		err = w.EncLengthDeterminant(n-from, 0)
+		if err != nil {
+			return
+		}
+		if size > 0 {
+			err = put(from, from+size)
+			if err != nil {
+				return
+			}
+		}
+		from += size
+		if size < 16384 {
+			return
+		}
+	}
+}
+
+func (w *BitWriter) encConstrainedWholeNumberWithExtmark(input, min, max int,
+	extmark bool) (err error) {
+
+	if input < min || input > max {
+		err = &RangeError{Offset: w.len, Value: input, Min: min, Max: max}
+		return
+	}
+	if extmark == true {
+		w.writeBit(0)
+	}
+	err = w.EncConstrainedWholeNumber(input, min, max)
+	return
+}
+
+// EncInteger writes the value as
+// 12. Encoding the integer type
+// for the constrained integer. If extmark is true and the input is out of
+// min and max, it is written as the value out of extension root.
+func (w *BitWriter) EncInteger(input, min, max int, extmark bool) (
+	err error) {
+
+	if extmark == true && (input < min || input > max) {
+		// 12.1 the value out of extension root is encoded as
+		// unconstrained whole number.
+		w.writeBit(1)
+		err = w.EncUnconstrainedWholeNumber(input)
+		return
+	}
+
+	if min == max { // 12.2.1 single value
+		if input != min {
+			err = &RangeError{Offset: w.len, Value: input, Min: min,
+				Max: max}
+			return
+		}
+		if extmark == true {
+			w.writeBit(0)
+		}
+		return
+	}
+
+	// 12.2.2 constrained whole number
+	err = w.encConstrainedWholeNumberWithExtmark(input, min, max, extmark)
+	return
+}
+
+// encIndexWithExtmark writes the index of ENUMERATED or CHOICE. If extmark
+// is true, the input more than max is the index out of extension root,
+// i.e. max+1 is the first one after the extension marker.
+func (w *BitWriter) encIndexWithExtmark(input, min, max int,
+	extmark bool) (err error) {
+	if extmark == true && input > max {
+		// 13.3 and 22.8 the index out of extension root
+		w.writeBit(1)
+		err = w.EncNormallySmallWholeNumber(input - max - 1)
+		return
+	}
+	err = w.encConstrainedWholeNumberWithExtmark(input, min, max, extmark)
+	return
+}
+
+// EncEnumerated writes the value as
+// 13. Encoding the enumerated type
+// If extmark is true, the input more than max is the value out of
+// extension root, i.e. max+1 is the first value after the extension marker.
+func (w *BitWriter) EncEnumerated(input, min, max int, extmark bool) (
+	err error) {
+	err = w.encIndexWithExtmark(input, min, max, extmark)
+	return
+}
+
+// encSizeWithExtmark writes the extension bit and the length of a string
+// type whose size is constrained by min and max. Nothing but the extension
+// bit is written when the size is fixed. unconstrained is true when the
+// upper bound is 64K or more, in which case the caller has to write the
+// length with the items by EncFragments.
+func (w *BitWriter) encSizeWithExtmark(size, min, max int, extmark bool) (
+	unconstrained bool, err error) {
+
+	if size < min || size > max {
+		err = &RangeError{Offset: w.len, Value: size, Min: min, Max: max}
+		return
+	}
+	if extmark == true {
+		w.writeBit(0)
+	}
+	if min == max && max < 65537 {
+		return
+	}
+	if max < 65536 {
+		err = w.EncConstrainedWholeNumber(size, min, max)
+		return
+	}
+	unconstrained = true
+	return
+}
+
+// EncBitString writes the BIT STRING whose inputlen bits are
+// right-aligned in input.
+// 15. Encoding the bitstering type
+func (w *BitWriter) EncBitString(input []uint8, inputlen, min, max int,
+	extmark bool) (err error) {
+
+	if inputlen < min || inputlen > max {
+		err = &RangeError{Offset: w.len, Value: inputlen, Min: min,
+			Max: max}
+		return
+	}
+
+	if len(input)*8 < inputlen {
+		err = &RangeError{Offset: w.len, Value: len(input) * 8,
+			Min: inputlen, Max: NoUpperBound}
+		return
+	}
+
+	unconstrained, err := w.encSizeWithExtmark(inputlen, min, max, extmark)
+	if err != nil {
+		return
+	}
+
+	off := len(input)*8 - inputlen
+	if unconstrained == true {
+		err = w.EncFragments(inputlen, func(from, to int) error {
+			w.putBits(input, off+from, to-from)
+			return nil
+		})
+		return
+	}
+
+	if min == max {
+		// 15.9 fixed length up to 16 bits is not octet-aligned.
+		// 15.10 fixed length up to 64K bits is octet-aligned.
+		if min > 16 {
+			w.Align()
+		}
+		w.putBits(input, off, inputlen)
+		return
+	}
+
+	if inputlen == 0 {
+		return
+	}
+	w.Align()
+	w.putBits(input, off, inputlen)
+	return
+}
+
+// EncOctetString writes the OCTET STRING as
+// 16. Encoding the octetstring type
+func (w *BitWriter) EncOctetString(input []uint8, min, max int,
+	extmark bool) (err error) {
+
+	inputlen := len(input)
+	if inputlen < min || inputlen > max {
+		err = &RangeError{Offset: w.len, Value: inputlen, Min: min,
+			Max: max}
+		return
+	}
+
+	unconstrained, err := w.encSizeWithExtmark(inputlen, min, max, extmark)
+	if err != nil {
+		return
+	}
+
+	if unconstrained == true {
+		err = w.EncFragments(inputlen, func(from, to int) error {
+			w.WriteOctets(input[from:to])
+			return nil
+		})
+		return
+	}
+
+	if min == max {
+		switch {
+		case min == 0:
+		case min < 3:
+			w.WriteOctets(input)
+		default:
+			w.Align()
+			w.WriteOctets(input)
+		}
+		return
+	}
+
+	if inputlen == 0 {
+		return
+	}
+	w.Align()
+	w.WriteOctets(input)
+	return
+}
+
+// EncSequence writes the preamble of SEQUENCE, i.e. the extension bit and
+// the presence bit-map of OPTIONAL components.
+// 18. Encoding the sequence type
+// The first OPTIONAL component is the most significant bit of optflag.
+func (w *BitWriter) EncSequence(extmark bool, optnum int, optflag uint) (
+	err error) {
+	if optnum > bits.UintSize {
+		err = &ConstraintError{Offset: w.len, Constraint: fmt.Sprintf(
+			"%d OPTIONAL components (should be <= %d)",
+			optnum, bits.UintSize)}
+		return
+	}
+	if extmark == true {
+		w.writeBit(0)
+	}
+	w.WriteUint(uint64(optflag), optnum)
+	return
+}
+
+// EncExtendedSequence writes the preamble of the extensible SEQUENCE whose
+// extension additions are present, i.e. the extension bit of 1 and the
+// presence bit-map of OPTIONAL components in the extension root. The
+// additions have to be written by EncExtensionAdditions after the
+// components in the extension root.
+// 18.1 the extension bit
+func (w *BitWriter) EncExtendedSequence(optnum int, optflag uint) (
+	err error) {
+	if optnum > bits.UintSize {
+		err = &ConstraintError{Offset: w.len, Constraint: fmt.Sprintf(
+			"%d OPTIONAL components (should be <= %d)",
+			optnum, bits.UintSize)}
+		return
+	}
+	w.writeBit(1)
+	w.WriteUint(uint64(optflag), optnum)
+	return
+}
+
+// encNormallySmallLength writes the number of bits in the bit-map of
+// extension additions as
+// 10.9.3.4 normally small length
+func (w *BitWriter) encNormallySmallLength(n int) (err error) {
+	if n < 1 {
+		err = &ExtensionError{Offset: w.len,
+			Reason: "no extension additions"}
+		return
+	}
+	if n <= 64 {
+		w.writeBit(0)
+		w.WriteUint(uint64(n-1), 6)
+		return
+	}
+	w.writeBit(1)
+	err = w.EncLengthDeterminant(n, 0)
+	return
+}
+
+// EncExtensionAdditions writes the extension additions of the SEQUENCE
+// preambled by EncExtendedSequence. additions has the complete encodings
+// of all extension additions in the order of definition, and nil for the
+// absent ones. An addition group is given as the encoding of a SEQUENCE
+// which has its components.
+// 18.7 - 18.9 the encoding of extension additions
+func (w *BitWriter) EncExtensionAdditions(additions [][]uint8) (err error) {
+	err = w.encNormallySmallLength(len(additions))
+	if err != nil {
+		return
+	}
+	for _, addition := range additions {
+		if addition == nil {
+			w.writeBit(0)
+		} else {
+			w.writeBit(1)
+		}
+	}
+	for _, addition := range additions {
+		if addition == nil {
+			continue
+		}
+		err = w.encOpenType(addition)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// encOpenType writes the complete encoding v as the value of open type.
+func (w *BitWriter) encOpenType(v []uint8) (err error) {
+	if len(v) == 0 {
+		// 10.1.3 the complete encoding has at least one octet.
+		v = []uint8{0x00}
+	}
+	err = w.EncFragments(len(v), func(from, to int) error {
+		w.WriteOctets(v[from:to])
+		return nil
+	})
+	return
+}
+
+// EncOpenType writes the value encoded by enc as the open type, e.g. the
+// value of ProtocolIE-Field. enc writes the value into another BitWriter,
+// and then its octet-aligned result is written with the length determinant.
+// 10.2 Open type fields
+func (w *BitWriter) EncOpenType(enc func(w *BitWriter) error) (err error) {
+	ow := BitWriter{Variant: w.Variant}
+	err = enc(&ow)
+	if err != nil {
+		return
+	}
+	err = w.encOpenType(ow.Bytes())
+	return
+}
+
+// EncSequenceOf writes the number of components of SEQUENCE OF.
+// 19. Encoding the sequence-of type
+// If max is 64K or more and there are 16K components or more, the number
+// has to be fragmented together with the components by EncFragments.
+func (w *BitWriter) EncSequenceOf(input, min, max int, extmark bool) (
+	err error) {
+
+	unconstrained, err := w.encSizeWithExtmark(input, min, max, extmark)
+	if err != nil || unconstrained == false {
+		return
+	}
+	if input >= 16384 {
+		err = &ConstraintError{Offset: w.len, Constraint: fmt.Sprintf(
+			"%d components have to be written by EncFragments",
+			input)}
+		return
+	}
+	err = w.EncLengthDeterminant(input, 0)
+	return
+}
+
+// EncChoice writes the index of the chosen alternative.
+// 22. Encoding the choice type
+// If extmark is true, the input more than max is the index of the
+// alternative out of extension root, i.e. max+1 is the first alternative
+// after the extension marker. Its value has to be written by EncOpenType.
+func (w *BitWriter) EncChoice(input, min, max int, extmark bool) (
+	err error) {
+	err = w.encIndexWithExtmark(input, min, max, extmark)
+	return
+}
+
+// BitReader reads PER encoded fields from a buffer. It is the counterpart
+// of BitWriter and keeps track of the bit offset from the beginning of
+// the buffer. Variant has to be the same as the one of the writer.
+type BitReader struct {
+	Variant Variant
+	buf     []uint8
+	off     int // in bits
+}
+
+// NewBitReader returns a BitReader reading from in.
+func NewBitReader(in []uint8) *BitReader {
+	return &BitReader{buf: in}
+}
+
+// Offset returns the number of bits read so far.
+func (r *BitReader) Offset() int {
+	return r.off
+}
+
+// Len returns the number of the unread bits.
+func (r *BitReader) Len() int {
+	if r.off > len(r.buf)*8 {
+		return 0
+	}
+	return len(r.buf)*8 - r.off
+}
+
+// ReadBits reads n bits and returns them left-aligned.
+func (r *BitReader) ReadBits(n int) (v []uint8, err error) {
+	if r.off+n > len(r.buf)*8 {
+		err = &TruncatedError{Offset: r.off, Need: n}
+		return
+	}
+	v = make([]uint8, (n+7)/8, (n+7)/8)
+	for i := 0; i < n; i++ {
+		pos := r.off + i
+		if r.buf[pos/8]&(0x80>>uint(pos%8)) != 0 {
+			v[i/8] |= 0x80 >> uint(i%8)
+		}
+	}
+	r.off += n
+	return
+}
+
+// ReadUint reads n (<= 64) bits as an unsigned integer.
+func (r *BitReader) ReadUint(n int) (v uint64, err error) {
+	v, err = getBits(r.buf, r.off, n)
+	if err != nil {
+		return
+	}
+	r.off += n
+	return
+}
+
+// ReadOctets reads n octets from the current bit offset. It does not
+// align the offset, call Align beforehand if needed.
+func (r *BitReader) ReadOctets(n int) (v []uint8, err error) {
+	v, err = getOctets(r.buf, r.off, n)
+	if err != nil {
+		return
+	}
+	r.off += n * 8
+	return
+}
+
+// Align skips the padding bits up to the next octet boundary. It does
+// nothing in UNALIGNED variant.
+func (r *BitReader) Align() {
+	r.off += r.Variant.padding(r.off)
+}
+
+// DecConstrainedWholeNumber reads the value written by
+// BitWriter.EncConstrainedWholeNumber.
+func (r *BitReader) DecConstrainedWholeNumber(min, max int) (
+	v int, err error) {
+	v, n, err := r.Variant.decConstrainedWholeNumber(r.buf, r.off, min, max)
+	r.off += n
+	return
+}
+
+// DecSemiConstrainedWholeNumber reads the value written by
+// BitWriter.EncSemiConstrainedWholeNumber.
+func (r *BitReader) DecSemiConstrainedWholeNumber(lb int) (
+	v int, err error) {
+	v, n, err := r.Variant.decSemiConstrainedWholeNumber(r.buf, r.off, lb)
+	r.off += n
+	return
+}
+
+// DecUnconstrainedWholeNumber reads the value written by
+// BitWriter.EncUnconstrainedWholeNumber.
+func (r *BitReader) DecUnconstrainedWholeNumber() (v int, err error) {
+	v, n, err := r.Variant.decUnconstrainedWholeNumber(r.buf, r.off)
+	r.off += n
+	return
+}
+
+// DecNormallySmallWholeNumber reads the value written by
+// BitWriter.EncNormallySmallWholeNumber.
+func (r *BitReader) DecNormallySmallWholeNumber() (v int, err error) {
+	v, n, err := r.Variant.decNormallySmallWholeNumber(r.buf, r.off)
+	r.off += n
+	return
+}
+
+// DecLengthDeterminant reads the length written by
+// BitWriter.EncLengthDeterminant.
+func (r *BitReader) DecLengthDeterminant(max int) (v int, err error) {
+	v, n, err := r.Variant.decLengthDeterminant(r.buf, r.off, max)
+	r.off += n
+	return
+}
+
+// DecFragments reads the items written by BitWriter.EncFragments. get(n)
+// is called for every fragment to read its n items, and the total number
+// of items is returned.
+// 10.9.3.8 fragmentation
+func (r *BitReader) DecFragments(get func(n int) error) (total int,
+	err error) {
+
+	off := r.off
+	defer func() {
+		if err != nil {
+			total, r.off = 0, off
+		}
+	}()
+	for {
+		var size int
+		size, err = r.DecLengthDeterminant(0)
+		if err != nil {
+			return
+		}
+		if size > 0 {
+			err = get(size)
+			if err != nil {
+				return
+			}
+		}
+		total += size
+		if size < 16384 {
+			return
+		}
+	}
+}
+
+// DecInteger reads the value written by BitWriter.EncInteger.
+func (r *BitReader) DecInteger(min, max int, extmark bool) (
+	v int, err error) {
+	v, n, err := r.Variant.decInteger(r.buf, r.off, min, max, extmark)
+	r.off += n
+	return
+}
+
+// DecEnumerated reads the value written by BitWriter.EncEnumerated.
+func (r *BitReader) DecEnumerated(min, max int, extmark bool) (
+	v int, err error) {
+	v, n, err := r.Variant.decEnumerated(r.buf, r.off, min, max, extmark)
+	r.off += n
+	return
+}
+
+// DecBitString reads the BIT STRING written by BitWriter.EncBitString.
+// The bits are returned right-aligned and vlen is its length in bits.
+func (r *BitReader) DecBitString(min, max int, extmark bool) (
+	v []uint8, vlen int, err error) {
+	v, vlen, n, err := r.Variant.decBitString(r.buf, r.off, min, max,
+		extmark)
+	r.off += n
+	return
+}
+
+// DecOctetString reads the OCTET STRING written by
+// BitWriter.EncOctetString.
+func (r *BitReader) DecOctetString(min, max int, extmark bool) (
+	v []uint8, err error) {
+	v, n, err := r.Variant.decOctetString(r.buf, r.off, min, max, extmark)
+	r.off += n
+	return
+}
+
+// DecSequence reads the preamble written by BitWriter.EncSequence.
+func (r *BitReader) DecSequence(extmark bool, optnum int) (
+	ext bool, optflag uint, err error) {
+	ext, optflag, n, err := DecSequence(r.buf, r.off, extmark, optnum)
+	r.off += n
+	return
+}
+
+// decOpenType reads the complete encoding written by encOpenType.
+func (r *BitReader) decOpenType() (v []uint8, err error) {
+	v = []uint8{}
+	_, err = r.DecFragments(func(n int) (err error) {
+		u, err := r.ReadOctets(n)
+		v = append(v, u...)
+		return
+	})
+	if err != nil {
+		v = nil
+	}
+	return
+}
+
+// DecOpenType reads the open type written by BitWriter.EncOpenType. dec
+// reads the value from another BitReader which has only the value. The
+// value is skipped if dec is nil, e.g. for the unknown ProtocolIE-Field.
+// 10.2 Open type fields
+func (r *BitReader) DecOpenType(dec func(r *BitReader) error) (err error) {
+	off := r.off
+	v, err := r.decOpenType()
+	if err != nil || dec == nil {
+		return
+	}
+	err = dec(&BitReader{Variant: r.Variant, buf: v})
+	if err != nil {
+		r.off = off
+	}
+	return
+}
+
+// DecExtensionAdditions reads the extension additions written by
+// BitWriter.EncExtensionAdditions. Call it after the components in the
+// extension root if DecSequence returns the extension bit of true.
+func (r *BitReader) DecExtensionAdditions() (additions [][]uint8,
+	err error) {
+	additions, n, err := r.Variant.decExtensionAdditions(r.buf, r.off)
+	r.off += n
+	return
+}
+
+// DecSequenceOf reads the number of components written by
+// BitWriter.EncSequenceOf.
+func (r *BitReader) DecSequenceOf(min, max int, extmark bool) (
+	v int, err error) {
+	v, n, err := r.Variant.decSequenceOf(r.buf, r.off, min, max, extmark)
+	r.off += n
+	return
+}
+
+// DecChoice reads the index written by BitWriter.EncChoice.
+func (r *BitReader) DecChoice(min, max int, extmark bool) (
+	v int, err error) {
+	v, n, err := r.Variant.decChoice(r.buf, r.off, min, max, extmark)
+	r.off += n
+	return
+}
@@ -0,0 +1,465 @@
+package per
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBitWriter(t *testing.T) {
+	w := NewBitWriter()
+	w.WriteUint(0x05, 3)
+	w.WriteBits([]uint8{0x80}, 1)
+	expect := []uint8{0xb0}
+	if w.Len() != 4 || compareSlice(expect, w.Bytes()) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 4, w.Len())
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, w.Bytes())
+	}
+
+	w.WriteOctets([]uint8{0x12})
+	expect = []uint8{0xb1, 0x20}
+	if w.Len() != 12 || compareSlice(expect, w.Bytes()) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 12, w.Len())
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, w.Bytes())
+	}
+
+	w.Align()
+	w.WriteOctets([]uint8{0x34})
+	expect = []uint8{0xb1, 0x20, 0x34}
+	if w.Len() != 24 || compareSlice(expect, w.Bytes()) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 24, w.Len())
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, w.Bytes())
+	}
+
+	// Align on the octet boundary writes nothing.
+	w.Align()
+	if w.Len() != 24 {
+		t.Errorf("bitlen expect: %d, actual %d", 24, w.Len())
+	}
+}
+
+// GlobalGNB-ID is composed as straight-line code.
+func TestBitWriterGlobalGNBID(t *testing.T) {
+	var w BitWriter
+	w.EncChoice(0, 0, 2, false) // globalGNB-ID
+	w.EncSequence(true, 1, 0)   // GlobalGNB-ID
+	w.EncOctetString([]uint8{0x21, 0xf3, 0x54}, 3, 3, false)
+	w.EncChoice(0, 0, 1, false) // gNB-ID
+	w.EncBitString([]uint8{0x00, 0x00, 0x01}, 22, 22, 32, false)
+
+	expect := []uint8{0x00, 0x21, 0xf3, 0x54, 0x00, 0x00, 0x00, 0x04}
+	expectlen := 62
+	if w.Len() != expectlen || compareSlice(expect, w.Bytes()) == false {
+		t.Errorf("bitlen expect: %d, actual %d", expectlen, w.Len())
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, w.Bytes())
+	}
+}
+
+func TestBitWriterEncLengthDeterminant(t *testing.T) {
+	w := NewBitWriter()
+	w.WriteUint(1, 1)
+	w.EncLengthDeterminant(130, 0)
+	expect := []uint8{0x80, 0x80, 0x82}
+	if w.Len() != 24 || compareSlice(expect, w.Bytes()) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 24, w.Len())
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, w.Bytes())
+	}
+
+	// 10.9.3.8 only the header of the first fragment is written.
+	w.EncLengthDeterminant(16384, 0)
+	expect = []uint8{0x80, 0x80, 0x82, 0xc1}
+	if w.Len() != 32 || compareSlice(expect, w.Bytes()) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 32, w.Len())
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, w.Bytes())
+	}
+}
+
+// fragmentedOctets returns the expected encoding of an unconstrained OCTET
+// STRING of n octets whose value is in fill, composed of the given length
+// determinants and the number of octets following each of them.
+func fragmentedOctets(fill uint8, lengths [][]uint8, sizes []int) (
+	out []uint8) {
+	for i := range lengths {
+		out = append(out, lengths[i]...)
+		for j := 0; j < sizes[i]; j++ {
+			out = append(out, fill)
+		}
+	}
+	return
+}
+
+// 10.9.3.8
+func TestBitWriterEncFragments(t *testing.T) {
+	cases := []struct {
+		n       int
+		lengths [][]uint8
+		sizes   []int
+	}{
+		{100, [][]uint8{{0x64}}, []int{100}},
+		{16384, [][]uint8{{0xc1}, {0x00}}, []int{16384, 0}},
+		{20000, [][]uint8{{0xc1}, {0x8e, 0x20}}, []int{16384, 3616}},
+		{70000, [][]uint8{{0xc4}, {0x91, 0x70}}, []int{65536, 4464}},
+		{100000, [][]uint8{{0xc4}, {0xc2}, {0x86, 0xa0}},
+			[]int{65536, 32768, 1696}},
+		{147456, [][]uint8{{0xc4}, {0xc4}, {0xc1}, {0x00}},
+			[]int{65536, 65536, 16384, 0}},
+	}
+	for _, c := range cases {
+		input := make([]uint8, c.n, c.n)
+		for i := range input {
+			input[i] = 0x5a
+		}
+		expect := fragmentedOctets(0x5a, c.lengths, c.sizes)
+
+		w := NewBitWriter()
+		err := w.EncOctetString(input, 0, 1<<20, false)
+		if err != nil || compareSlice(expect, w.Bytes()) == false {
+			t.Errorf("EncOctetString: n=%d, len expect: %d, actual %d, %v",
+				c.n, len(expect), len(w.Bytes()), err)
+		}
+
+		r := NewBitReader(expect)
+		v, err := r.DecOctetString(0, 1<<20, false)
+		if err != nil || compareSlice(input, v) == false ||
+			r.Offset() != len(expect)*8 {
+			t.Errorf("DecOctetString: n=%d, len expect: %d, actual %d, %v",
+				c.n, c.n, len(v), err)
+		}
+	}
+
+	// BIT STRING is fragmented in bits, with a leading extension bit.
+	input := make([]uint8, 2049, 2049)
+	input[0] = 0x01
+	input[2048] = 0xff
+	w := NewBitWriter()
+	w.EncBitString(input, 16385, 0, 65536, true)
+	expect := make([]uint8, 2052, 2052)
+	expect[1] = 0xc1
+	expect[2] = 0x80
+	expect[2049] = 0x7f
+	expect[2050] = 0x01
+	expect[2051] = 0x80
+	if w.Len() != 2051*8+1 || compareSlice(expect, w.Bytes()) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 2051*8+1, w.Len())
+	}
+
+	r := NewBitReader(expect)
+	v, vlen, err := r.DecBitString(0, 65536, true)
+	if vlen != 16385 || compareSlice(input, v) == false || err != nil {
+		t.Errorf("vlen expect: %d, actual %d, %v", 16385, vlen, err)
+	}
+	if r.Offset() != 2051*8+1 {
+		t.Errorf("offset expect: %d, actual %d", 2051*8+1, r.Offset())
+	}
+}
+
+// SEQUENCE OF with 16K components or more is written by EncFragments.
+func TestBitWriterSequenceOfFragments(t *testing.T) {
+	items := make([]int, 20000, 20000)
+	for i := range items {
+		items[i] = i % 4
+	}
+
+	w := NewBitWriter()
+	err := w.EncSequenceOf(len(items), 1, 65536, false)
+	if err == nil {
+		t.Errorf("EncSequenceOf: unexpected success")
+	}
+	err = w.EncFragments(len(items), func(from, to int) error {
+		for _, item := range items[from:to] {
+			w.EncInteger(item, 0, 3, false)
+		}
+		return nil
+	})
+	if err != nil || w.Len() != (1+16384/4+2+3616/4)*8 {
+		t.Errorf("bitlen expect: %d, actual %d, %v",
+			(1+16384/4+2+3616/4)*8, w.Len(), err)
+	}
+
+	r := NewBitReader(w.Bytes())
+	var decoded []int
+	n, err := r.DecFragments(func(n int) error {
+		for i := 0; i < n; i++ {
+			v, err := r.DecInteger(0, 3, false)
+			if err != nil {
+				return err
+			}
+			decoded = append(decoded, v)
+		}
+		return nil
+	})
+	if n != len(items) || len(decoded) != len(items) || err != nil {
+		t.Errorf("total expect: %d, actual %d, %v", len(items), n, err)
+	}
+	for i := range decoded {
+		if decoded[i] != items[i] {
+			t.Errorf("item[%d] expect: %d, actual %d",
+				i, items[i], decoded[i])
+			break
+		}
+	}
+
+	// the number less than 16K is written as a length determinant.
+	w = NewBitWriter()
+	w.EncSequenceOf(300, 1, 65536, false)
+	expect := []uint8{0x81, 0x2c}
+	if compareSlice(expect, w.Bytes()) == false {
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, w.Bytes())
+	}
+	v, err := NewBitReader(expect).DecSequenceOf(1, 65536, false)
+	if v != 300 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 300, v)
+	}
+}
+
+func TestBitReader(t *testing.T) {
+	r := NewBitReader([]uint8{0xb1, 0x20, 0x34})
+	u, err := r.ReadUint(3)
+	if u != 0x05 || err != nil {
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", 0x05, u)
+	}
+
+	v, err := r.ReadBits(1)
+	expect := []uint8{0x80}
+	if compareSlice(expect, v) == false || err != nil {
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	v, err = r.ReadOctets(1)
+	expect = []uint8{0x12}
+	if compareSlice(expect, v) == false || err != nil {
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	r.Align()
+	if r.Offset() != 16 || r.Len() != 8 {
+		t.Errorf("offset expect: %d, actual %d", 16, r.Offset())
+		t.Errorf("len expect: %d, actual %d", 8, r.Len())
+	}
+
+	_, err = r.ReadOctets(2)
+	var terr *TruncatedError
+	if errors.As(err, &terr) == false || terr.Offset != 16 {
+		t.Errorf("ReadOctets: expect TruncatedError, actual %v", err)
+	}
+	if r.Offset() != 16 {
+		t.Errorf("offset expect: %d, actual %d", 16, r.Offset())
+	}
+}
+
+// the round trip of GlobalGNB-ID composed by BitWriter.
+func TestBitReaderGlobalGNBID(t *testing.T) {
+	in := []uint8{0x00, 0x21, 0xf3, 0x54, 0x00, 0x00, 0x00, 0x04}
+	r := NewBitReader(in)
+
+	choice, err := r.DecChoice(0, 2, false)
+	if choice != 0 || err != nil {
+		t.Errorf("choice expect: %d, actual %d", 0, choice)
+	}
+
+	ext, optflag, err := r.DecSequence(true, 1)
+	if ext != false || optflag != 0 || err != nil {
+		t.Errorf("optflag expect: %d, actual %d", 0, optflag)
+	}
+
+	plmn, err := r.DecOctetString(3, 3, false)
+	expect := []uint8{0x21, 0xf3, 0x54}
+	if compareSlice(expect, plmn) == false || err != nil {
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, plmn)
+	}
+
+	choice, err = r.DecChoice(0, 1, false)
+	if choice != 0 || err != nil {
+		t.Errorf("choice expect: %d, actual %d", 0, choice)
+	}
+
+	id, idlen, err := r.DecBitString(22, 32, false)
+	expect = []uint8{0x00, 0x00, 0x01}
+	if idlen != 22 || compareSlice(expect, id) == false || err != nil {
+		t.Errorf("bitlen expect: %d, actual %d", 22, idlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, id)
+	}
+
+	if r.Offset() != 62 {
+		t.Errorf("offset expect: %d, actual %d", 62, r.Offset())
+	}
+}
+
+// SEQUENCE {
+//     a INTEGER (0..7),
+//     ...,
+//     b INTEGER (0..255),
+//     c INTEGER (0..255)
+// }
+func TestExtensionAdditions(t *testing.T) {
+	w := NewBitWriter()
+	w.EncExtendedSequence(0, 0)
+	w.EncInteger(5, 0, 7, false)
+	w.EncExtensionAdditions([][]uint8{{0xc8}, nil})
+	expect := []uint8{0xd0, 0x30, 0x01, 0xc8}
+	//b 1101 0000 0011 0xxx 0000 0001 1100 1000
+	if w.Len() != 32 || compareSlice(expect, w.Bytes()) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 32, w.Len())
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, w.Bytes())
+	}
+
+	r := NewBitReader(expect)
+	ext, _, err := r.DecSequence(true, 0)
+	if ext != true || err != nil {
+		t.Errorf("ext expect: %v, actual %v", true, ext)
+	}
+	a, err := r.DecInteger(0, 7, false)
+	if a != 5 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 5, a)
+	}
+	additions, err := r.DecExtensionAdditions()
+	if len(additions) != 2 || err != nil ||
+		compareSlice([]uint8{0xc8}, additions[0]) == false ||
+		additions[1] != nil {
+		t.Errorf("additions expect: [[0xc8] []], actual %v, %v",
+			additions, err)
+	}
+	b, err := NewBitReader(additions[0]).DecInteger(0, 255, false)
+	if b != 200 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 200, b)
+	}
+	if r.Len() != 0 {
+		t.Errorf("len expect: %d, actual %d", 0, r.Len())
+	}
+
+	// more than 64 additions
+	in := make([][]uint8, 65, 65)
+	in[64] = []uint8{0xab}
+	v, _, _ := EncExtensionAdditions(in)
+	additions, bitlen, err := DecExtensionAdditions(v, 0)
+	if len(additions) != 65 || bitlen != len(v)*8 || err != nil ||
+		additions[0] != nil ||
+		compareSlice([]uint8{0xab}, additions[64]) == false {
+		t.Errorf("DecExtensionAdditions: %v, %v", additions, err)
+	}
+}
+
+// 10.2
+func TestOpenType(t *testing.T) {
+	w := NewBitWriter()
+	w.WriteUint(1, 1)
+	w.EncOpenType(func(w *BitWriter) error {
+		return w.EncInteger(5, 0, 7, false)
+	})
+	expect := []uint8{0x80, 0x01, 0xa0}
+	if w.Len() != 24 || compareSlice(expect, w.Bytes()) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 24, w.Len())
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, w.Bytes())
+	}
+
+	// the empty value has one octet.
+	v, bitlen, _ := EncOpenType(func(w *BitWriter) error { return nil })
+	expect = []uint8{0x01, 0x00}
+	if bitlen != 16 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 16, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	// the value of 128 octets or more has the length of two octets.
+	value := make([]uint8, 200, 200)
+	value[199] = 0xff
+	v, bitlen, _ = EncOpenType(func(w *BitWriter) error {
+		return w.EncOctetString(value, 200, 200, false)
+	})
+	if bitlen != 202*8 || v[0] != 0x80 || v[1] != 0xc8 || v[201] != 0xff {
+		t.Errorf("bitlen expect: %d, actual %d", 202*8, bitlen)
+	}
+
+	r := NewBitReader(v)
+	var decoded []uint8
+	err := r.DecOpenType(func(r *BitReader) (err error) {
+		decoded, err = r.DecOctetString(200, 200, false)
+		return
+	})
+	if compareSlice(value, decoded) == false || err != nil ||
+		r.Offset() != 202*8 {
+		t.Errorf("offset expect: %d, actual %d, %v", 202*8, r.Offset(), err)
+	}
+
+	// the unknown value is skipped.
+	bitlen, err = DecOpenType([]uint8{0x80, 0x01, 0xa0}, 1, nil)
+	if bitlen != 23 || err != nil {
+		t.Errorf("bitlen expect: %d, actual %d", 23, bitlen)
+	}
+
+	// the error of dec leaves the offset.
+	r = NewBitReader([]uint8{0x01, 0xa0})
+	err = r.DecOpenType(func(r *BitReader) (err error) {
+		_, err = r.DecOctetString(2, 2, false)
+		return
+	})
+	var terr *TruncatedError
+	if errors.As(err, &terr) == false || r.Offset() != 0 {
+		t.Errorf("DecOpenType: expect TruncatedError, actual %v", err)
+	}
+}
+
+// the constrained whole number of the range up to 255 is a bit-field
+// which is not octet-aligned.
+func TestBitWriterConstrainedWholeNumber(t *testing.T) {
+	w := NewBitWriter()
+	w.WriteUint(1, 1)
+	w.EncConstrainedWholeNumber(150, 0, 199)
+	expect := []uint8{0xcb, 0x00}
+	//b 1100 1011 0xxx xxxx
+	if w.Len() != 9 || compareSlice(expect, w.Bytes()) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 9, w.Len())
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, w.Bytes())
+	}
+}
+
+func TestUnaligned(t *testing.T) {
+	w := &BitWriter{Variant: Unaligned}
+	w.WriteUint(1, 1)
+	w.EncInteger(200, 0, 255, false)
+	w.EncInteger(5, 0, 100000, false)
+	w.EncOctetString([]uint8{0x61, 0x62}, 0, 10, false)
+	w.EncOpenType(func(w *BitWriter) error {
+		return w.EncInteger(5, 0, 7, false)
+	})
+	w.EncLengthDeterminant(130, 0)
+	w.Align()
+	expect := []uint8{
+		0xe4, 0x00, 0x01, 0x49, 0x85, 0x88, 0x06, 0x82, 0x02, 0x08}
+	//b 1110 0100 0000 0000 0000 0001 0100 1001
+	//b 1000 0101 1000 1000 0000 0110 1000 0010
+	//b 0000 0010 0000 10xx
+	if w.Len() != 78 || compareSlice(expect, w.Bytes()) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 78, w.Len())
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, w.Bytes())
+	}
+
+	r := NewBitReader(expect)
+	r.Variant = Unaligned
+	r.ReadUint(1)
+	v, err := r.DecInteger(0, 255, false)
+	if v != 200 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 200, v)
+	}
+	v, err = r.DecInteger(0, 100000, false)
+	if v != 5 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 5, v)
+	}
+	s, err := r.DecOctetString(0, 10, false)
+	if compareSlice([]uint8{0x61, 0x62}, s) == false || err != nil {
+		t.Errorf("value expect: 0x6162, actual 0x%02x", s)
+	}
+	err = r.DecOpenType(func(r *BitReader) (err error) {
+		v, err = r.DecInteger(0, 7, false)
+		return
+	})
+	if v != 5 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 5, v)
+	}
+	v, err = r.DecLengthDeterminant(0)
+	if v != 130 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 130, v)
+	}
+	if r.Offset() != 78 {
+		t.Errorf("offset expect: %d, actual %d", 78, r.Offset())
+	}
+}
@@ -0,0 +1,762 @@
+// Copyright 2019 hhorai. All rights reserved.
+// Use of this source code is governed by a MIT license that can be found
+// in the LICENSE file.
+
+package per
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// The decoders below are the counterpart of the Enc* functions. Each of
+// them reads one field from the bit offset "offset" of the input and
+// returns the decoded value and the number of bits consumed, including
+// the padding bits skipped for octet alignment. The offset must be counted
+// from the beginning of the outermost encoding because ALIGNED variant
+// aligns fields on its octet boundaries.
+
+// getBits returns n (<= 64) bits from the bit offset off as an unsigned
+// integer.
+func getBits(in []uint8, off, n int) (v uint64, err error) {
+	if off+n > len(in)*8 {
+		err = &TruncatedError{Offset: off, Need: n}
+		return
+	}
+	for i := 0; i < n; i++ {
+		pos := off + i
+		v <<= 1
+		v |= uint64(in[pos/8]>>uint(7-pos%8)) & 0x01
+	}
+	return
+}
+
+// getOctets returns n octets from the bit offset off.
+func getOctets(in []uint8, off, n int) (v []uint8, err error) {
+	if off+n*8 > len(in)*8 {
+		err = &TruncatedError{Offset: off, Need: n * 8}
+		return
+	}
+	v = make([]uint8, n, n)
+	if off%8 == 0 {
+		copy(v, in[off/8:])
+		return
+	}
+	for i := 0; i < n; i++ {
+		b, _ := getBits(in, off+i*8, 8)
+		v[i] = uint8(b)
+	}
+	return
+}
+
+// padding returns the number of bits to the next octet boundary.
+func padding(off int) int {
+	return (8 - off%8) % 8
+}
+
+// DecConstrainedWholeNumber is the counterpart of EncConstrainedWholeNumber.
+// 10.5 Decoding of constrained whole number.
+func DecConstrainedWholeNumber(in []uint8, offset, min, max int) (
+	v, bitlen int, err error) {
+	v, bitlen, err = Aligned.decConstrainedWholeNumber(in, offset, min, max)
+	return
+}
+
+func (variant Variant) decConstrainedWholeNumber(in []uint8,
+	offset, min, max int) (
+	v, bitlen int, err error) {
+
+	if min > max {
+		err = &ConstraintError{Offset: offset, Constraint: fmt.Sprintf(
+			"invalid range min=%d, max=%d", min, max)}
+		return
+	}
+
+	inputRange := max - min + 1
+	var u uint64
+
+	switch {
+	case inputRange == 1: // empty bit-field
+		v = min
+		return
+	case variant == Unaligned: // 10.5.7 the minimum bit-field
+		n := bits.Len64(uint64(max - min))
+		u, err = getBits(in, offset, n)
+		bitlen = n
+	case inputRange < 256: // the bit-field case
+		n := bits.Len(uint(inputRange - 1))
+		u, err = getBits(in, offset, n)
+		bitlen = n
+	case inputRange == 256: // the one-octet case
+		bitlen = variant.padding(offset)
+		u, err = getBits(in, offset+bitlen, 8)
+		bitlen += 8
+	case inputRange <= 65536: // the two-octet case
+		bitlen = variant.padding(offset)
+		u, err = getBits(in, offset+bitlen, 16)
+		bitlen += 16
+	default: // the indefinite length case
+		maxOctets := (bits.Len64(uint64(max-min)) + 7) / 8
+		var n int
+		n, bitlen, err = variant.decConstrainedWholeNumber(in, offset,
+			1, maxOctets)
+		if err != nil {
+			bitlen = 0
+			return
+		}
+		bitlen += variant.padding(offset + bitlen)
+		u, err = getUint(in, offset+bitlen, n)
+		bitlen += n * 8
+	}
+	if err != nil {
+		bitlen = 0
+		return
+	}
+
+	v = int(u) + min
+	if v > max {
+		err = &RangeError{Offset: offset, Value: v, Min: min, Max: max}
+	}
+	return
+}
+
+// getUint returns n octets from the bit offset off as an unsigned integer.
+func getUint(in []uint8, off, n int) (v uint64, err error) {
+	if n > 8 {
+		err = &ConstraintError{Offset: off, Constraint: fmt.Sprintf(
+			"integer of %d octets", n)}
+		return
+	}
+	oct, err := getOctets(in, off, n)
+	if err != nil {
+		return
+	}
+	for _, b := range oct {
+		v = v<<8 | uint64(b)
+	}
+	return
+}
+
+// DecSemiConstrainedWholeNumber is the counterpart of
+// EncSemiConstrainedWholeNumber.
+// 10.7 Encoding of a semi-constrained whole number.
+func DecSemiConstrainedWholeNumber(in []uint8, offset, lb int) (
+	v, bitlen int, err error) {
+	v, bitlen, err = Aligned.decSemiConstrainedWholeNumber(in, offset, lb)
+	return
+}
+
+func (variant Variant) decSemiConstrainedWholeNumber(in []uint8,
+	offset, lb int) (
+	v, bitlen int, err error) {
+
+	n, bitlen, err := variant.decLengthDeterminant(in, offset, 0)
+	if err != nil {
+		return
+	}
+	if n == 0 {
+		err = &RangeError{Offset: offset, Value: 0, Min: 1,
+			Max: NoUpperBound}
+		bitlen = 0
+		return
+	}
+	u, err := getUint(in, offset+bitlen, n)
+	if err != nil {
+		bitlen = 0
+		return
+	}
+	v = int(u) + lb
+	bitlen += n * 8
+	return
+}
+
+// DecUnconstrainedWholeNumber is the counterpart of
+// EncUnconstrainedWholeNumber.
+// 10.8 Encoding of an unconstrained whole number.
+func DecUnconstrainedWholeNumber(in []uint8, offset int) (
+	v, bitlen int, err error) {
+	v, bitlen, err = Aligned.decUnconstrainedWholeNumber(in, offset)
+	return
+}
+
+func (variant Variant) decUnconstrainedWholeNumber(in []uint8, offset int) (
+	v, bitlen int, err error) {
+
+	n, bitlen, err := variant.decLengthDeterminant(in, offset, 0)
+	if err != nil {
+		return
+	}
+	if n == 0 {
+		err = &RangeError{Offset: offset, Value: 0, Min: 1,
+			Max: NoUpperBound}
+		bitlen = 0
+		return
+	}
+	u, err := getUint(in, offset+bitlen, n)
+	if err != nil {
+		bitlen = 0
+		return
+	}
+	// sign extension of 2's-complement
+	shift := uint(64 - n*8)
+	v = int(int64(u<<shift) >> shift)
+	bitlen += n * 8
+	return
+}
+
+// DecNormallySmallWholeNumber is the counterpart of
+// EncNormallySmallWholeNumber.
+// 10.6 Encoding of a normally small non-negative whole number
+func DecNormallySmallWholeNumber(in []uint8, offset int) (
+	v, bitlen int, err error) {
+	v, bitlen, err = Aligned.decNormallySmallWholeNumber(in, offset)
+	return
+}
+
+func (variant Variant) decNormallySmallWholeNumber(in []uint8, offset int) (
+	v, bitlen int, err error) {
+
+	u, err := getBits(in, offset, 7)
+	if err != nil {
+		return
+	}
+	if u&0x40 == 0 {
+		v = int(u)
+		bitlen = 7
+		return
+	}
+	v, bitlen, err = variant.decSemiConstrainedWholeNumber(in, offset+1, 0)
+	if err != nil {
+		return
+	}
+	bitlen++
+	return
+}
+
+// DecLengthDeterminant is the counterpart of EncLengthDeterminant.
+// 10.9 General rules for encoding a length determinant
+//
+// The value of 16K or more is the size of a fragment, which is followed by
+// the length determinant of the rest. See BitReader.DecFragments.
+func DecLengthDeterminant(in []uint8, offset, max int) (
+	v, bitlen int, err error) {
+	v, bitlen, err = Aligned.decLengthDeterminant(in, offset, max)
+	return
+}
+
+func (variant Variant) decLengthDeterminant(in []uint8, offset, max int) (
+	v, bitlen int, err error) {
+
+	if max != 0 && max < 65536 {
+		v, bitlen, err = variant.decConstrainedWholeNumber(in, offset,
+			0, max)
+		return
+	}
+
+	pad := variant.padding(offset)
+	u, err := getBits(in, offset+pad, 8)
+	if err != nil {
+		return
+	}
+
+	switch {
+	case u&0x80 == 0:
+		v = int(u)
+		bitlen = pad + 8
+		return
+	case u&0xc0 == 0x80:
+		var u2 uint64
+		u2, err = getBits(in, offset+pad+8, 8)
+		if err != nil {
+			return
+		}
+		v = int(u&0x3f)<<8 | int(u2)
+		bitlen = pad + 16
+		return
+	case u&0x3f >= 1 && u&0x3f <= 4:
+		// 10.9.3.8.1 the fragment of 16K, 32K, 48K or 64K items
+		v = int(u&0x3f) * 16384
+		bitlen = pad + 8
+		return
+	}
+	err = &ConstraintError{Offset: offset + pad, Constraint: fmt.Sprintf(
+		"fragment header=0x%02x", u)}
+	return
+}
+
+func (variant Variant) decConstrainedWholeNumberWithExtmark(in []uint8,
+	offset, min, max int,
+	extmark bool) (v, bitlen int, err error) {
+
+	if extmark == true {
+		var ext uint64
+		ext, err = getBits(in, offset, 1)
+		if err != nil {
+			return
+		}
+		if ext == 1 {
+			err = &ConstraintError{Offset: offset,
+				Constraint: "value out of extension root"}
+			return
+		}
+		bitlen = 1
+	}
+
+	v, n, err := variant.decConstrainedWholeNumber(in, offset+bitlen, min,
+		max)
+	if err != nil {
+		bitlen = 0
+		return
+	}
+	bitlen += n
+	return
+}
+
+// DecInteger is the counterpart of EncInteger.
+// 12. Encoding the integer type
+// for the constrained integer. The value out of extension root is
+// returned as it is if extmark is true.
+func DecInteger(in []uint8, offset, min, max int, extmark bool) (
+	v, bitlen int, err error) {
+	v, bitlen, err = Aligned.decInteger(in, offset, min, max, extmark)
+	return
+}
+
+func (variant Variant) decInteger(in []uint8,
+	offset, min, max int, extmark bool) (
+	v, bitlen int, err error) {
+
+	if extmark == true {
+		var ext uint64
+		ext, err = getBits(in, offset, 1)
+		if err != nil {
+			return
+		}
+		bitlen = 1
+		if ext == 1 {
+			// 12.1 the value out of extension root
+			var n int
+			v, n, err = variant.decUnconstrainedWholeNumber(in,
+				offset+bitlen)
+			if err != nil {
+				bitlen = 0
+				return
+			}
+			bitlen += n
+			return
+		}
+	}
+
+	if min == max { // 12.2.1 single value
+		v = min
+		return
+	}
+
+	// 12.2.2 constrained whole number
+	v, n, err := variant.decConstrainedWholeNumber(in, offset+bitlen, min,
+		max)
+	if err != nil {
+		bitlen = 0
+		return
+	}
+	bitlen += n
+	return
+}
+
+// decIndexWithExtmark is the counterpart of BitWriter.encIndexWithExtmark.
+func (variant Variant) decIndexWithExtmark(in []uint8,
+	offset, min, max int, extmark bool) (
+	v, bitlen int, err error) {
+
+	if extmark == true {
+		var ext uint64
+		ext, err = getBits(in, offset, 1)
+		if err != nil {
+			return
+		}
+		if ext == 1 {
+			// 13.3 and 22.8 the index out of extension root
+			v, bitlen, err = variant.decNormallySmallWholeNumber(in,
+				offset+1)
+			if err != nil {
+				return
+			}
+			v += max + 1
+			bitlen++
+			return
+		}
+	}
+	v, bitlen, err =
+		variant.decConstrainedWholeNumberWithExtmark(in, offset, min,
+			max, extmark)
+	return
+}
+
+// DecEnumerated is the counterpart of EncEnumerated. The value more than
+// max is out of extension root.
+// 13. Encoding the enumerated type
+func DecEnumerated(in []uint8, offset, min, max int, extmark bool) (
+	v, bitlen int, err error) {
+	v, bitlen, err = Aligned.decEnumerated(in, offset, min, max, extmark)
+	return
+}
+
+func (variant Variant) decEnumerated(in []uint8,
+	offset, min, max int, extmark bool) (
+	v, bitlen int, err error) {
+	v, bitlen, err = variant.decIndexWithExtmark(in, offset, min, max,
+		extmark)
+	return
+}
+
+// decSizeWithExtmark reads the extension bit and the length of a string
+// type whose size is constrained by min and max. fixed is true when the
+// length is not encoded because the size is fixed. unconstrained is true
+// when the length is encoded as the unconstrained length determinant, in
+// which case nothing but the extension bit is read and the caller has to
+// read the length with the items by BitReader.DecFragments.
+func (variant Variant) decSizeWithExtmark(in []uint8,
+	offset, min, max int, extmark bool) (
+	size, bitlen int, fixed, unconstrained bool, err error) {
+
+	if extmark == true {
+		var ext uint64
+		ext, err = getBits(in, offset, 1)
+		if err != nil {
+			return
+		}
+		bitlen = 1
+		if ext == 1 {
+			// the size is out of extension root.
+			unconstrained = true
+			return
+		}
+	}
+
+	if min == max && max < 65537 {
+		size = min
+		fixed = true
+		return
+	}
+
+	if max >= 65536 {
+		unconstrained = true
+		return
+	}
+
+	size, n, err := variant.decConstrainedWholeNumber(in, offset+bitlen,
+		min, max)
+	if err != nil {
+		bitlen = 0
+		return
+	}
+	bitlen += n
+	return
+}
+
+// DecBitString is the counterpart of EncBitString.
+// 15. Encoding the bitstering type
+//
+// The bits are returned in the same form as the input of EncBitString,
+// i.e. right-aligned in the returned octets, and vlen is its length in bits.
+func DecBitString(in []uint8, offset, min, max int, extmark bool) (
+	v []uint8, vlen, bitlen int, err error) {
+	v, vlen, bitlen, err = Aligned.decBitString(in, offset, min, max,
+		extmark)
+	return
+}
+
+func (variant Variant) decBitString(in []uint8,
+	offset, min, max int, extmark bool) (
+	v []uint8, vlen, bitlen int, err error) {
+
+	if min > max {
+		err = &ConstraintError{Offset: offset, Constraint: fmt.Sprintf(
+			"invalid range min=%d, max=%d", min, max)}
+		return
+	}
+
+	vlen, bitlen, fixed, unconstrained, err :=
+		variant.decSizeWithExtmark(in, offset, min, max, extmark)
+	if err != nil {
+		return
+	}
+
+	if unconstrained == true {
+		r := &BitReader{Variant: variant, buf: in, off: offset + bitlen}
+		var w BitWriter
+		vlen, err = r.DecFragments(func(n int) (err error) {
+			u, err := r.ReadBits(n)
+			w.WriteBits(u, n)
+			return
+		})
+		if err != nil {
+			vlen, bitlen = 0, 0
+			return
+		}
+		bitlen = r.off - offset
+		v = w.Bytes()
+		v = ShiftRight(v, len(v)*8-vlen)
+		return
+	}
+
+	switch {
+	case vlen == 0:
+	case fixed == true && vlen < 17:
+	case vlen < 65537:
+		bitlen += variant.padding(offset + bitlen)
+	default:
+		err = &ConstraintError{Offset: offset, Constraint: fmt.Sprintf(
+			"size of %d bits", vlen)}
+		bitlen = 0
+		return
+	}
+
+	v = make([]uint8, (vlen+7)/8, (vlen+7)/8)
+	for i := 0; i < vlen; i += 8 {
+		n := 8
+		if vlen-i < 8 {
+			n = vlen - i
+		}
+		var u uint64
+		u, err = getBits(in, offset+bitlen+i, n)
+		if err != nil {
+			v = nil
+			bitlen = 0
+			return
+		}
+		v[i/8] = uint8(u << uint(8-n))
+	}
+	bitlen += vlen
+	v = ShiftRight(v, len(v)*8-vlen)
+	return
+}
+
+// DecOctetString is the counterpart of EncOctetString.
+// 16. Encoding the octetstring type
+func DecOctetString(in []uint8, offset, min, max int, extmark bool) (
+	v []uint8, bitlen int, err error) {
+	v, bitlen, err = Aligned.decOctetString(in, offset, min, max, extmark)
+	return
+}
+
+func (variant Variant) decOctetString(in []uint8,
+	offset, min, max int, extmark bool) (
+	v []uint8, bitlen int, err error) {
+
+	if min > max {
+		err = &ConstraintError{Offset: offset, Constraint: fmt.Sprintf(
+			"invalid range min=%d, max=%d", min, max)}
+		return
+	}
+
+	size, bitlen, fixed, unconstrained, err :=
+		variant.decSizeWithExtmark(in, offset, min, max, extmark)
+	if err != nil {
+		return
+	}
+
+	if unconstrained == true {
+		r := &BitReader{Variant: variant, buf: in, off: offset + bitlen}
+		v = []uint8{}
+		_, err = r.DecFragments(func(n int) (err error) {
+			u, err := r.ReadOctets(n)
+			v = append(v, u...)
+			return
+		})
+		if err != nil {
+			v, bitlen = nil, 0
+			return
+		}
+		bitlen = r.off - offset
+		return
+	}
+
+	switch {
+	case size == 0:
+	case fixed == true && size < 3:
+	case size < 65537:
+		bitlen += variant.padding(offset + bitlen)
+	default:
+		err = &ConstraintError{Offset: offset, Constraint: fmt.Sprintf(
+			"size of %d octets", size)}
+		bitlen = 0
+		return
+	}
+
+	v, err = getOctets(in, offset+bitlen, size)
+	if err != nil {
+		bitlen = 0
+		return
+	}
+	bitlen += size * 8
+	return
+}
+
+// DecSequence is the counterpart of EncSequence. It returns the extension
+// bit and the presence bit-map of OPTIONAL components. The first OPTIONAL
+// component is the most significant bit of optflag.
+// 18. Encoding the sequence type
+func DecSequence(in []uint8, offset int, extmark bool, optnum int) (
+	ext bool, optflag uint, bitlen int, err error) {
+
+	if optnum > bits.UintSize {
+		err = &ConstraintError{Offset: offset, Constraint: fmt.Sprintf(
+			"%d OPTIONAL components (should be <= %d)",
+			optnum, bits.UintSize)}
+		return
+	}
+
+	if extmark == true {
+		var u uint64
+		u, err = getBits(in, offset, 1)
+		if err != nil {
+			return
+		}
+		ext = u == 1
+		bitlen = 1
+	}
+
+	u, err := getBits(in, offset+bitlen, optnum)
+	if err != nil {
+		bitlen = 0
+		return
+	}
+	optflag = uint(u)
+	bitlen += optnum
+	return
+}
+
+// decNormallySmallLength is the counterpart of
+// BitWriter.encNormallySmallLength.
+// 10.9.3.4 normally small length
+func (variant Variant) decNormallySmallLength(in []uint8, offset int) (
+	v, bitlen int, err error) {
+
+	u, err := getBits(in, offset, 7)
+	if err != nil {
+		return
+	}
+	if u&0x40 == 0 {
+		v = int(u) + 1
+		bitlen = 7
+		return
+	}
+	v, bitlen, err = variant.decLengthDeterminant(in, offset+1, 0)
+	if err != nil {
+		bitlen = 0
+		return
+	}
+	if v >= 16384 {
+		err = &ConstraintError{Offset: offset, Constraint: fmt.Sprintf(
+			"%d extension additions", v)}
+		v, bitlen = 0, 0
+		return
+	}
+	bitlen++
+	return
+}
+
+// DecExtensionAdditions is the counterpart of EncExtensionAdditions. It
+// returns the complete encodings of extension additions in the order of
+// definition, and nil for the absent ones. The additions unknown to the
+// caller can be ignored.
+// 18.7 - 18.9 the encoding of extension additions
+func DecExtensionAdditions(in []uint8, offset int) (
+	additions [][]uint8, bitlen int, err error) {
+	additions, bitlen, err = Aligned.decExtensionAdditions(in, offset)
+	return
+}
+
+func (variant Variant) decExtensionAdditions(in []uint8, offset int) (
+	additions [][]uint8, bitlen int, err error) {
+
+	n, bitlen, err := variant.decNormallySmallLength(in, offset)
+	if err != nil {
+		return
+	}
+
+	r := &BitReader{Variant: variant, buf: in, off: offset + bitlen}
+	present, err := r.ReadBits(n)
+	if err != nil {
+		bitlen = 0
+		return
+	}
+
+	additions = make([][]uint8, n, n)
+	for i := range additions {
+		if present[i/8]&(0x80>>uint(i%8)) == 0 {
+			continue
+		}
+		additions[i], err = r.decOpenType()
+		if err != nil {
+			additions, bitlen = nil, 0
+			return
+		}
+	}
+	bitlen = r.off - offset
+	return
+}
+
+// DecOpenType is the counterpart of EncOpenType. See BitReader.DecOpenType
+// for dec.
+// 10.2 Open type fields
+func DecOpenType(in []uint8, offset int, dec func(r *BitReader) error) (
+	bitlen int, err error) {
+	r := &BitReader{buf: in, off: offset}
+	err = r.DecOpenType(dec)
+	if err != nil {
+		return
+	}
+	bitlen = r.off - offset
+	return
+}
+
+// DecSequenceOf is the counterpart of EncSequenceOf. It returns the number
+// of components.
+// 19. Encoding the sequence-of type
+// If max is 64K or more, the number of 16K or more is the size of the
+// first fragment. Use BitReader.DecFragments to read such components.
+func DecSequenceOf(in []uint8, offset, min, max int, extmark bool) (
+	v, bitlen int, err error) {
+	v, bitlen, err = Aligned.decSequenceOf(in, offset, min, max, extmark)
+	return
+}
+
+func (variant Variant) decSequenceOf(in []uint8,
+	offset, min, max int, extmark bool) (
+	v, bitlen int, err error) {
+
+	v, bitlen, fixed, unconstrained, err :=
+		variant.decSizeWithExtmark(in, offset, min, max, extmark)
+	if err != nil || fixed == true || unconstrained == false {
+		return
+	}
+
+	n := 0
+	v, n, err = variant.decLengthDeterminant(in, offset+bitlen, 0)
+	if err != nil {
+		bitlen = 0
+		return
+	}
+	bitlen += n
+	return
+}
+
+// DecChoice is the counterpart of EncChoice. It returns the index of the
+// chosen alternative, which is more than max for the alternative out of
+// extension root. Its value follows as the open type, see DecOpenType.
+// 22. Encoding the choice type
+func DecChoice(in []uint8, offset, min, max int, extmark bool) (
+	v, bitlen int, err error) {
+	v, bitlen, err = Aligned.decChoice(in, offset, min, max, extmark)
+	return
+}
+
+func (variant Variant) decChoice(in []uint8,
+	offset, min, max int, extmark bool) (
+	v, bitlen int, err error) {
+	v, bitlen, err = variant.decIndexWithExtmark(in, offset, min, max,
+		extmark)
+	return
+}
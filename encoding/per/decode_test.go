@@ -0,0 +1,418 @@
+package per
+
+import (
+	"errors"
+	"testing"
+)
+
+// 10.5
+func TestDecConstrainedWholeNumber(t *testing.T) {
+	v, bitlen, err := DecConstrainedWholeNumber([]uint8{0x20}, 0, 0, 7)
+	if v != 1 || bitlen != 3 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 1, v)
+		t.Errorf("bitlen expect: %d, actual %d", 3, bitlen)
+	}
+
+	// the one-octet case is octet-aligned.
+	v, bitlen, err = DecConstrainedWholeNumber([]uint8{0x00, 0x80}, 3, 0, 255)
+	if v != 128 || bitlen != 13 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 128, v)
+		t.Errorf("bitlen expect: %d, actual %d", 13, bitlen)
+	}
+
+	v, bitlen, err = DecConstrainedWholeNumber([]uint8{0x01, 0x00}, 0,
+		0, 65535)
+	if v != 256 || bitlen != 16 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 256, v)
+		t.Errorf("bitlen expect: %d, actual %d", 16, bitlen)
+	}
+
+	v, bitlen, err = DecConstrainedWholeNumber(nil, 0, 5, 5)
+	if v != 5 || bitlen != 0 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 5, v)
+		t.Errorf("bitlen expect: %d, actual %d", 0, bitlen)
+	}
+
+	// b11 is out of range for 0..2
+	_, _, err = DecConstrainedWholeNumber([]uint8{0xc0}, 0, 0, 2)
+	var rerr *RangeError
+	if errors.As(err, &rerr) == false || rerr.Value != 3 {
+		t.Errorf("DecConstrainedWholeNumber: expect RangeError, actual %v",
+			err)
+	}
+
+	// the indefinite length case, e.g.
+	// AMF-UE-NGAP-ID ::= INTEGER (0..1099511627775)
+	in := []uint8{0x80, 0xff, 0xff, 0xff, 0xff, 0xff}
+	v, bitlen, err = DecConstrainedWholeNumber(in, 0, 0, 1099511627775)
+	if v != 1099511627775 || bitlen != 48 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 1099511627775, v)
+		t.Errorf("bitlen expect: %d, actual %d", 48, bitlen)
+	}
+
+	in = []uint8{0x01, 0x12, 0x34}
+	v, bitlen, err = DecConstrainedWholeNumber(in, 6, 0, 4294967295)
+	if v != 0x1234 || bitlen != 18 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 0x1234, v)
+		t.Errorf("bitlen expect: %d, actual %d", 18, bitlen)
+	}
+
+	_, _, err = DecConstrainedWholeNumber([]uint8{0x01}, 0, 0, 65535)
+	var terr *TruncatedError
+	if errors.As(err, &terr) == false || terr.Need != 16 {
+		t.Errorf("DecConstrainedWholeNumber: "+
+			"expect TruncatedError, actual %v", err)
+	}
+}
+
+// 10.7
+func TestDecSemiConstrainedWholeNumber(t *testing.T) {
+	v, bitlen, err := DecSemiConstrainedWholeNumber([]uint8{0x00, 0x02,
+		0x01, 0x00}, 1, 1)
+	if v != 257 || bitlen != 31 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 257, v)
+		t.Errorf("bitlen expect: %d, actual %d", 31, bitlen)
+	}
+
+	_, _, err = DecSemiConstrainedWholeNumber([]uint8{0x00}, 0, 0)
+	if err == nil {
+		t.Errorf("DecSemiConstrainedWholeNumber: unexpected success")
+	}
+
+	_, _, err = DecSemiConstrainedWholeNumber([]uint8{0x02, 0x01}, 0, 0)
+	var terr *TruncatedError
+	if errors.As(err, &terr) == false {
+		t.Errorf("DecSemiConstrainedWholeNumber: "+
+			"expect TruncatedError, actual %v", err)
+	}
+}
+
+// 10.8
+func TestDecUnconstrainedWholeNumber(t *testing.T) {
+	for _, in := range []int{0, 127, 128, -1, -128, -129, 1 << 40} {
+		e, _, _ := EncUnconstrainedWholeNumber(in)
+		v, bitlen, err := DecUnconstrainedWholeNumber(e, 0)
+		if v != in || bitlen != len(e)*8 || err != nil {
+			t.Errorf("value expect: %d, actual %d", in, v)
+			t.Errorf("bitlen expect: %d, actual %d", len(e)*8, bitlen)
+		}
+	}
+}
+
+// 10.9
+func TestDecLengthDeterminant(t *testing.T) {
+	v, bitlen, err := DecLengthDeterminant([]uint8{0x01}, 0, 255)
+	if v != 1 || bitlen != 8 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 1, v)
+		t.Errorf("bitlen expect: %d, actual %d", 8, bitlen)
+	}
+
+	v, bitlen, err = DecLengthDeterminant([]uint8{0x01}, 0, 0)
+	if v != 1 || bitlen != 8 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 1, v)
+		t.Errorf("bitlen expect: %d, actual %d", 8, bitlen)
+	}
+
+	v, bitlen, err = DecLengthDeterminant([]uint8{0x00, 0xbf, 0xff}, 1, 0)
+	if v != 16383 || bitlen != 23 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 16383, v)
+		t.Errorf("bitlen expect: %d, actual %d", 23, bitlen)
+	}
+
+	// 10.9.3.8 the header of a fragment
+	v, bitlen, err = DecLengthDeterminant([]uint8{0x80, 0xc3}, 1, 0)
+	if v != 49152 || bitlen != 15 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 49152, v)
+		t.Errorf("bitlen expect: %d, actual %d", 15, bitlen)
+	}
+
+	_, _, err = DecLengthDeterminant([]uint8{0xc5}, 0, 0)
+	if err == nil {
+		t.Errorf("DecLengthDeterminant: unexpected success")
+	}
+
+	_, _, err = DecLengthDeterminant([]uint8{0x80}, 0, 0)
+	var terr *TruncatedError
+	if errors.As(err, &terr) == false {
+		t.Errorf("DecLengthDeterminant: expect TruncatedError, actual %v",
+			err)
+	}
+}
+
+// 12
+func TestDecInteger(t *testing.T) {
+	v, bitlen, err := DecInteger(nil, 0, 2, 2, false)
+	if v != 2 || bitlen != 0 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 2, v)
+		t.Errorf("bitlen expect: %d, actual %d", 0, bitlen)
+	}
+
+	v, bitlen, err = DecInteger([]uint8{0x00}, 0, 2, 2, true)
+	if v != 2 || bitlen != 1 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 2, v)
+		t.Errorf("bitlen expect: %d, actual %d", 1, bitlen)
+	}
+
+	v, bitlen, err = DecInteger([]uint8{0x10}, 0, 0, 7, true)
+	if v != 1 || bitlen != 4 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 1, v)
+		t.Errorf("bitlen expect: %d, actual %d", 4, bitlen)
+	}
+
+	v, bitlen, err = DecInteger([]uint8{0x00, 128}, 0, 0, 255, true)
+	if v != 128 || bitlen != 16 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 128, v)
+		t.Errorf("bitlen expect: %d, actual %d", 16, bitlen)
+	}
+
+	// the value out of extension root
+	v, bitlen, err = DecInteger([]uint8{0x80, 0x01, 0x03}, 0, 0, 2, true)
+	if v != 3 || bitlen != 24 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 3, v)
+		t.Errorf("bitlen expect: %d, actual %d", 24, bitlen)
+	}
+
+	// the round trip with EncInteger
+	for _, in := range []int{-1, 256, 1 << 40} {
+		e, _, _ := EncInteger(in, 0, 255, true)
+		v, bitlen, err = DecInteger(e, 0, 0, 255, true)
+		if v != in || bitlen != len(e)*8 || err != nil {
+			t.Errorf("value expect: %d, actual %d", in, v)
+		}
+	}
+	for _, in := range []int{0, 1, 1 << 32, 1099511627775} {
+		e, elen, _ := EncInteger(in, 0, 1099511627775, false)
+		v, bitlen, err = DecInteger(e, 0, 0, 1099511627775, false)
+		if v != in || bitlen != elen || err != nil {
+			t.Errorf("value expect: %d, actual %d", in, v)
+		}
+	}
+	for _, in := range []int{0, 1, 127, 255} {
+		e, _, _ := EncInteger(in, 0, 255, false)
+		v, bitlen, err = DecInteger(e, 0, 0, 255, false)
+		if v != in || bitlen != 8 || err != nil {
+			t.Errorf("value expect: %d, actual %d", in, v)
+		}
+	}
+}
+
+// 13
+func TestDecEnumerated(t *testing.T) {
+	v, bitlen, err := DecEnumerated([]uint8{0x80}, 0, 0, 2, false)
+	if v != 2 || bitlen != 2 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 2, v)
+		t.Errorf("bitlen expect: %d, actual %d", 2, bitlen)
+	}
+
+	v, bitlen, err = DecEnumerated([]uint8{0x20}, 0, 0, 2, true)
+	if v != 1 || bitlen != 3 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 1, v)
+		t.Errorf("bitlen expect: %d, actual %d", 3, bitlen)
+	}
+
+	// the first value out of extension root
+	v, bitlen, err = DecEnumerated([]uint8{0x80}, 0, 0, 2, true)
+	if v != 3 || bitlen != 8 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 3, v)
+		t.Errorf("bitlen expect: %d, actual %d", 8, bitlen)
+	}
+
+	v, bitlen, err = DecEnumerated([]uint8{0x00, 0x81}, 8, 0, 2, true)
+	if v != 4 || bitlen != 8 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 4, v)
+		t.Errorf("bitlen expect: %d, actual %d", 8, bitlen)
+	}
+
+	_, _, err = DecEnumerated([]uint8{0x01}, 7, 0, 2, true)
+	var terr *TruncatedError
+	if errors.As(err, &terr) == false {
+		t.Errorf("DecEnumerated: expect TruncatedError, actual %v", err)
+	}
+}
+
+// 15
+func TestDecBitString(t *testing.T) {
+	// fixed length up to 16 bits is not octet-aligned.
+	v, vlen, bitlen, err := DecBitString([]uint8{0x80, 0x20}, 1,
+		10, 10, false)
+	expect := []uint8{0x00, 0x01}
+	if vlen != 10 || bitlen != 10 || err != nil ||
+		compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 10, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	// fixed length of more than 16 bits is octet-aligned.
+	in := []uint8{0x80, 0x12, 0x34, 0x56, 0x78, 0x90}
+	v, vlen, bitlen, err = DecBitString(in, 1, 36, 36, false)
+	expect = []uint8{0x01, 0x23, 0x45, 0x67, 0x89}
+	if vlen != 36 || bitlen != 43 || err != nil ||
+		compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 43, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	// fixed length of more than 64K bits is fragmented.
+	pv, plen, _ := EncBitString(make([]uint8, 8193), 65537,
+		65537, 65537, false)
+	v, vlen, bitlen, err = DecBitString(pv, 0, 65537, 65537, false)
+	if vlen != 65537 || bitlen != plen || err != nil || len(v) != 8193 {
+		t.Errorf("bitlen expect: %d, actual %d", plen, bitlen)
+	}
+
+	// gNB-ID BIT STRING (SIZE(22..32)) which has 24 bits after
+	// the choice index.
+	in = []uint8{0x10, 0x00, 0x01, 0x02}
+	v, vlen, bitlen, err = DecBitString(in, 1, 22, 32, false)
+	expect = []uint8{0x00, 0x01, 0x02}
+	if vlen != 24 || bitlen != 31 || err != nil ||
+		compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 31, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	in = []uint8{0x10, 0x00, 0x00, 0x04}
+	v, vlen, bitlen, err = DecBitString(in, 0, 22, 32, false)
+	expect = []uint8{0x00, 0x00, 0x02}
+	if vlen != 23 || bitlen != 31 || err != nil ||
+		compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 31, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	// the round trip with EncBitString
+	e, _, _ := EncBitString([]uint8{0x00, 0x10}, 16, 0, 255, false)
+	v, vlen, bitlen, err = DecBitString(e, 0, 0, 255, false)
+	expect = []uint8{0x00, 0x10}
+	if vlen != 16 || bitlen != 24 || err != nil ||
+		compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 24, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	_, _, _, err = DecBitString(in[:3], 0, 22, 32, false)
+	var terr *TruncatedError
+	if errors.As(err, &terr) == false {
+		t.Errorf("DecBitString: expect TruncatedError, actual %v", err)
+	}
+}
+
+// 16
+func TestDecOctetString(t *testing.T) {
+	// PLMNIdentity OCTET STRING (SIZE(3)) after 4 bits of preamble.
+	in := []uint8{0x00, 0x21, 0xf3, 0x54}
+	v, bitlen, err := DecOctetString(in, 4, 3, 3, false)
+	expect := []uint8{0x21, 0xf3, 0x54}
+	if bitlen != 28 || err != nil || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 28, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	// fixed length up to 2 octets is not octet-aligned.
+	in = []uint8{0x00, 0xc0, 0x00}
+	v, bitlen, err = DecOctetString(in, 0, 2, 2, true)
+	expect = []uint8{0x01, 0x80}
+	if bitlen != 17 || err != nil || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 17, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	// the round trip with EncOctetString
+	for _, n := range []int{0, 3, 7} {
+		oct := make([]uint8, n, n)
+		for i := range oct {
+			oct[i] = uint8(i + 1)
+		}
+		pv, plen, ov, _ := EncOctetString(oct, 0, 7, true)
+		e := append([]uint8{}, pv...)
+		e = append(e, ov...)
+		v, bitlen, err = DecOctetString(e, 0, 0, 7, true)
+		expectlen := plen
+		if n != 0 {
+			expectlen = 8 + n*8
+		}
+		if bitlen != expectlen || err != nil ||
+			compareSlice(oct, v) == false {
+			t.Errorf("bitlen expect: %d, actual %d", expectlen, bitlen)
+			t.Errorf("value expect: 0x%02x, actual 0x%02x", oct, v)
+		}
+	}
+
+	_, _, err = DecOctetString([]uint8{0x30, 0x00}, 0, 0, 7, true)
+	var terr *TruncatedError
+	if errors.As(err, &terr) == false {
+		t.Errorf("DecOctetString: expect TruncatedError, actual %v", err)
+	}
+}
+
+// 18
+func TestDecSequence(t *testing.T) {
+	ext, optflag, bitlen, err := DecSequence([]uint8{0x40}, 0, true, 2)
+	if ext != false || optflag != 0x02 || bitlen != 3 || err != nil {
+		t.Errorf("optflag expect: 0x%02x, actual 0x%02x", 0x02, optflag)
+		t.Errorf("bitlen expect: %d, actual %d", 3, bitlen)
+	}
+
+	ext, optflag, bitlen, err = DecSequence([]uint8{0x80}, 0, true, 1)
+	if ext != true || optflag != 0 || bitlen != 2 || err != nil {
+		t.Errorf("ext expect: %v, actual %v", true, ext)
+		t.Errorf("bitlen expect: %d, actual %d", 2, bitlen)
+	}
+
+	// the round trip with EncSequence
+	pv, plen, _ := EncSequence(true, 7, 0x55)
+	ext, optflag, bitlen, err = DecSequence(pv, 0, true, 7)
+	if ext != false || optflag != 0x55 || bitlen != plen || err != nil {
+		t.Errorf("optflag expect: 0x%02x, actual 0x%02x", 0x55, optflag)
+		t.Errorf("bitlen expect: %d, actual %d", plen, bitlen)
+	}
+}
+
+// 19
+func TestDecSequenceOf(t *testing.T) {
+	// maxnoofTACs is 256, so the number is octet-aligned.
+	v, bitlen, err := DecSequenceOf([]uint8{0x00, 0x00}, 4, 1, 256, false)
+	if v != 1 || bitlen != 12 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 1, v)
+		t.Errorf("bitlen expect: %d, actual %d", 12, bitlen)
+	}
+}
+
+// 22
+func TestDecChoice(t *testing.T) {
+	// NGAP-PDU: initiatingMessage, successfulOutcome, unsuccessfulOutcome
+	pv, _, _ := EncChoice(1, 0, 2, true)
+	v, bitlen, err := DecChoice(pv, 0, 0, 2, true)
+	if v != 1 || bitlen != 3 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 1, v)
+		t.Errorf("bitlen expect: %d, actual %d", 3, bitlen)
+	}
+
+	// the alternative out of extension root with its value.
+	w := NewBitWriter()
+	w.EncChoice(3, 0, 2, true)
+	w.EncOpenType(func(w *BitWriter) error {
+		return w.EncInteger(200, 0, 255, false)
+	})
+	v, bitlen, err = DecChoice(w.Bytes(), 0, 0, 2, true)
+	if v != 3 || bitlen != 8 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 3, v)
+		t.Errorf("bitlen expect: %d, actual %d", 8, bitlen)
+	}
+	n, err := DecOpenType(w.Bytes(), bitlen, nil)
+	if bitlen+n != w.Len() || err != nil {
+		t.Errorf("bitlen expect: %d, actual %d", w.Len(), bitlen+n)
+	}
+
+	v, bitlen, err = DecChoice([]uint8{0xc0, 0x01, 0x40}, 0, 0, 0, true)
+	if v != 65 || bitlen != 24 || err != nil {
+		t.Errorf("value expect: %d, actual %d", 65, v)
+		t.Errorf("bitlen expect: %d, actual %d", 24, bitlen)
+	}
+
+	_, _, err = DecChoice(nil, 0, 0, 2, true)
+	var terr *TruncatedError
+	if errors.As(err, &terr) == false {
+		t.Errorf("DecChoice: expect TruncatedError, actual %v", err)
+	}
+}
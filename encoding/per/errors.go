@@ -0,0 +1,115 @@
+// Copyright 2019 hhorai. All rights reserved.
+// Use of this source code is governed by a MIT license that can be found
+// in the LICENSE file.
+
+package per
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TruncatedError is returned by the decoders when the input ends before
+// the value has been read completely.
+type TruncatedError struct {
+	Offset int // bit offset of the field which could not be read
+	Need   int // number of bits needed from Offset
+}
+
+func (e *TruncatedError) Error() string {
+	return fmt.Sprintf("per: truncated input: "+
+		"need %d bits at bit offset %d", e.Need, e.Offset)
+}
+
+// RangeError is returned when the value, or the size of the value, does
+// not satisfy the constraint given by the caller. Max is NoUpperBound if
+// the value has only the lower bound.
+type RangeError struct {
+	Offset int // bit offset of the field
+	Value  int
+	Min    int
+	Max    int
+}
+
+func (e *RangeError) Error() string {
+	if e.Max == NoUpperBound {
+		return fmt.Sprintf("per: value=%d at bit offset %d is out of "+
+			"range. (should be %d <= value)", e.Value, e.Offset, e.Min)
+	}
+	return fmt.Sprintf("per: value=%d at bit offset %d is out of range. "+
+		"(should be %d <= %d)", e.Value, e.Offset, e.Min, e.Max)
+}
+
+// ConstraintError is returned when the constraint, or the value under the
+// constraint, is not supported by the encoders and the decoders, e.g. the
+// SEQUENCE which has more OPTIONAL components than the bits of uint.
+type ConstraintError struct {
+	Offset     int    // bit offset of the field
+	Constraint string // description of the unsupported constraint
+}
+
+func (e *ConstraintError) Error() string {
+	return fmt.Sprintf("per: unsupported constraint at bit offset %d: %s",
+		e.Offset, e.Constraint)
+}
+
+// ExtensionError is returned when the extension bit or the extension
+// additions are not valid, e.g. the extension bit of the type which is
+// not extensible.
+type ExtensionError struct {
+	Offset int    // bit offset of the field
+	Reason string // description of the invalid extension
+}
+
+func (e *ExtensionError) Error() string {
+	return fmt.Sprintf("per: invalid extension at bit offset %d: %s",
+		e.Offset, e.Reason)
+}
+
+// MissingIEError is returned by the decoders of the protocol IE containers
+// when the mandatory IE is not in the container.
+type MissingIEError struct {
+	IE string // name of the missing IE
+}
+
+func (e *MissingIEError) Error() string {
+	return "per: missing mandatory IE " + e.IE
+}
+
+// FieldError records the ASN.1 path of the field which caused Err, e.g.
+// NGSetupRequest.protocolIEs[1].value.SupportedTAList[0].tAC. The bit
+// offset is in Err, which is usually one of the errors above. The offset
+// in the value of an open type is the one from the beginning of the value.
+type FieldError struct {
+	Path string
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	return "per: " + e.Path + ": " + strings.TrimPrefix(e.Err.Error(), "per: ")
+}
+
+// Unwrap returns the underlying error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// WithPath prepends name to the path of err, where name is the name of
+// the component or the type, or the index like "[1]". err is wrapped by
+// FieldError if it does not have the path yet. WithPath returns nil if err
+// is nil.
+func WithPath(err error, name string) error {
+	if err == nil {
+		return nil
+	}
+	e, ok := err.(*FieldError)
+	if ok == false {
+		return &FieldError{Path: name, Err: err}
+	}
+	if strings.HasPrefix(e.Path, "[") {
+		e.Path = name + e.Path
+	} else {
+		e.Path = name + "." + e.Path
+	}
+	return e
+}
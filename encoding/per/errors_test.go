@@ -0,0 +1,158 @@
+package per
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWithPath(t *testing.T) {
+	if WithPath(nil, "a") != nil {
+		t.Errorf("expect nil for nil error")
+	}
+
+	err := error(&RangeError{Offset: 3, Value: 8, Min: 0, Max: 7})
+	for _, name := range []string{"tAC", "[0]", "SupportedTAList", "value",
+		"[1]", "protocolIEs", "NGSetupRequest"} {
+		err = WithPath(err, name)
+	}
+	var ferr *FieldError
+	if errors.As(err, &ferr) == false {
+		t.Fatalf("expect FieldError, actual %v", err)
+	}
+	expect := "NGSetupRequest.protocolIEs[1].value.SupportedTAList[0].tAC"
+	if ferr.Path != expect {
+		t.Errorf("expect: %s, actual %s", expect, ferr.Path)
+	}
+	var rerr *RangeError
+	if errors.As(err, &rerr) == false || rerr.Offset != 3 {
+		t.Errorf("expect RangeError, actual %v", err)
+	}
+	expect = "per: " + expect + ": value=8 at bit offset 3 is out of range. " +
+		"(should be 0 <= 7)"
+	if err.Error() != expect {
+		t.Errorf("expect: %s, actual %s", expect, err.Error())
+	}
+
+	err = WithPath(&MissingIEError{IE: "GlobalRANNodeID"}, "protocolIEs")
+	var merr *MissingIEError
+	if errors.As(err, &merr) == false || merr.IE != "GlobalRANNodeID" {
+		t.Errorf("expect MissingIEError, actual %v", err)
+	}
+	expect = "per: protocolIEs: missing mandatory IE GlobalRANNodeID"
+	if err.Error() != expect {
+		t.Errorf("expect: %s, actual %s", expect, err.Error())
+	}
+}
+
+func TestEncodeError(t *testing.T) {
+	w := NewBitWriter()
+	w.WriteUint(0, 5)
+
+	var rerr *RangeError
+	err := w.EncInteger(8, 0, 7, false)
+	if errors.As(err, &rerr) == false || rerr.Offset != 5 ||
+		rerr.Value != 8 {
+		t.Errorf("EncInteger: expect RangeError, actual %v", err)
+	}
+	err = w.EncOctetString([]uint8{0x01}, 3, 3, false)
+	if errors.As(err, &rerr) == false || rerr.Value != 1 {
+		t.Errorf("EncOctetString: expect RangeError, actual %v", err)
+	}
+	err = w.EncSemiConstrainedWholeNumber(-1, 0)
+	if errors.As(err, &rerr) == false || rerr.Max != NoUpperBound {
+		t.Errorf("EncSemiConstrainedWholeNumber: "+
+			"expect RangeError, actual %v", err)
+	}
+
+	var cerr *ConstraintError
+	err = w.EncSequence(true, 65, 0)
+	if errors.As(err, &cerr) == false || cerr.Offset != 5 {
+		t.Errorf("EncSequence: expect ConstraintError, actual %v", err)
+	}
+
+	err = w.EncPrintableString("@", "", 1, 1, false)
+	if errors.As(err, &cerr) == false || cerr.Offset != 5 {
+		t.Errorf("EncPrintableString: "+
+			"expect ConstraintError, actual %v", err)
+	}
+	err = w.EncBitString([]uint8{0x01}, 9, 0, 16, false)
+	if errors.As(err, &rerr) == false || rerr.Value != 8 {
+		t.Errorf("EncBitString: expect RangeError, actual %v", err)
+	}
+	_, _, err = EncConstrainedWholeNumber(8, 0, 7)
+	if errors.As(err, &rerr) == false || rerr.Value != 8 {
+		t.Errorf("EncConstrainedWholeNumber: "+
+			"expect RangeError, actual %v", err)
+	}
+
+	var eerr *ExtensionError
+	err = w.EncExtensionAdditions(nil)
+	if errors.As(err, &eerr) == false {
+		t.Errorf("EncExtensionAdditions: "+
+			"expect ExtensionError, actual %v", err)
+	}
+	if w.Len() != 5 {
+		t.Errorf("len expect: %d, actual %d", 5, w.Len())
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	cases := []struct {
+		name   string
+		in     []uint8
+		dec    func(r *BitReader) error
+		target interface{}
+	}{
+		{"truncated", []uint8{0x01}, func(r *BitReader) (err error) {
+			_, err = r.DecConstrainedWholeNumber(0, 65535)
+			return
+		}, new(*TruncatedError)},
+		{"out of range", []uint8{0xc0}, func(r *BitReader) (err error) {
+			_, err = r.DecConstrainedWholeNumber(0, 2)
+			return
+		}, new(*RangeError)},
+		{"invalid range", []uint8{0x00}, func(r *BitReader) (err error) {
+			_, err = r.DecConstrainedWholeNumber(1, 0)
+			return
+		}, new(*ConstraintError)},
+		{"zero length", []uint8{0x00}, func(r *BitReader) (err error) {
+			_, err = r.DecSemiConstrainedWholeNumber(0)
+			return
+		}, new(*RangeError)},
+		{"zero length", []uint8{0x00}, func(r *BitReader) (err error) {
+			_, err = r.DecUnconstrainedWholeNumber()
+			return
+		}, new(*RangeError)},
+		{"fragment header", []uint8{0xc5}, func(r *BitReader) (err error) {
+			_, err = r.DecLengthDeterminant(0)
+			return
+		}, new(*ConstraintError)},
+		{"invalid size", []uint8{0x00}, func(r *BitReader) (err error) {
+			_, _, err = r.DecBitString(2, 1, false)
+			return
+		}, new(*ConstraintError)},
+		{"invalid size", []uint8{0x00}, func(r *BitReader) (err error) {
+			_, err = r.DecOctetString(2, 1, false)
+			return
+		}, new(*ConstraintError)},
+		{"invalid alphabet", []uint8{0x41}, func(r *BitReader) (err error) {
+			_, err = r.DecPrintableString("!", 1, 1, false)
+			return
+		}, new(*ConstraintError)},
+		{"invalid character", []uint8{0x21}, func(r *BitReader) (err error) {
+			_, err = r.DecPrintableString("", 1, 1, false)
+			return
+		}, new(*ConstraintError)},
+		{"invalid UTF-8", []uint8{0x01, 0xff}, func(r *BitReader) (err error) {
+			_, err = r.DecUTF8String()
+			return
+		}, new(*ConstraintError)},
+	}
+	for i, c := range cases {
+		err := c.dec(NewBitReader(c.in))
+		if errors.As(err, c.target) == false {
+			t.Errorf("%d %s: expect %T, actual %v", i, c.name,
+				c.target, err)
+		}
+	}
+}
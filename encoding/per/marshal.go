@@ -0,0 +1,529 @@
+// Copyright 2019 hhorai. All rights reserved.
+// Use of this source code is governed by a MIT license that can be found
+// in the LICENSE file.
+
+package per
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Marshaler is the interface implemented by types that can encode
+// themselves into PER, e.g. the code generated for ASN.1 types.
+type Marshaler interface {
+	MarshalPER(w *BitWriter) error
+}
+
+// Unmarshaler is the interface implemented by types that can decode
+// themselves from PER.
+type Unmarshaler interface {
+	UnmarshalPER(r *BitReader) error
+}
+
+// Extensible is embedded in the struct of SEQUENCE or CHOICE which has the
+// extension marker "...". It is the same as "ext" in the tag, and is
+// useful for the type of SEQUENCE OF components which has no tag.
+type Extensible struct{}
+
+// Choice is embedded in the struct of CHOICE. It is the same as "choice"
+// in the tag.
+type Choice struct{}
+
+// BitString is the value of BIT STRING. The BitLength bits are
+// right-aligned in Bytes, i.e. the same form as the input of EncBitString.
+type BitString struct {
+	Bytes     []uint8
+	BitLength int
+}
+
+var (
+	bitStringType  = reflect.TypeOf(BitString{})
+	extensibleType = reflect.TypeOf(Extensible{})
+	choiceType     = reflect.TypeOf(Choice{})
+	bytesType      = reflect.TypeOf([]uint8{})
+)
+
+// NoUpperBound is the upper bound of size which has no constraint, e.g.
+// OCTET STRING without SIZE. It is more than 64K, so the length is encoded
+// as unconstrained.
+const NoUpperBound = math.MaxInt32
+
+// fieldParameters is the parsed representation of the tag string of a
+// struct field, e.g. `per:"sizeLB=1,sizeUB=12,ext,optional"`.
+type fieldParameters struct {
+	valueLB, valueUB       int
+	hasValueLB, hasValueUB bool
+	sizeLB, sizeUB         int
+	hasSizeUB              bool
+	ext                    bool // the type has the extension marker.
+	optional               bool // OPTIONAL component of SEQUENCE.
+	extension              bool // component or alternative after "...".
+	choice                 bool // the struct is CHOICE.
+	enum                   bool // the integer is ENUMERATED.
+	stringType             string
+	opentype               bool             // the value is wrapped as the open type.
+	elem                   *fieldParameters // components of SEQUENCE OF.
+}
+
+func parseFieldParameters(str string) (params fieldParameters, err error) {
+	for _, part := range strings.Split(str, ",") {
+		part = strings.TrimSpace(part)
+		key, value := part, ""
+		if i := strings.IndexByte(part, '='); i >= 0 {
+			key, value = part[:i], part[i+1:]
+		}
+
+		var n int
+		switch key {
+		case "valueLB", "valueUB", "sizeLB", "sizeUB":
+			n, err = strconv.Atoi(value)
+			if err != nil {
+				err = fmt.Errorf("per: invalid tag %q: %v", part, err)
+				return
+			}
+		}
+
+		switch key {
+		case "":
+		case "valueLB":
+			params.valueLB, params.hasValueLB = n, true
+		case "valueUB":
+			params.valueUB, params.hasValueUB = n, true
+		case "sizeLB":
+			params.sizeLB = n
+		case "sizeUB":
+			params.sizeUB, params.hasSizeUB = n, true
+		case "ext":
+			params.ext = true
+		case "optional":
+			params.optional = true
+		case "extension":
+			params.extension = true
+		case "choice":
+			params.choice = true
+		case "enum":
+			params.enum = true
+		case "printable", "visible", "ia5", "utf8":
+			params.stringType = key
+		case "opentype":
+			params.opentype = true
+		default:
+			err = fmt.Errorf("per: unknown tag %q", part)
+			return
+		}
+	}
+	return
+}
+
+func (params fieldParameters) sizeRange() (min, max int) {
+	min, max = params.sizeLB, NoUpperBound
+	if params.hasSizeUB == true {
+		max = params.sizeUB
+	}
+	return
+}
+
+// structField is an exported field of the struct for SEQUENCE or CHOICE.
+type structField struct {
+	index  int
+	name   string
+	params fieldParameters
+}
+
+// structFields returns the fields of SEQUENCE or CHOICE in the extension
+// root and the ones after the extension marker. The markers embedded in
+// the struct are reflected to params.
+func structFields(t reflect.Type, params *fieldParameters) (
+	root, additions []structField, err error) {
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		switch sf.Type {
+		case extensibleType:
+			params.ext = true
+			continue
+		case choiceType:
+			params.choice = true
+			continue
+		}
+		if sf.PkgPath != "" { // unexported
+			continue
+		}
+		var fp fieldParameters
+		fp, err = parseFieldParameters(sf.Tag.Get("per"))
+		if err != nil {
+			return
+		}
+		if tag, ok := sf.Tag.Lookup("elem"); ok {
+			var ep fieldParameters
+			if ep, err = parseFieldParameters(tag); err != nil {
+				return
+			}
+			fp.elem = &ep
+		}
+		f := structField{index: i, name: sf.Name, params: fp}
+		if fp.extension == true {
+			additions = append(additions, f)
+		} else {
+			root = append(root, f)
+		}
+	}
+	return
+}
+
+// isPresent returns false for nil value of OPTIONAL component or CHOICE
+// alternative.
+func isPresent(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Interface, reflect.Map:
+		return v.IsNil() == false
+	}
+	return true
+}
+
+// Marshal returns the PER ALIGNED encoding of v.
+//
+// The Go types are encoded as the following ASN.1 types, and the
+// constraints are given by the struct tag with the key "per".
+//
+//   - bool: BOOLEAN
+//   - int, intN, uint and uintN: INTEGER with valueLB and valueUB, or
+//     ENUMERATED with enum and valueUB (the number of root values - 1).
+//     The value more than valueUB of ENUMERATED is the extension value.
+//   - []byte: OCTET STRING with sizeLB and sizeUB
+//   - BitString: BIT STRING with sizeLB and sizeUB
+//   - string: PrintableString, or the one given by visible, ia5 or utf8
+//   - other slices: SEQUENCE OF with sizeLB and sizeUB. The parameters of
+//     the components are given by the tag with the key "elem", e.g.
+//     `per:"sizeLB=1,sizeUB=16" elem:"valueLB=0,valueUB=255"`.
+//   - struct: SEQUENCE, or CHOICE with choice. The OPTIONAL components
+//     and the alternatives of CHOICE are pointers or slices, and nil is
+//     absent. The ones tagged with extension are after "...".
+//   - pointer: the value it points to
+//
+// ext marks the extensible constraint or type, and opentype wraps the
+// value in the open type. An empty struct can be used for NULL. The types
+// implementing Marshaler encode themselves.
+func Marshal(v interface{}) ([]byte, error) {
+	return MarshalWithParams(v, "")
+}
+
+// MarshalWithParams allows field parameters to be specified for the
+// top-level value. The form of the params is the same as the field tags.
+func MarshalWithParams(v interface{}, params string) (b []byte, err error) {
+	return marshal(v, params, Aligned)
+}
+
+// MarshalWithVariant returns the PER encoding of v in the variant, e.g.
+// Unaligned for RRC.
+func MarshalWithVariant(v interface{}, variant Variant) ([]byte, error) {
+	return marshal(v, "", variant)
+}
+
+func marshal(v interface{}, params string, variant Variant) (b []byte,
+	err error) {
+
+	w := NewBitWriter()
+	w.Variant = variant
+	err = w.EncValue(v, params)
+	if err != nil {
+		return
+	}
+	b = w.Bytes()
+	if len(b) == 0 {
+		// 10.1.3 the complete encoding has at least one octet.
+		b = []uint8{0x00}
+	}
+	return
+}
+
+// EncValue writes v as Marshal does. params is the same form as the field
+// tags.
+func (w *BitWriter) EncValue(v interface{}, params string) (err error) {
+	fp, err := parseFieldParameters(params)
+	if err != nil {
+		return
+	}
+	err = w.marshalValue(reflect.ValueOf(v), fp)
+	return
+}
+
+func (w *BitWriter) marshalValue(v reflect.Value, params fieldParameters) (
+	err error) {
+
+	if v.IsValid() == false {
+		err = fmt.Errorf("per: cannot marshal nil value")
+		return
+	}
+
+	if params.opentype == true {
+		if v.Type() == bytesType {
+			// the complete encoding is given as it is.
+			err = w.encOpenType(v.Bytes())
+			return
+		}
+		params.opentype = false
+		err = w.EncOpenType(func(ow *BitWriter) error {
+			return ow.marshalValue(v, params)
+		})
+		return
+	}
+
+	if v.Kind() != reflect.Ptr && v.CanInterface() {
+		if m, ok := v.Interface().(Marshaler); ok {
+			err = m.MarshalPER(w)
+			return
+		}
+		if v.CanAddr() {
+			if m, ok := v.Addr().Interface().(Marshaler); ok {
+				err = m.MarshalPER(w)
+				return
+			}
+		}
+	}
+
+	if v.Type() == bitStringType {
+		bs := v.Interface().(BitString)
+		min, max := params.sizeRange()
+		err = w.EncBitString(bs.Bytes, bs.BitLength, min, max, params.ext)
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			err = fmt.Errorf("per: cannot marshal nil %s", v.Type())
+			return
+		}
+		err = w.marshalValue(v.Elem(), params)
+	case reflect.Bool:
+		err = w.EncBoolean(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		err = w.marshalInteger(int(v.Int()), params)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		if v.Uint() > math.MaxInt64 {
+			err = fmt.Errorf("per: %d is too large to marshal", v.Uint())
+			return
+		}
+		err = w.marshalInteger(int(v.Uint()), params)
+	case reflect.String:
+		err = w.marshalString(v.String(), params)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			min, max := params.sizeRange()
+			err = w.EncOctetString(v.Bytes(), min, max, params.ext)
+			return
+		}
+		err = w.marshalSequenceOf(v, params)
+	case reflect.Struct:
+		err = w.marshalStruct(v, params)
+	default:
+		err = fmt.Errorf("per: unsupported type %s", v.Type())
+	}
+	return
+}
+
+func (w *BitWriter) marshalInteger(input int, params fieldParameters) (
+	err error) {
+
+	switch {
+	case params.enum == true:
+		err = w.EncEnumerated(input, 0, params.valueUB, params.ext)
+	case params.hasValueLB == true && params.hasValueUB == true:
+		err = w.EncInteger(input, params.valueLB, params.valueUB,
+			params.ext)
+	case params.hasValueLB == true:
+		if params.ext == true {
+			w.writeBit(0)
+		}
+		err = w.EncSemiConstrainedWholeNumber(input, params.valueLB)
+	default:
+		if params.ext == true {
+			w.writeBit(0)
+		}
+		err = w.EncUnconstrainedWholeNumber(input)
+	}
+	return
+}
+
+func (w *BitWriter) marshalString(input string, params fieldParameters) (
+	err error) {
+
+	min, max := params.sizeRange()
+	switch params.stringType {
+	case "", "printable":
+		err = w.EncPrintableString(input, "", min, max, params.ext)
+	case "visible":
+		err = w.EncVisibleString(input, "", min, max, params.ext)
+	case "ia5":
+		err = w.EncIA5String(input, "", min, max, params.ext)
+	case "utf8":
+		err = w.EncUTF8String(input)
+	}
+	return
+}
+
+func (w *BitWriter) marshalSequenceOf(v reflect.Value,
+	params fieldParameters) (err error) {
+
+	var elem fieldParameters
+	if params.elem != nil {
+		elem = *params.elem
+	}
+	put := func(from, to int) error {
+		for i := from; i < to; i++ {
+			err := w.marshalValue(v.Index(i), elem)
+			if err != nil {
+				return WithPath(err, fmt.Sprintf("[%d]", i))
+			}
+		}
+		return nil
+	}
+
+	n := v.Len()
+	min, max := params.sizeRange()
+	if max < 65536 {
+		err = w.EncSequenceOf(n, min, max, params.ext)
+		if err != nil {
+			return
+		}
+		err = put(0, n)
+		return
+	}
+
+	if n < min {
+		err = &RangeError{Offset: w.len, Value: n, Min: min,
+			Max: NoUpperBound}
+		return
+	}
+	if params.ext == true {
+		w.writeBit(0)
+	}
+	err = w.EncFragments(n, put)
+	return
+}
+
+func (w *BitWriter) marshalStruct(v reflect.Value, params fieldParameters) (
+	err error) {
+
+	root, additions, err := structFields(v.Type(), &params)
+	if err != nil {
+		return
+	}
+	if params.choice == true {
+		err = w.marshalChoice(v, root, additions, params)
+		return
+	}
+
+	// 18. Encoding the sequence type
+	optnum, optflag := 0, uint(0)
+	for _, f := range root {
+		if f.params.optional == false {
+			continue
+		}
+		optnum++
+		optflag <<= 1
+		if isPresent(v.Field(f.index)) {
+			optflag |= 1
+		}
+	}
+
+	var encs [][]uint8
+	for i, f := range additions {
+		fv := v.Field(f.index)
+		if isPresent(fv) == false {
+			continue
+		}
+		if encs == nil {
+			encs = make([][]uint8, len(additions), len(additions))
+		}
+		aw := BitWriter{Variant: w.Variant}
+		err = aw.marshalValue(fv, f.params)
+		if err != nil {
+			err = WithPath(err, f.name)
+			return
+		}
+		encs[i] = append([]uint8{}, aw.Bytes()...)
+	}
+	if encs != nil && params.ext == false {
+		err = &ExtensionError{Offset: w.len, Reason: fmt.Sprintf(
+			"%s has extension additions but is not extensible",
+			v.Type())}
+		return
+	}
+
+	if encs != nil {
+		err = w.EncExtendedSequence(optnum, optflag)
+	} else {
+		err = w.EncSequence(params.ext, optnum, optflag)
+	}
+	if err != nil {
+		return
+	}
+
+	for _, f := range root {
+		fv := v.Field(f.index)
+		if f.params.optional == true && isPresent(fv) == false {
+			continue
+		}
+		err = w.marshalValue(fv, f.params)
+		if err != nil {
+			err = WithPath(err, f.name)
+			return
+		}
+	}
+
+	if encs != nil {
+		err = w.EncExtensionAdditions(encs)
+	}
+	return
+}
+
+func (w *BitWriter) marshalChoice(v reflect.Value, root,
+	additions []structField, params fieldParameters) (err error) {
+
+	// 22. Encoding the choice type
+	chosen := -1
+	var alt structField
+	fields := make([]structField, 0, len(root)+len(additions))
+	fields = append(append(fields, root...), additions...)
+	for i, f := range fields {
+		if isPresent(v.Field(f.index)) == false {
+			continue
+		}
+		if chosen >= 0 {
+			err = &ConstraintError{Offset: w.len, Constraint: fmt.Sprintf(
+				"%s has more than one alternative", v.Type())}
+			return
+		}
+		chosen, alt = i, f
+	}
+	if chosen < 0 {
+		err = &ConstraintError{Offset: w.len, Constraint: fmt.Sprintf(
+			"%s has no alternative", v.Type())}
+		return
+	}
+	if chosen >= len(root) && params.ext == false {
+		err = &ExtensionError{Offset: w.len, Reason: fmt.Sprintf(
+			"%s has extension alternatives but is not extensible",
+			v.Type())}
+		return
+	}
+
+	err = w.EncChoice(chosen, 0, len(root)-1, params.ext)
+	if err != nil {
+		return
+	}
+	if chosen >= len(root) {
+		alt.params.opentype = true
+	}
+	err = w.marshalValue(v.Field(alt.index), alt.params)
+	err = WithPath(err, alt.name)
+	return
+}
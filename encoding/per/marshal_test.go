@@ -0,0 +1,285 @@
+package per
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+/*
+S-NSSAI ::= SEQUENCE {
+    sST           SST,
+    sD            SD                                                  OPTIONAL,
+    iE-Extensions ProtocolExtensionContainer { { S-NSSAI-ExtIEs} }    OPTIONAL,
+    ...
+}
+SST ::= OCTET STRING (SIZE(1))
+SD ::= OCTET STRING (SIZE(3))
+*/
+type testSNSSAI struct {
+	Extensible
+	SST          []uint8  `per:"sizeLB=1,sizeUB=1"`
+	SD           []uint8  `per:"sizeLB=3,sizeUB=3,optional"`
+	IEExtensions *[]uint8 `per:"optional"`
+}
+
+type testSliceSupportItem struct {
+	SNSSAI       testSNSSAI
+	IEExtensions *[]uint8 `per:"optional"`
+}
+
+type testChoice struct {
+	Choice
+	Extensible
+	A *int `per:"valueLB=0,valueUB=7"`
+	B *bool
+	C *bool `per:"extension"`
+}
+
+type testExtendedSequence struct {
+	A bool
+	B *int `per:"valueLB=0,valueUB=255,extension"`
+}
+
+type testMarshaler struct {
+	v int
+}
+
+func (m testMarshaler) MarshalPER(w *BitWriter) error {
+	return w.EncConstrainedWholeNumber(m.v, 0, 3)
+}
+
+func (m *testMarshaler) UnmarshalPER(r *BitReader) (err error) {
+	m.v, err = r.DecConstrainedWholeNumber(0, 3)
+	return
+}
+
+type testValues struct {
+	Enum     int             `per:"enum,valueUB=2,ext"`
+	Int      int8            `per:"valueLB=-5,valueUB=5"`
+	Uint     uint32          `per:"valueLB=0"`
+	Name     string          `per:"sizeLB=1,sizeUB=150,ext"`
+	Bits     BitString       `per:"sizeLB=22,sizeUB=32"`
+	List     []testMarshaler `per:"sizeLB=1,sizeUB=4"`
+	Null     struct{}
+	Raw      []uint8 `per:"opentype"`
+	Wrapped  *int    `per:"opentype,valueLB=0,valueUB=1000,optional"`
+	Fallback *int    `per:"optional"`
+}
+
+func TestMarshal(t *testing.T) {
+	one, two, five := 1, 2, 5
+	yes := true
+	cases := []struct {
+		name   string
+		input  interface{}
+		params string
+		expect []uint8
+	}{
+		{
+			"SliceSupportItem",
+			&testSliceSupportItem{SNSSAI: testSNSSAI{
+				SST: []uint8{1}, SD: []uint8{0, 0, 123}}},
+			"ext",
+			[]uint8{0x10, 0x08, 0x00, 0x00, 0x7b},
+		},
+		{
+			"CHOICE in the extension root",
+			testChoice{B: &yes}, "",
+			[]uint8{0x60},
+		},
+		{
+			"CHOICE extension alternative",
+			testChoice{C: &yes}, "",
+			[]uint8{0x80, 0x01, 0x80},
+		},
+		{
+			"SEQUENCE extension addition",
+			testExtendedSequence{A: true, B: &five}, "ext",
+			[]uint8{0xc0, 0x40, 0x01, 0x05},
+		},
+		{
+			"SEQUENCE without extension additions",
+			testExtendedSequence{A: true}, "ext",
+			[]uint8{0x40},
+		},
+		{
+			"ENUMERATED",
+			one, "enum,valueUB=2,ext",
+			[]uint8{0x20},
+		},
+		{
+			"empty encoding",
+			struct{}{}, "",
+			[]uint8{0x00},
+		},
+		{
+			"values",
+			testValues{Enum: 4, Int: -5, Uint: 300, Name: "gNB",
+				Bits: BitString{[]uint8{0x3f, 0xff, 0xff}, 22},
+				List: []testMarshaler{{1}, {2}, {3}},
+				Raw:  []uint8{0xab}, Wrapped: &two},
+			"",
+			[]uint8{
+				0xa0, 0x40, // preamble, Enum and Int
+				0x02, 0x01, 0x2c, // Uint
+				0x01, 0x00, 0x67, 0x4e, 0x42, // Name
+				0x00, 0xff, 0xff, 0xfe, 0x6c, // Bits and List
+				0x01, 0xab, // Raw
+				0x02, 0x00, 0x02, // Wrapped
+			},
+		},
+	}
+	for _, c := range cases {
+		v, err := MarshalWithParams(c.input, c.params)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+			continue
+		}
+		if compareSlice(c.expect, v) == false {
+			t.Errorf("%s: expect: 0x%02x, actual 0x%02x",
+				c.name, c.expect, v)
+			continue
+		}
+
+		out := reflect.New(reflect.TypeOf(c.input))
+		err = UnmarshalWithParams(v, out.Interface(), c.params)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+			continue
+		}
+		if reflect.DeepEqual(c.input, out.Elem().Interface()) == false {
+			t.Errorf("%s: expect: %+v, actual %+v",
+				c.name, c.input, out.Elem().Interface())
+		}
+	}
+}
+
+// List ::= SEQUENCE (SIZE(1..4)) OF INTEGER (0..255)
+type testElements struct {
+	List []int `per:"sizeLB=1,sizeUB=4" elem:"valueLB=0,valueUB=255"`
+}
+
+func TestMarshalWithVariant(t *testing.T) {
+	input := testElements{List: []int{1, 2}}
+	cases := []struct {
+		variant Variant
+		expect  []uint8
+	}{
+		{Aligned, []uint8{0x40, 0x01, 0x02}},
+		{Unaligned, []uint8{0x40, 0x40, 0x80}},
+	}
+	for _, c := range cases {
+		v, err := MarshalWithVariant(input, c.variant)
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", c.variant, err)
+			continue
+		}
+		if compareSlice(c.expect, v) == false {
+			t.Errorf("%d: expect: 0x%02x, actual 0x%02x",
+				c.variant, c.expect, v)
+			continue
+		}
+		var out testElements
+		err = UnmarshalWithVariant(v, &out, c.variant)
+		if err != nil || reflect.DeepEqual(input, out) == false {
+			t.Errorf("%d: expect: %+v, actual %+v, %v",
+				c.variant, input, out, err)
+		}
+	}
+
+	_, err := Marshal(testElements{List: []int{256}})
+	var rerr *RangeError
+	if errors.As(err, &rerr) == false || rerr.Value != 256 {
+		t.Errorf("expect RangeError, actual %v", err)
+	}
+	_, err = Marshal(struct {
+		List []int `elem:"valueLB=zero"`
+	}{})
+	if err == nil {
+		t.Errorf("invalid elem tag: expect error")
+	}
+}
+
+func TestUnmarshalUnknownExtension(t *testing.T) {
+	// the alternative after C is unknown.
+	var c testChoice
+	err := Unmarshal([]uint8{0x82, 0x01, 0x80}, &c)
+	if err != nil || c.A != nil || c.B != nil || c.C != nil {
+		t.Errorf("unexpected result: %+v, %v", c, err)
+	}
+
+	// the extension addition after B is unknown.
+	var s testExtendedSequence
+	err = UnmarshalWithParams(
+		[]uint8{0xc1, 0x60, 0x01, 0x05, 0x01, 0x00}, &s, "ext")
+	if err != nil || s.A != true || s.B == nil || *s.B != 5 {
+		t.Errorf("unexpected result: %+v, %v", s, err)
+	}
+}
+
+func TestMarshalError(t *testing.T) {
+	yes := true
+	cases := []struct {
+		name   string
+		input  interface{}
+		params string
+	}{
+		{"no alternative", testChoice{}, ""},
+		{"two alternatives", testChoice{B: &yes, C: &yes}, ""},
+		{"not extensible", testExtendedSequence{B: new(int)}, ""},
+		{"unknown tag", 0, "valueLB=0,unknown"},
+		{"invalid number", 0, "valueLB=zero"},
+		{"out of range", 8, "valueLB=0,valueUB=7"},
+		{"nil", nil, ""},
+		{"unsupported type", 1.0, ""},
+	}
+	for _, c := range cases {
+		if _, err := MarshalWithParams(c.input, c.params); err == nil {
+			t.Errorf("%s: expect error", c.name)
+		}
+	}
+	var cerr *ConstraintError
+	for _, c := range cases[:2] {
+		_, err := MarshalWithParams(c.input, c.params)
+		if errors.As(err, &cerr) == false {
+			t.Errorf("%s: expect ConstraintError, actual %v", c.name, err)
+		}
+	}
+
+	var v int8
+	err := UnmarshalWithParams([]uint8{0x01, 0xff}, &v, "valueLB=0")
+	var rerr *RangeError
+	if errors.As(err, &rerr) == false || rerr.Value != 255 ||
+		rerr.Min != -128 || rerr.Max != 127 {
+		t.Errorf("overflow: expect RangeError, actual %v", err)
+	}
+	var u uint16
+	err = UnmarshalWithParams([]uint8{0x01, 0x00}, &u, "valueLB=-1")
+	if errors.As(err, &rerr) == false || rerr.Value != -1 ||
+		rerr.Min != 0 || rerr.Max != 65535 {
+		t.Errorf("overflow: expect RangeError, actual %v", err)
+	}
+	if err := Unmarshal([]uint8{0x00}, v); err == nil {
+		t.Errorf("not pointer: expect error")
+	}
+}
+
+func TestMarshalErrorPath(t *testing.T) {
+	v := testValues{
+		Name: "gNB",
+		Bits: BitString{Bytes: []uint8{0x00, 0x00, 0x01}, BitLength: 22},
+		List: []testMarshaler{{0}, {4}},
+	}
+	_, err := Marshal(v)
+	var ferr *FieldError
+	if errors.As(err, &ferr) == false || ferr.Path != "List[1]" {
+		t.Errorf("expect FieldError of List[1], actual %v", err)
+	}
+
+	_, err = Marshal(testExtendedSequence{B: new(int)})
+	var eerr *ExtensionError
+	if errors.As(err, &eerr) == false {
+		t.Errorf("expect ExtensionError, actual %v", err)
+	}
+}
@@ -106,9 +106,7 @@ func EncConstrainedWholeNumber(input, min, max int) (
 	v []uint8, bitlen int, err error) {
 
 	if input < min || input > max {
-		err = fmt.Errorf("EncConstrainedWholeNumber: "+
-			"input value=%d is out of range. "+
-			"(should be %d <= %d)", input, min, max)
+		err = &RangeError{Value: input, Min: min, Max: max}
 		return
 	}
 
@@ -119,8 +117,7 @@ func EncConstrainedWholeNumber(input, min, max int) (
 	case inputRange == 1: // empty bit-field
 		return
 	case inputRange < 256: // the bit-field case
-		bitlen = bits.Len(uint(inputRange))
-		//v = append(v, uint8(inputEnc << uint((8 - bitlen))))
+		bitlen = bits.Len(uint(inputRange - 1))
 		v = append(v, uint8(inputEnc))
 		return
 	case inputRange == 256: // the one-octet case
@@ -132,19 +129,89 @@ func EncConstrainedWholeNumber(input, min, max int) (
 		v = append(v, uint8((inputEnc>>8)&0xff))
 		v = append(v, uint8(inputEnc&0xff))
 		return
-	case inputRange > 65537: // the indefinite length case
-		// not implemented yet
-		err = fmt.Errorf("EncConstrainedWholeNumber: "+
-			"not implemented yet for %d", input)
+	case inputRange > 65536: // the indefinite length case
+		// v has the length of octets in the leading bits, and then the
+		// octet-aligned value follows.
+		w := NewBitWriter()
+		err = w.EncConstrainedWholeNumber(input, min, max)
+		v, bitlen = w.Bytes(), w.Len()
 		return
 	}
-	err = fmt.Errorf("EncConstrainedWholeNumber: "+
-		"invalid range min=%d, max=%d", min, max)
+	err = &ConstraintError{Constraint: fmt.Sprintf(
+		"invalid range min=%d, max=%d", min, max)}
+	return
+}
+
+// nonNegativeBinaryInteger returns the input in the minimum number of
+// octets, but at least one octet.
+func nonNegativeBinaryInteger(input uint64) (v []uint8) {
+	n := (bits.Len64(input) + 7) / 8
+	if n == 0 {
+		n = 1
+	}
+	v = make([]uint8, n, n)
+	for i := 0; i < n; i++ {
+		v[n-1-i] = uint8(input >> uint(i*8))
+	}
+	return
+}
+
+// twosComplementBinaryInteger returns the input in the minimum number of
+// octets of 2's-complement.
+func twosComplementBinaryInteger(input int64) (v []uint8) {
+	n := 1
+	for ; n < 8; n++ {
+		lim := int64(1) << uint(n*8-1)
+		if input >= -lim && input < lim {
+			break
+		}
+	}
+	v = make([]uint8, n, n)
+	for i := 0; i < n; i++ {
+		v[n-1-i] = uint8(input >> uint(i*8))
+	}
+	return
+}
+
+// EncSemiConstrainedWholeNumber is the implementation for
+// 10.7 Encoding of a semi-constrained whole number.
+// The returned value is the length octet followed by the value octets.
+func EncSemiConstrainedWholeNumber(input, lb int) (
+	v []uint8, bitlen int, err error) {
+	w := NewBitWriter()
+	err = w.EncSemiConstrainedWholeNumber(input, lb)
+	v, bitlen = w.Bytes(), w.Len()
+	return
+}
+
+// EncUnconstrainedWholeNumber is the implementation for
+// 10.8 Encoding of an unconstrained whole number.
+// The returned value is the length octet followed by the value octets.
+func EncUnconstrainedWholeNumber(input int) (
+	v []uint8, bitlen int, err error) {
+	w := NewBitWriter()
+	err = w.EncUnconstrainedWholeNumber(input)
+	v, bitlen = w.Bytes(), w.Len()
+	return
+}
+
+// EncNormallySmallWholeNumber is the implementation for
+// 10.6 Encoding of a normally small non-negative whole number
+func EncNormallySmallWholeNumber(input int) (
+	v []uint8, bitlen int, err error) {
+	w := NewBitWriter()
+	err = w.EncNormallySmallWholeNumber(input)
+	v, bitlen = w.Bytes(), w.Len()
 	return
 }
 
 // EncLengthDeterminant is the implementation for
 // 10.9 General rules for encoding a length determinant
+//
+// If max is 0 or not less than 64K and input is 16K or more, only the
+// header of the first fragment is returned (10.9.3.8). The caller has to
+// put FragmentSize(input) items after it and encode the length of the rest
+// again, see BitWriter.EncFragments.
 func EncLengthDeterminant(input, max int) (
 	v []uint8, bitlen int, err error) {
 
@@ -154,6 +221,9 @@ func EncLengthDeterminant(input, max int) (
 	}
 
 	switch {
+	case input < 0:
+		err = &RangeError{Value: input, Min: 0, Max: NoUpperBound}
+		return
 	case input < 128:
 		v = append(v, uint8(input))
 		return
@@ -163,47 +233,35 @@ func EncLengthDeterminant(input, max int) (
 		v[0] |= 0x80
 		return
 	}
-	err = fmt.Errorf("EncLengthDeterminant: "+
-		"not implemented yet for input=%d, max=%d", input, max)
+	// 10.9.3.8.1 the fragment of 16K, 32K, 48K or 64K items
+	v = append(v, 0xc0|uint8(FragmentSize(input)/16384))
 	return
 }
 
-func encConstrainedWholeNumberWithExtmark(input, min, max int, extmark bool) (
-	v []uint8, bitlen int, err error) {
-	v, bitlen, err = EncConstrainedWholeNumber(input, min, max)
-	if err != nil {
-		return
-	}
-	if extmark == true {
-		switch {
-		case bitlen%8 == 0:
-			bitlen += 8
-			v = append([]uint8{0x00}, v...)
-		case bitlen < 8:
-			bitlen++
-		}
+// FragmentSize returns the number of items which are put after the length
+// determinant of the given length. It is less than the length only if the
+// length is 16K or more.
+func FragmentSize(length int) int {
+	switch {
+	case length < 16384:
+		return length
+	case length < 65536:
+		return length / 16384 * 16384
 	}
-	ShiftLeftMost(v, bitlen)
-	return
+	return 65536
 }
 
 // EncInteger is the implementation for
 // 12. Encoding the integer type
-// but it is only for the case of single value and constrained whole nuber.
+// for the constrained integer. If extmark is true and the input is out of
+// min and max, it is encoded as the value out of extension root.
+// Use EncSemiConstrainedWholeNumber and EncUnconstrainedWholeNumber for
+// the integer without upper bound or without any bounds.
 func EncInteger(input, min, max int, extmark bool) (
 	v []uint8, bitlen int, err error) {
-
-	if min == max { // 12.2.1 single value
-		if extmark == true {
-			bitlen = 1
-			v = make([]uint8, 1, 1)
-		}
-		return
-	}
-
-	// 12.2.2 constrained whole number
-	v, bitlen, err = encConstrainedWholeNumberWithExtmark(input,
-		min, max, extmark)
+	w := NewBitWriter()
+	err = w.EncInteger(input, min, max, extmark)
+	v, bitlen = w.Bytes(), w.Len()
 	return
 }
 
@@ -211,8 +269,9 @@ func EncInteger(input, min, max int, extmark bool) (
 // 13. Encoding the enumerated type
 func EncEnumerated(input, min, max int, extmark bool) (
 	v []uint8, bitlen int, err error) {
-	v, bitlen, err =
-		encConstrainedWholeNumberWithExtmark(input, min, max, extmark)
+	w := NewBitWriter()
+	err = w.EncEnumerated(input, min, max, extmark)
+	v, bitlen = w.Bytes(), w.Len()
 	return
 }
 
@@ -220,43 +279,9 @@ func EncEnumerated(input, min, max int, extmark bool) (
 // 15. Encoding the bitstering type
 func EncBitString(input []uint8, inputlen, min, max int, extmark bool) (
 	v []uint8, bitlen int, err error) {
-
-	//bitLen := bits.Len(uint(input))
-	if inputlen < min || inputlen > max {
-		err = fmt.Errorf("EncBitString: "+
-			"input len(value)=%d is out of range. "+
-			"(should be %d <= %d)", inputlen, min, max)
-		return
-	}
-
-	if min == max && min != inputlen {
-		err = fmt.Errorf("EncBitString: "+
-			"input len(value)=%d must be %d", inputlen, min)
-		return
-	}
-
-	if len(input)*8 < inputlen {
-		err = fmt.Errorf("EncBitString: "+
-			"input len(value)=%d is too short.", len(input))
-		return
-	}
-
-	v, bitlen = ShiftLeftMost(input, inputlen)
-
-	if min == max {
-		// fixed length case. not implemented yet.
-		switch {
-		case min < 17:
-		case min > 16 && min < 65537:
-		}
-		return
-	}
-
-	// range is constrained whole number.
-	pv, plen, _ := encConstrainedWholeNumberWithExtmark(inputlen,
-		min, max, extmark)
-
-	v, bitlen = MergeBitField(pv, plen, v, bitlen)
+	w := NewBitWriter()
+	err = w.EncBitString(input, inputlen, min, max, extmark)
+	v, bitlen = w.Bytes(), w.Len()
 	return
 }
 
@@ -267,81 +292,106 @@ func EncBitString(input []uint8, inputlen, min, max int, extmark bool) (
 // - returned value can be len(value) == 0 if the specified octet string has
 //   fixed length and the lenght is less than 3. And then the octet string is
 //   encoded as bit field.
+// - if the length of 16K or more is fragmented, returned preamble has only
+//   the extension bit and returned value has the fragments with the length
+//   determinants in front of them.
 func EncOctetString(input []uint8, min, max int, extmark bool) (
 	pv []uint8, plen int, v []uint8, err error) {
 
 	inputlen := len(input)
 	if inputlen < min || inputlen > max {
-		err = fmt.Errorf("EncOctetString: "+
-			"input len(value)=%d is out of range. "+
-			"(should be %d <= %d)", inputlen, min, max)
+		err = &RangeError{Value: inputlen, Min: min, Max: max}
 		return
 	}
 
-	if min == max {
-		switch {
-		case min < 3:
-			pv = input
-			plen = inputlen * 8
-			if extmark == true {
-				pv = append([]uint8{0x00}, pv...)
-				plen++
-			}
-			pv, plen = ShiftLeftMost(pv, plen)
-		case min < 65537:
-			v = input
-			if extmark == true {
-				pv = []uint8{0x00}
-				plen = 1
-				pv, plen = ShiftLeftMost(pv, plen)
-			}
-		}
+	w := NewBitWriter()
+	if min == max && min < 3 {
+		err = w.EncOctetString(input, min, max, extmark)
+		pv, plen = w.Bytes(), w.Len()
 		return
 	}
 
-	v = input
-	pv, plen, perr :=
-		encConstrainedWholeNumberWithExtmark(inputlen,
-			min, max, extmark)
-
-	if perr != nil {
-		err = fmt.Errorf("EncOctetString: unexpected error.")
+	unconstrained, err := w.encSizeWithExtmark(inputlen, min, max, extmark)
+	if err != nil {
 		return
 	}
+	if unconstrained == true && inputlen >= 16384 {
+		pv, plen = w.Bytes(), w.Len()
+		fw := NewBitWriter()
+		err = fw.EncFragments(inputlen, func(from, to int) error {
+			fw.WriteOctets(input[from:to])
+			return nil
+		})
+		v = fw.Bytes()
+		return
+	}
+	if unconstrained == true {
+		w.EncLengthDeterminant(inputlen, 0)
+	}
+	pv, plen = w.Bytes(), w.Len()
+	v = input
 	return
 }
 
-// EncSequence return Sequence Preamble but it just returns 0x00 for now.
+// EncSequence return Sequence Preamble.
 // 18. Encoding the sequence type
 func EncSequence(extmark bool, optnum int, optflag uint) (
 	pv []uint8, plen int, err error) {
-	if optnum > 7 {
-		err = fmt.Errorf("EncSequence: "+
-			"optnum=%d is not implemented yet. (should be < 8)",
-			optnum)
-		return
-	}
-	if extmark == true {
-		plen++
-	}
+	w := NewBitWriter()
+	err = w.EncSequence(extmark, optnum, optflag)
+	pv, plen = w.Bytes(), w.Len()
+	return
+}
+
+// EncExtendedSequence return Sequence Preamble whose extension bit is 1.
+// 18. Encoding the sequence type
+func EncExtendedSequence(optnum int, optflag uint) (
+	pv []uint8, plen int, err error) {
+	w := NewBitWriter()
+	err = w.EncExtendedSequence(optnum, optflag)
+	pv, plen = w.Bytes(), w.Len()
+	return
+}
+
+// EncExtensionAdditions returns the extension additions of Sequence, which
+// follows the components in the extension root.
+// 18. Encoding the sequence type
+func EncExtensionAdditions(additions [][]uint8) (
+	v []uint8, bitlen int, err error) {
+	w := NewBitWriter()
+	err = w.EncExtensionAdditions(additions)
+	v, bitlen = w.Bytes(), w.Len()
+	return
+}
 
-	pv = make([]uint8, 1, 1)
-	plen += optnum
-	pv = make([]uint8, 1, 1)
-	pv[0] |= uint8(optflag)
-	pv, plen = ShiftLeftMost(pv, plen)
+// EncOpenType returns the value encoded by enc as the open type, i.e. the
+// length determinant followed by the octet-aligned value. See
+// BitWriter.EncOpenType for enc.
+// 10.2 Open type fields
+func EncOpenType(enc func(w *BitWriter) error) (
+	v []uint8, bitlen int, err error) {
+	w := NewBitWriter()
+	err = w.EncOpenType(enc)
+	v, bitlen = w.Bytes(), w.Len()
 	return
 }
 
 // EncSequenceOf return Sequence-Of Preamble.
 // 19. Encoding the sequence-of type
-var EncSequenceOf = EncEnumerated
+func EncSequenceOf(input, min, max int, extmark bool) (
+	pv []uint8, plen int, err error) {
+	w := NewBitWriter()
+	err = w.EncSequenceOf(input, min, max, extmark)
+	pv, plen = w.Bytes(), w.Len()
+	return
+}
 
 // EncChoice is the implementation for
 // 22. Encoding the choice type
 func EncChoice(input, min, max int, extmark bool) (
 	pv []uint8, plen int, err error) {
-	pv, plen, err = EncInteger(input, min, max, extmark)
-	pv, plen = ShiftLeftMost(pv, plen)
+	w := NewBitWriter()
+	err = w.EncChoice(input, min, max, extmark)
+	pv, plen = w.Bytes(), w.Len()
 	return
 }
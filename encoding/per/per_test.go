@@ -74,8 +74,8 @@ func TestEncConstrainedWholeNumber(t *testing.T) {
 
 	v, bitlen, err = EncConstrainedWholeNumber(1, 0, 7)
 	expect = []uint8{0x01}
-	if bitlen != 4 || compareSlice(expect, v) == false {
-		t.Errorf("bitlen expect: %d, actual %d", 4, bitlen)
+	if bitlen != 3 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 3, bitlen)
 		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
 	}
 
@@ -90,6 +90,75 @@ func TestEncConstrainedWholeNumber(t *testing.T) {
 	if bitlen != 16 || compareSlice(expect, v) == false {
 		t.Errorf("expect: 0x%02x, actual 0x%02x", expect, v)
 	}
+
+	// the indefinite length case, e.g.
+	// AMF-UE-NGAP-ID ::= INTEGER (0..1099511627775)
+	v, bitlen, err = EncConstrainedWholeNumber(1, 0, 1099511627775)
+	expect = []uint8{0x00, 0x01}
+	if bitlen != 16 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 16, bitlen)
+		t.Errorf("expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	v, bitlen, err = EncConstrainedWholeNumber(1099511627775, 0,
+		1099511627775)
+	expect = []uint8{0x80, 0xff, 0xff, 0xff, 0xff, 0xff}
+	if bitlen != 48 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 48, bitlen)
+		t.Errorf("expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	v, bitlen, err = EncConstrainedWholeNumber(65536, 1, 65537)
+	expect = []uint8{0x40, 0xff, 0xff}
+	if bitlen != 24 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 24, bitlen)
+		t.Errorf("expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+}
+
+// 10.7
+func TestEncSemiConstrainedWholeNumber(t *testing.T) {
+	v, bitlen, err := EncSemiConstrainedWholeNumber(-2, -1)
+	if err == nil {
+		t.Errorf("EncSemiConstrainedWholeNumber: unexpected success")
+	}
+
+	v, bitlen, err = EncSemiConstrainedWholeNumber(0, 0)
+	expect := []uint8{0x01, 0x00}
+	if bitlen != 16 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 16, bitlen)
+		t.Errorf("expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	v, bitlen, err = EncSemiConstrainedWholeNumber(257, 1)
+	expect = []uint8{0x02, 0x01, 0x00}
+	if bitlen != 24 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 24, bitlen)
+		t.Errorf("expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+}
+
+// 10.8
+func TestEncUnconstrainedWholeNumber(t *testing.T) {
+	cases := []struct {
+		in     int
+		expect []uint8
+	}{
+		{0, []uint8{0x01, 0x00}},
+		{127, []uint8{0x01, 0x7f}},
+		{128, []uint8{0x02, 0x00, 0x80}},
+		{-1, []uint8{0x01, 0xff}},
+		{-128, []uint8{0x01, 0x80}},
+		{-129, []uint8{0x02, 0xff, 0x7f}},
+	}
+	for _, c := range cases {
+		v, bitlen, _ := EncUnconstrainedWholeNumber(c.in)
+		if bitlen != len(c.expect)*8 || compareSlice(c.expect, v) == false {
+			t.Errorf("bitlen expect: %d, actual %d",
+				len(c.expect)*8, bitlen)
+			t.Errorf("expect: 0x%02x, actual 0x%02x", c.expect, v)
+		}
+	}
 }
 
 // 10.9
@@ -118,26 +187,70 @@ func TestEncLengthDeterminant(t *testing.T) {
 		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
 	}
 
-	v, bitlen, err := EncLengthDeterminant(16384, 0)
+	// 10.9.3.8 the header of the first fragment
+	v, bitlen, _ = EncLengthDeterminant(16384, 0)
+	expect = []uint8{0xc1}
+	expectlen = 0
+	if bitlen != expectlen || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", expectlen, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	v, bitlen, _ = EncLengthDeterminant(50000, 0)
+	expect = []uint8{0xc3}
+	if bitlen != expectlen || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", expectlen, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	v, bitlen, _ = EncLengthDeterminant(100000, 65536)
+	expect = []uint8{0xc4}
+	if bitlen != expectlen || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", expectlen, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	_, _, err := EncLengthDeterminant(-1, 0)
 	if err == nil {
-		t.Errorf("EncLengthDeterminant: unexpected error")
+		t.Errorf("EncLengthDeterminant: unexpected success")
+	}
+}
+
+// 10.9.3.8
+func TestFragmentSize(t *testing.T) {
+	for _, c := range [][2]int{
+		{0, 0}, {16383, 16383}, {16384, 16384}, {32767, 16384},
+		{32768, 32768}, {65535, 49152}, {65536, 65536}, {100000, 65536},
+	} {
+		if n := FragmentSize(c[0]); n != c[1] {
+			t.Errorf("FragmentSize(%d) expect: %d, actual %d",
+				c[0], c[1], n)
+		}
 	}
 }
 
 // 12
 func TestEncInteger(t *testing.T) {
-	v, bitlen, err := EncInteger(3, 0, 2, true)
+	v, bitlen, err := EncInteger(3, 0, 2, false)
 	if err == nil {
 		t.Errorf("EncInteger: unexpected error")
 	}
 
+	// the value out of extension root
+	v, bitlen, err = EncInteger(3, 0, 2, true)
+	expect := []uint8{0x80, 0x01, 0x03}
+	if bitlen != 24 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 24, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
 	v, bitlen, err = EncInteger(2, 2, 2, false)
 	if bitlen != 0 && len(v) == 0 {
 		t.Errorf("bitlen expect: %d, actual %d", 2, bitlen)
 	}
 
 	v, bitlen, err = EncInteger(2, 2, 2, true)
-	expect := []uint8{0x00}
+	expect = []uint8{0x00}
 	if bitlen != 1 || compareSlice(expect, v) == false {
 		t.Errorf("bitlen expect: %d, actual %d", 2, bitlen)
 	}
@@ -149,9 +262,9 @@ func TestEncInteger(t *testing.T) {
 	}
 
 	v, bitlen, err = EncInteger(1, 0, 7, true)
-	expect = []uint8{0x08}
-	if bitlen != 5 || compareSlice(expect, v) == false {
-		t.Errorf("bitlen expect: %d, actual %d", 5, bitlen)
+	expect = []uint8{0x10}
+	if bitlen != 4 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 4, bitlen)
 		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
 	}
 
@@ -169,6 +282,21 @@ func TestEncInteger(t *testing.T) {
 		t.Errorf("expect: 0x%02x, actual 0x%02x", expect, v)
 	}
 
+	// RAN-UE-NGAP-ID ::= INTEGER (0..4294967295)
+	v, bitlen, err = EncInteger(0x1234, 0, 4294967295, false)
+	expect = []uint8{0x40, 0x12, 0x34}
+	if bitlen != 24 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 24, bitlen)
+		t.Errorf("expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	// the value out of extension root of single value
+	v, bitlen, err = EncInteger(-1, 2, 2, true)
+	expect = []uint8{0x80, 0x01, 0xff}
+	if bitlen != 24 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 24, bitlen)
+		t.Errorf("expect: 0x%02x, actual 0x%02x", expect, v)
+	}
 }
 
 // 13
@@ -191,22 +319,133 @@ func TestEncEnumerated(t *testing.T) {
 		t.Errorf("bitlen expect: %d, actual %d", 3, bitlen)
 		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
 	}
+
+	// the second value out of extension root
+	v, bitlen, err = EncEnumerated(4, 0, 2, true)
+	expect = []uint8{0x81}
+	//b 1000 0001
+	if bitlen != 8 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 8, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
 }
 
 // 13
 func TestEncSequence(t *testing.T) {
-	v, bitlen, err := EncSequence(false, 8, 0x00)
+	v, bitlen, err := EncSequence(false, 65, 0x00)
 	if err == nil {
 		t.Errorf("EncSequence: unexpected error")
 	}
 
+	v, bitlen, err = EncSequence(true, 10, 0x2a5)
+	expect := []uint8{0x54, 0xa0}
+	//b 0101 0100 101x xxxx
+	if bitlen != 11 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 11, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
 	v, bitlen, err = EncSequence(true, 1, 0x00)
-	expect := []uint8{0x00}
+	expect = []uint8{0x00}
+	if bitlen != 2 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 2, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	v, bitlen, err = EncExtendedSequence(1, 0x01)
+	expect = []uint8{0xc0}
 	if bitlen != 2 || compareSlice(expect, v) == false {
 		t.Errorf("bitlen expect: %d, actual %d", 2, bitlen)
 		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
 	}
+}
+
+// 18.7 - 18.9
+func TestEncExtensionAdditions(t *testing.T) {
+	// two additions, the second is absent and the first is empty.
+	v, bitlen, err := EncExtensionAdditions([][]uint8{{}, nil})
+	expect := []uint8{0x03, 0x00, 0x01, 0x00}
+	//b 0000 0011 0xxx xxxx 0000 0001 0000 0000
+	if bitlen != 32 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 32, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	// more than 64 additions
+	additions := make([][]uint8, 65, 65)
+	additions[64] = []uint8{0xab}
+	v, bitlen, err = EncExtensionAdditions(additions)
+	expectlen := 8 + 8 + 65 + 7 + 16
+	if bitlen != expectlen || err != nil || v[0] != 0x80 ||
+		v[1] != 0x41 || v[10] != 0x80 || v[11] != 0x01 || v[12] != 0xab {
+		t.Errorf("bitlen expect: %d, actual %d", expectlen, bitlen)
+		t.Errorf("value: 0x%02x", v)
+	}
 
+	_, _, err = EncExtensionAdditions(nil)
+	if err == nil {
+		t.Errorf("EncExtensionAdditions: unexpected success")
+	}
+}
+
+// 10.6
+func TestEncNormallySmallWholeNumber(t *testing.T) {
+	v, bitlen, _ := EncNormallySmallWholeNumber(5)
+	expect := []uint8{0x0a}
+	if bitlen != 7 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 7, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	v, bitlen, _ = EncNormallySmallWholeNumber(63)
+	expect = []uint8{0x7e}
+	if bitlen != 7 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 7, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	v, bitlen, _ = EncNormallySmallWholeNumber(64)
+	expect = []uint8{0x80, 0x01, 0x40}
+	//b 1xxx xxxx 0000 0001 0100 0000
+	if bitlen != 24 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 24, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	_, _, err := EncNormallySmallWholeNumber(-1)
+	if err == nil {
+		t.Errorf("EncNormallySmallWholeNumber: unexpected success")
+	}
+}
+
+// 22
+func TestEncChoice(t *testing.T) {
+	v, bitlen, _ := EncChoice(1, 0, 2, true)
+	expect := []uint8{0x20}
+	if bitlen != 3 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 3, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	// the first alternative out of extension root
+	v, bitlen, _ = EncChoice(3, 0, 2, true)
+	expect = []uint8{0x80}
+	if bitlen != 8 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 8, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	v, bitlen, _ = EncChoice(4, 0, 0, true)
+	expect = []uint8{0x83}
+	if bitlen != 8 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 8, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	_, _, err := EncChoice(3, 0, 2, false)
+	if err == nil {
+		t.Errorf("EncChoice: unexpected success")
+	}
 }
 
 /*
@@ -245,7 +484,7 @@ func TestBitString(t *testing.T) {
 		t.Errorf("BitString error")
 	}
 
-	//fixed length case. but not implemented yet.
+	// fixed length up to 16 bits is not octet-aligned.
 	in = []uint8{0x00, 0x00}
 	v, bitlen, err = EncBitString(in, 16, 16, 16, false)
 	expect := []uint8{0x00, 0x00}
@@ -254,10 +493,40 @@ func TestBitString(t *testing.T) {
 		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
 	}
 
+	in = []uint8{0x05}
+	v, bitlen, err = EncBitString(in, 3, 3, 3, true)
+	expect = []uint8{0x50}
+	//b 0101 xxxx
+	if bitlen != 4 || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 4, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	// NRCellIdentity BIT STRING (SIZE(36)) is octet-aligned.
+	in = []uint8{0x01, 0x23, 0x45, 0x67, 0x89}
+	v, bitlen, err = EncBitString(in, 36, 36, 36, true)
+	expect = []uint8{0x00, 0x12, 0x34, 0x56, 0x78, 0x90}
+	//b 0xxx xxxx 0001 0010 ... 1001 xxxx
+	expectlen := 44
+	if bitlen != expectlen || compareSlice(expect, v) == false {
+		t.Errorf("bitlen expect: %d, actual %d", expectlen, bitlen)
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
+	}
+
+	// fixed length of more than 64K bits is fragmented.
+	in = make([]uint8, 8193, 8193)
+	in[0] = 0x01
+	v, bitlen, err = EncBitString(in, 65537, 65537, 65537, false)
+	expectlen = (1+8192+1+1)*8 - 7
+	if bitlen != expectlen || err != nil ||
+		v[0] != 0xc4 || v[1] != 0x80 || v[8193] != 0x01 {
+		t.Errorf("bitlen expect: %d, actual %d", expectlen, bitlen)
+	}
+
 	in = []uint8{0x00, 0x10}
 	v, bitlen, err = EncBitString(in, 16, 0, 255, false)
 	expect = []uint8{0x10, 0x00, 0x10}
-	expectlen := 24
+	expectlen = 24
 	if bitlen != expectlen || compareSlice(expect, v) == false {
 		t.Errorf("bitlen expect: %d, actual %d", expectlen, bitlen)
 		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
@@ -266,9 +535,9 @@ func TestBitString(t *testing.T) {
 	in = []uint8{0x00, 0x00, 0x02}
 	//b x000 0000 0000 0000 0000 0010
 	v, bitlen, err = EncBitString(in, 23, 22, 32, false)
-	expect = []uint8{0x10, 0x00, 0x00, 0x40}
-	//b 0001 0000 0000 0000 0000 0000 010x xxxx
-	expectlen = 27
+	expect = []uint8{0x10, 0x00, 0x00, 0x04}
+	//b 0001 xxxx 0000 0000 0000 0000 0000 010x
+	expectlen = 31
 	if bitlen != expectlen || compareSlice(expect, v) == false {
 		t.Errorf("bitlen expect: %d, actual %d", expectlen, bitlen)
 		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
@@ -277,9 +546,9 @@ func TestBitString(t *testing.T) {
 	in = []uint8{0x00, 0x00, 0x00, 0x03}
 	//b xxxx xxx0 0000 0000 0000 0000 0000 0011
 	v, bitlen, err = EncBitString(in, 25, 22, 32, false)
-	expect = []uint8{0x30, 0x00, 0x00, 0x18}
-	//b 0011  0000 0000 0000 0000 0000 0001 1xxx
-	expectlen = 29
+	expect = []uint8{0x30, 0x00, 0x00, 0x01, 0x80}
+	//b 0011 xxxx 0000 0000 0000 0000 0000 0001 1xxx xxxx
+	expectlen = 33
 	if bitlen != expectlen || compareSlice(expect, v) == false {
 		t.Errorf("bitlen expect: %d, actual %d", expectlen, bitlen)
 		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, v)
@@ -340,10 +609,10 @@ func TestOctetString(t *testing.T) {
 	min = 0
 	max = 7
 	in = make([]uint8, 3, 3)
-	pexpect = []uint8{0x18}
+	pexpect = []uint8{0x30}
 	expect = in
 	pv, plen, v, err = EncOctetString(in, min, max, true)
-	expectplen = 5
+	expectplen = 4
 	if compareSlice(pexpect, pv) == false || plen != expectplen ||
 		compareSlice(expect, v) == false {
 		t.Errorf("plen expect: %d, actual %d", expectplen, plen)
@@ -0,0 +1,303 @@
+// Copyright 2019 hhorai. All rights reserved.
+// Use of this source code is governed by a MIT license that can be found
+// in the LICENSE file.
+
+package per
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+	"unicode/utf8"
+)
+
+// the alphabets of the known-multiplier character string types, sorted
+// in the order of character codes.
+const (
+	printableAlphabet = " '()+,-./0123456789:=?" +
+		"ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+var (
+	visibleAlphabet = asciiRange(0x20, 0x7e)
+	ia5Alphabet     = asciiRange(0x00, 0x7f)
+)
+
+func asciiRange(first, last uint8) string {
+	var b strings.Builder
+	for c := first; c <= last; c++ {
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// effectiveAlphabet returns the characters in alphabet sorted in the order
+// of character codes. alphabet is the permitted alphabet constraint like
+// FROM("0".."9"), and it has to be a subset of typeAlphabet. The empty
+// alphabet means typeAlphabet itself.
+func effectiveAlphabet(offset int, typeAlphabet, alphabet string) (
+	effective string, err error) {
+
+	if alphabet == "" {
+		effective = typeAlphabet
+		return
+	}
+	var b strings.Builder
+	for i := 0; i < len(typeAlphabet); i++ {
+		if strings.IndexByte(alphabet, typeAlphabet[i]) >= 0 {
+			b.WriteByte(typeAlphabet[i])
+		}
+	}
+	effective = b.String()
+	for i := 0; i < len(alphabet); i++ {
+		if strings.IndexByte(effective, alphabet[i]) < 0 {
+			err = &ConstraintError{Offset: offset, Constraint: fmt.Sprintf(
+				"character 0x%02x in permitted alphabet", alphabet[i])}
+			return
+		}
+	}
+	return
+}
+
+// charBits returns the number of bits for each character of alphabet, and
+// whether the character is encoded as the index in alphabet instead of
+// its code.
+// 26.5.2 - 26.5.4
+func (variant Variant) charBits(alphabet string) (b int, index bool) {
+	b = bits.Len(uint(len(alphabet) - 1))
+	if variant == Aligned && b&(b-1) != 0 {
+		// the smallest power of 2 in ALIGNED variant
+		b = 1 << uint(bits.Len(uint(b)))
+	}
+	index = bits.Len(uint(alphabet[len(alphabet)-1])) > b
+	return
+}
+
+// charAligned returns whether the characters are octet-aligned in ALIGNED
+// variant.
+// 26.5.6 and 26.5.7
+func charAligned(size, min, max, b int) bool {
+	if size == 0 {
+		return false
+	}
+	if min == max {
+		return max*b > 16
+	}
+	return max*b >= 16
+}
+
+func (w *BitWriter) encKnownMultiplierString(input, typeAlphabet,
+	alphabet string, min, max int, extmark bool) (err error) {
+
+	alphabet, err = effectiveAlphabet(w.len, typeAlphabet, alphabet)
+	if err != nil {
+		return
+	}
+	for i := 0; i < len(input); i++ {
+		if strings.IndexByte(alphabet, input[i]) < 0 {
+			err = &ConstraintError{Offset: w.len, Constraint: fmt.Sprintf(
+				"character 0x%02x not in permitted alphabet", input[i])}
+			return
+		}
+	}
+
+	b, index := w.Variant.charBits(alphabet)
+	put := func(from, to int) error {
+		for i := from; i < to; i++ {
+			v := int(input[i])
+			if index == true {
+				v = strings.IndexByte(alphabet, input[i])
+			}
+			w.WriteUint(uint64(v), b)
+		}
+		return nil
+	}
+
+	size := len(input)
+	unconstrained, err := w.encSizeWithExtmark(size, min, max, extmark)
+	if err != nil {
+		return
+	}
+	if unconstrained == true {
+		err = w.EncFragments(size, put)
+		return
+	}
+	if charAligned(size, min, max, b) {
+		w.Align()
+	}
+	err = put(0, size)
+	return
+}
+
+func (r *BitReader) decKnownMultiplierString(typeAlphabet,
+	alphabet string, min, max int, extmark bool) (v string, err error) {
+
+	alphabet, err = effectiveAlphabet(r.off, typeAlphabet, alphabet)
+	if err != nil {
+		return
+	}
+	b, index := r.Variant.charBits(alphabet)
+
+	off := r.off
+	var sb strings.Builder
+	get := func(n int) error {
+		for i := 0; i < n; i++ {
+			u, err := r.ReadUint(b)
+			if err != nil {
+				return err
+			}
+			var c uint8
+			switch {
+			case index == true && int(u) < len(alphabet):
+				c = alphabet[u]
+			case index == false && strings.IndexByte(alphabet,
+				uint8(u)) >= 0:
+				c = uint8(u)
+			default:
+				return &ConstraintError{Offset: r.off - b,
+					Constraint: fmt.Sprintf(
+						"character 0x%02x not in permitted alphabet", u)}
+			}
+			sb.WriteByte(c)
+		}
+		return nil
+	}
+
+	size, n, _, unconstrained, err :=
+		r.Variant.decSizeWithExtmark(r.buf, r.off, min, max, extmark)
+	if err != nil {
+		return
+	}
+	r.off += n
+	if unconstrained == true {
+		_, err = r.DecFragments(get)
+	} else {
+		if charAligned(size, min, max, b) {
+			r.Align()
+		}
+		err = get(size)
+	}
+	if err != nil {
+		r.off = off
+		return
+	}
+	v = sb.String()
+	return
+}
+
+// EncBoolean writes the value as
+// 11. Encoding the boolean type
+func (w *BitWriter) EncBoolean(input bool) (err error) {
+	if input == true {
+		w.writeBit(1)
+	} else {
+		w.writeBit(0)
+	}
+	return
+}
+
+// EncNull writes nothing as
+// 17. Encoding the null type
+func (w *BitWriter) EncNull() (err error) {
+	return
+}
+
+// EncPrintableString writes the PrintableString whose size is constrained
+// by min and max. alphabet is the permitted alphabet constraint, and the
+// empty alphabet means no constraint.
+// 26. Encoding the restricted character string types (known-multiplier)
+func (w *BitWriter) EncPrintableString(input, alphabet string,
+	min, max int, extmark bool) (err error) {
+	err = w.encKnownMultiplierString(input, printableAlphabet, alphabet,
+		min, max, extmark)
+	return
+}
+
+// EncVisibleString writes the VisibleString as EncPrintableString.
+// 26. Encoding the restricted character string types (known-multiplier)
+func (w *BitWriter) EncVisibleString(input, alphabet string,
+	min, max int, extmark bool) (err error) {
+	err = w.encKnownMultiplierString(input, visibleAlphabet, alphabet,
+		min, max, extmark)
+	return
+}
+
+// EncIA5String writes the IA5String as EncPrintableString.
+// 26. Encoding the restricted character string types (known-multiplier)
+func (w *BitWriter) EncIA5String(input, alphabet string,
+	min, max int, extmark bool) (err error) {
+	err = w.encKnownMultiplierString(input, ia5Alphabet, alphabet,
+		min, max, extmark)
+	return
+}
+
+// EncUTF8String writes the UTF8String. Its size constraint is not
+// PER-visible, so the length in octets is always written as the
+// unconstrained length determinant.
+// 27. Encoding the unrestricted character string types
+func (w *BitWriter) EncUTF8String(input string) (err error) {
+	if utf8.ValidString(input) == false {
+		err = &ConstraintError{Offset: w.len, Constraint: "invalid UTF-8"}
+		return
+	}
+	v := []uint8(input)
+	err = w.EncFragments(len(v), func(from, to int) error {
+		w.WriteOctets(v[from:to])
+		return nil
+	})
+	return
+}
+
+// DecBoolean reads the value written by BitWriter.EncBoolean.
+func (r *BitReader) DecBoolean() (v bool, err error) {
+	u, err := r.ReadUint(1)
+	v = u == 1
+	return
+}
+
+// DecNull reads nothing written by BitWriter.EncNull.
+func (r *BitReader) DecNull() (err error) {
+	return
+}
+
+// DecPrintableString reads the PrintableString written by
+// BitWriter.EncPrintableString.
+func (r *BitReader) DecPrintableString(alphabet string, min, max int,
+	extmark bool) (v string, err error) {
+	v, err = r.decKnownMultiplierString(printableAlphabet, alphabet,
+		min, max, extmark)
+	return
+}
+
+// DecVisibleString reads the VisibleString written by
+// BitWriter.EncVisibleString.
+func (r *BitReader) DecVisibleString(alphabet string, min, max int,
+	extmark bool) (v string, err error) {
+	v, err = r.decKnownMultiplierString(visibleAlphabet, alphabet,
+		min, max, extmark)
+	return
+}
+
+// DecIA5String reads the IA5String written by BitWriter.EncIA5String.
+func (r *BitReader) DecIA5String(alphabet string, min, max int,
+	extmark bool) (v string, err error) {
+	v, err = r.decKnownMultiplierString(ia5Alphabet, alphabet,
+		min, max, extmark)
+	return
+}
+
+// DecUTF8String reads the UTF8String written by BitWriter.EncUTF8String.
+func (r *BitReader) DecUTF8String() (v string, err error) {
+	off := r.off
+	u, err := r.decOpenType()
+	if err != nil {
+		return
+	}
+	if utf8.Valid(u) == false {
+		err = &ConstraintError{Offset: off, Constraint: "invalid UTF-8"}
+		r.off = off
+		return
+	}
+	v = string(u)
+	return
+}
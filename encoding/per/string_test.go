@@ -0,0 +1,157 @@
+package per
+
+import (
+	"testing"
+)
+
+func TestBoolean(t *testing.T) {
+	w := NewBitWriter()
+	w.EncBoolean(true)
+	w.EncNull()
+	w.EncBoolean(false)
+	w.EncBoolean(true)
+	expect := []uint8{0xa0}
+	if w.Len() != 3 || compareSlice(expect, w.Bytes()) == false {
+		t.Errorf("bitlen expect: %d, actual %d", 3, w.Len())
+		t.Errorf("value expect: 0x%02x, actual 0x%02x", expect, w.Bytes())
+	}
+
+	r := NewBitReader(expect)
+	for _, b := range []bool{true, false, true} {
+		v, err := r.DecBoolean()
+		if v != b || err != nil {
+			t.Errorf("value expect: %v, actual %v", b, v)
+		}
+	}
+	if err := r.DecNull(); err != nil || r.Offset() != 3 {
+		t.Errorf("offset expect: %d, actual %d", 3, r.Offset())
+	}
+}
+
+func TestKnownMultiplierString(t *testing.T) {
+	cases := []struct {
+		name    string
+		variant Variant
+		enc     func(w *BitWriter) error
+		dec     func(r *BitReader) (string, error)
+		input   string
+		expect  []uint8
+		bitlen  int
+	}{
+		{
+			// RANNodeName ::= PrintableString (SIZE(1..150, ...))
+			"RANNodeName", Aligned,
+			func(w *BitWriter) error {
+				return w.EncPrintableString("gNB", "", 1, 150, true)
+			},
+			func(r *BitReader) (string, error) {
+				return r.DecPrintableString("", 1, 150, true)
+			},
+			"gNB", []uint8{0x01, 0x00, 0x67, 0x4e, 0x42}, 40,
+		},
+		{
+			// the fixed size up to 16 bits is not octet-aligned.
+			"IA5String (SIZE(2))", Aligned,
+			func(w *BitWriter) error {
+				w.WriteUint(1, 1)
+				return w.EncIA5String("ab", "", 2, 2, false)
+			},
+			func(r *BitReader) (string, error) {
+				r.ReadUint(1)
+				return r.DecIA5String("", 2, 2, false)
+			},
+			"ab", []uint8{0xb0, 0xb1, 0x00}, 17,
+		},
+		{
+			// the characters are encoded as the index in the alphabet.
+			"PrintableString (FROM(\"0\"..\"9\")) (SIZE(4))", Aligned,
+			func(w *BitWriter) error {
+				w.WriteUint(1, 1)
+				return w.EncPrintableString("1234", "0123456789",
+					4, 4, false)
+			},
+			func(r *BitReader) (string, error) {
+				r.ReadUint(1)
+				return r.DecPrintableString("0123456789", 4, 4, false)
+			},
+			"1234", []uint8{0x89, 0x1a, 0x00}, 17,
+		},
+		{
+			"VisibleString (SIZE(1..8))", Aligned,
+			func(w *BitWriter) error {
+				return w.EncVisibleString("Hi", "", 1, 8, false)
+			},
+			func(r *BitReader) (string, error) {
+				return r.DecVisibleString("", 1, 8, false)
+			},
+			"Hi", []uint8{0x20, 0x48, 0x69}, 24,
+		},
+		{
+			// 7 bits for each character in UNALIGNED variant.
+			"PrintableString (SIZE(0..10))", Unaligned,
+			func(w *BitWriter) error {
+				return w.EncPrintableString("ab", "", 0, 10, false)
+			},
+			func(r *BitReader) (string, error) {
+				return r.DecPrintableString("", 0, 10, false)
+			},
+			"ab", []uint8{0x2c, 0x38, 0x80}, 18,
+		},
+		{
+			"UTF8String", Aligned,
+			func(w *BitWriter) error {
+				return w.EncUTF8String("é")
+			},
+			func(r *BitReader) (string, error) {
+				return r.DecUTF8String()
+			},
+			"é", []uint8{0x02, 0xc3, 0xa9}, 24,
+		},
+	}
+
+	for _, c := range cases {
+		w := &BitWriter{Variant: c.variant}
+		err := c.enc(w)
+		if err != nil || w.Len() != c.bitlen ||
+			compareSlice(c.expect, w.Bytes()) == false {
+			t.Errorf("%s: bitlen expect: %d, actual %d, %v",
+				c.name, c.bitlen, w.Len(), err)
+			t.Errorf("%s: value expect: 0x%02x, actual 0x%02x",
+				c.name, c.expect, w.Bytes())
+		}
+
+		r := NewBitReader(c.expect)
+		r.Variant = c.variant
+		v, err := c.dec(r)
+		if v != c.input || err != nil || r.Offset() != c.bitlen {
+			t.Errorf("%s: value expect: %q, actual %q, %v",
+				c.name, c.input, v, err)
+		}
+	}
+}
+
+func TestKnownMultiplierStringError(t *testing.T) {
+	w := NewBitWriter()
+	err := w.EncPrintableString("a@b", "", 1, 150, true)
+	if err == nil {
+		t.Errorf("EncPrintableString: unexpected success")
+	}
+
+	err = w.EncPrintableString("12", "0123456789@", 2, 2, false)
+	if err == nil {
+		t.Errorf("EncPrintableString: unexpected success")
+	}
+
+	// the index 10 is out of the alphabet "0".."9".
+	r := NewBitReader([]uint8{0xa0})
+	_, err = r.DecPrintableString("0123456789", 1, 1, false)
+	if err == nil || r.Offset() != 0 {
+		t.Errorf("DecPrintableString: unexpected success")
+	}
+
+	r = NewBitReader([]uint8{0x01, 0xff})
+	_, err = r.DecUTF8String()
+	if err == nil || r.Offset() != 0 {
+		t.Errorf("DecUTF8String: unexpected success")
+	}
+}
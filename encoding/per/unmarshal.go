@@ -0,0 +1,351 @@
+// Copyright 2019 hhorai. All rights reserved.
+// Use of this source code is governed by a MIT license that can be found
+// in the LICENSE file.
+
+package per
+
+import (
+	"fmt"
+	"math/bits"
+	"reflect"
+)
+
+// Unmarshal parses the PER ALIGNED encoded data b and stores the result in
+// the value pointed to by v. The types and the struct tags are the same as
+// Marshal. The unknown extension additions and alternatives are skipped,
+// i.e. the CHOICE of the unknown alternative has no alternative.
+func Unmarshal(b []byte, v interface{}) error {
+	return UnmarshalWithParams(b, v, "")
+}
+
+// UnmarshalWithParams allows field parameters to be specified for the
+// top-level value. The form of the params is the same as the field tags.
+func UnmarshalWithParams(b []byte, v interface{}, params string) error {
+	return NewBitReader(b).DecValue(v, params)
+}
+
+// UnmarshalWithVariant parses the PER encoded data b in the variant, e.g.
+// Unaligned for RRC, as Unmarshal does.
+func UnmarshalWithVariant(b []byte, v interface{}, variant Variant) error {
+	r := NewBitReader(b)
+	r.Variant = variant
+	return r.DecValue(v, "")
+}
+
+// DecValue reads the value written by BitWriter.EncValue into the value
+// pointed to by v.
+func (r *BitReader) DecValue(v interface{}, params string) (err error) {
+	fp, err := parseFieldParameters(params)
+	if err != nil {
+		return
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		err = fmt.Errorf("per: Unmarshal needs non-nil pointer, not %T", v)
+		return
+	}
+	off := r.off
+	err = r.unmarshalValue(rv.Elem(), fp)
+	if err != nil {
+		r.off = off
+	}
+	return
+}
+
+func (r *BitReader) unmarshalValue(v reflect.Value, params fieldParameters) (
+	err error) {
+
+	if params.opentype == true {
+		if v.Type() == bytesType {
+			var u []uint8
+			u, err = r.decOpenType()
+			if err == nil {
+				v.SetBytes(u)
+			}
+			return
+		}
+		params.opentype = false
+		err = r.DecOpenType(func(or *BitReader) error {
+			return or.unmarshalValue(v, params)
+		})
+		return
+	}
+
+	if v.Kind() != reflect.Ptr && v.CanAddr() {
+		if u, ok := v.Addr().Interface().(Unmarshaler); ok {
+			err = u.UnmarshalPER(r)
+			return
+		}
+	}
+
+	if v.Type() == bitStringType {
+		var bs BitString
+		min, max := params.sizeRange()
+		bs.Bytes, bs.BitLength, err = r.DecBitString(min, max, params.ext)
+		if err == nil {
+			v.Set(reflect.ValueOf(bs))
+		}
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		err = r.unmarshalValue(v.Elem(), params)
+	case reflect.Bool:
+		var b bool
+		b, err = r.DecBoolean()
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		off := r.off
+		var n int
+		n, err = r.unmarshalInteger(params)
+		if err != nil {
+			return
+		}
+		if v.OverflowInt(int64(n)) {
+			min, max := intRange(v.Type())
+			err = &RangeError{Offset: off, Value: n, Min: min, Max: max}
+			return
+		}
+		v.SetInt(int64(n))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		off := r.off
+		var n int
+		n, err = r.unmarshalInteger(params)
+		if err != nil {
+			return
+		}
+		if n < 0 || v.OverflowUint(uint64(n)) {
+			min, max := intRange(v.Type())
+			err = &RangeError{Offset: off, Value: n, Min: min, Max: max}
+			return
+		}
+		v.SetUint(uint64(n))
+	case reflect.String:
+		var s string
+		s, err = r.unmarshalString(params)
+		v.SetString(s)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			var u []uint8
+			min, max := params.sizeRange()
+			u, err = r.DecOctetString(min, max, params.ext)
+			v.SetBytes(u)
+			return
+		}
+		err = r.unmarshalSequenceOf(v, params)
+	case reflect.Struct:
+		err = r.unmarshalStruct(v, params)
+	default:
+		err = fmt.Errorf("per: unsupported type %s", v.Type())
+	}
+	return
+}
+
+func (r *BitReader) unmarshalInteger(params fieldParameters) (
+	v int, err error) {
+
+	switch {
+	case params.enum == true:
+		v, err = r.DecEnumerated(0, params.valueUB, params.ext)
+	case params.hasValueLB == true && params.hasValueUB == true:
+		v, err = r.DecInteger(params.valueLB, params.valueUB, params.ext)
+	case params.hasValueLB == true:
+		if params.ext == true {
+			_, err = r.ReadUint(1)
+			if err != nil {
+				return
+			}
+		}
+		v, err = r.DecSemiConstrainedWholeNumber(params.valueLB)
+	default:
+		if params.ext == true {
+			_, err = r.ReadUint(1)
+			if err != nil {
+				return
+			}
+		}
+		v, err = r.DecUnconstrainedWholeNumber()
+	}
+	return
+}
+
+func (r *BitReader) unmarshalString(params fieldParameters) (
+	v string, err error) {
+
+	min, max := params.sizeRange()
+	switch params.stringType {
+	case "", "printable":
+		v, err = r.DecPrintableString("", min, max, params.ext)
+	case "visible":
+		v, err = r.DecVisibleString("", min, max, params.ext)
+	case "ia5":
+		v, err = r.DecIA5String("", min, max, params.ext)
+	case "utf8":
+		v, err = r.DecUTF8String()
+	}
+	return
+}
+
+func (r *BitReader) unmarshalSequenceOf(v reflect.Value,
+	params fieldParameters) (err error) {
+
+	var elem fieldParameters
+	if params.elem != nil {
+		elem = *params.elem
+	}
+	s := reflect.MakeSlice(v.Type(), 0, 0)
+	get := func(n int) error {
+		for i := 0; i < n; i++ {
+			s = reflect.Append(s, reflect.Zero(v.Type().Elem()))
+			err := r.unmarshalValue(s.Index(s.Len()-1), elem)
+			if err != nil {
+				return WithPath(err, fmt.Sprintf("[%d]", s.Len()-1))
+			}
+		}
+		return nil
+	}
+
+	min, max := params.sizeRange()
+	if max < 65536 {
+		var n int
+		n, err = r.DecSequenceOf(min, max, params.ext)
+		if err != nil {
+			return
+		}
+		err = get(n)
+	} else {
+		if params.ext == true {
+			_, err = r.ReadUint(1)
+			if err != nil {
+				return
+			}
+		}
+		var n int
+		n, err = r.DecFragments(get)
+		if err == nil && n < min {
+			err = &RangeError{Offset: r.off, Value: n, Min: min,
+				Max: NoUpperBound}
+		}
+	}
+	if err != nil {
+		return
+	}
+	v.Set(s)
+	return
+}
+
+func (r *BitReader) unmarshalStruct(v reflect.Value, params fieldParameters) (
+	err error) {
+
+	root, additions, err := structFields(v.Type(), &params)
+	if err != nil {
+		return
+	}
+	if params.choice == true {
+		err = r.unmarshalChoice(v, root, additions, params)
+		return
+	}
+
+	optnum := 0
+	for _, f := range root {
+		if f.params.optional == true {
+			optnum++
+		}
+	}
+	ext, optflag, err := r.DecSequence(params.ext, optnum)
+	if err != nil {
+		return
+	}
+
+	for _, f := range root {
+		fv := v.Field(f.index)
+		if f.params.optional == true {
+			optnum--
+			if optflag&(1<<uint(optnum)) == 0 {
+				fv.Set(reflect.Zero(fv.Type()))
+				continue
+			}
+		}
+		err = r.unmarshalValue(fv, f.params)
+		if err != nil {
+			err = WithPath(err, f.name)
+			return
+		}
+	}
+
+	if ext == false {
+		return
+	}
+	encs, err := r.DecExtensionAdditions()
+	if err != nil {
+		return
+	}
+	for i, enc := range encs {
+		if i >= len(additions) || enc == nil {
+			continue
+		}
+		f := additions[i]
+		ar := BitReader{Variant: r.Variant, buf: enc}
+		err = ar.unmarshalValue(v.Field(f.index), f.params)
+		if err != nil {
+			err = WithPath(err, f.name)
+			return
+		}
+	}
+	return
+}
+
+func (r *BitReader) unmarshalChoice(v reflect.Value, root,
+	additions []structField, params fieldParameters) (err error) {
+
+	chosen, err := r.DecChoice(0, len(root)-1, params.ext)
+	if err != nil {
+		return
+	}
+	fields := make([]structField, 0, len(root)+len(additions))
+	fields = append(append(fields, root...), additions...)
+	for _, f := range fields {
+		fv := v.Field(f.index)
+		fv.Set(reflect.Zero(fv.Type()))
+	}
+
+	if chosen < len(root) {
+		f := root[chosen]
+		err = r.unmarshalValue(v.Field(f.index), f.params)
+		err = WithPath(err, f.name)
+		return
+	}
+	if chosen-len(root) >= len(additions) {
+		// unknown extension alternative
+		err = r.DecOpenType(nil)
+		return
+	}
+	f := additions[chosen-len(root)]
+	f.params.opentype = true
+	err = r.unmarshalValue(v.Field(f.index), f.params)
+	err = WithPath(err, f.name)
+	return
+}
+
+// intRange returns the range of the integer type t which the decoded value
+// overflows. The upper bound which int cannot hold is NoUpperBound.
+func intRange(t reflect.Type) (min, max int) {
+	b := uint(t.Bits())
+	switch t.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		max = NoUpperBound
+		if b < bits.UintSize-1 {
+			max = 1<<b - 1
+		}
+	default:
+		min, max = -1<<(b-1), 1<<(b-1)-1
+	}
+	return
+}
@@ -0,0 +1,25 @@
+// Copyright 2019 hhorai. All rights reserved.
+// Use of this source code is governed by a MIT license that can be found
+// in the LICENSE file.
+
+package per
+
+// Variant selects the encoding rules of PER. The zero value is ALIGNED.
+type Variant int
+
+const (
+	// Aligned is BASIC-PER ALIGNED variant, e.g. for NGAP.
+	Aligned Variant = iota
+	// Unaligned is BASIC-PER UNALIGNED variant, e.g. for RRC.
+	Unaligned
+)
+
+// padding returns the number of padding bits from the bit offset off to
+// the next octet boundary. No padding bits are inserted in UNALIGNED
+// variant.
+func (variant Variant) padding(off int) int {
+	if variant == Unaligned {
+		return 0
+	}
+	return padding(off)
+}
@@ -0,0 +1,16990 @@
+// Code generated by asn1gen. DO NOT EDIT.
+// Source: NGAP-CommonDataTypes.asn NGAP-Constants.asn NGAP-Containers.asn NGAP-IEs.asn NGAP-PDU-Contents.asn NGAP-PDU-Descriptions.asn
+
+package ngap
+
+import (
+	"../encoding/per"
+	"fmt"
+)
+
+// NGAP-Constants
+const (
+	procCodeAMFConfigurationUpdate                = 0
+	procCodeAMFStatusIndication                   = 1
+	procCodeCellTrafficTrace                      = 2
+	procCodeDeactivateTrace                       = 3
+	procCodeDownlinkNASTransport                  = 4
+	procCodeDownlinkNonUEAssociatedNRPPaTransport = 5
+	procCodeDownlinkRANConfigurationTransfer      = 6
+	procCodeDownlinkRANStatusTransfer             = 7
+	procCodeDownlinkUEAssociatedNRPPaTransport    = 8
+	procCodeErrorIndication                       = 9
+	procCodeHandoverCancel                        = 10
+	procCodeHandoverNotification                  = 11
+	procCodeHandoverPreparation                   = 12
+	procCodeHandoverResourceAllocation            = 13
+	procCodeInitialContextSetup                   = 14
+	procCodeInitialUEMessage                      = 15
+	procCodeLocationReportingControl              = 16
+	procCodeLocationReportingFailureIndication    = 17
+	procCodeLocationReport                        = 18
+	procCodeNASNonDeliveryIndication              = 19
+	procCodeNGReset                               = 20
+	procCodeNGSetup                               = 21
+	procCodeOverloadStart                         = 22
+	procCodeOverloadStop                          = 23
+	procCodePaging                                = 24
+	procCodePathSwitchRequest                     = 25
+	procCodePDUSessionResourceModify              = 26
+	procCodePDUSessionResourceModifyIndication    = 27
+	procCodePDUSessionResourceRelease             = 28
+	procCodePDUSessionResourceSetup               = 29
+	procCodePDUSessionResourceNotify              = 30
+	procCodePrivateMessage                        = 31
+	procCodePWSCancel                             = 32
+	procCodePWSFailureIndication                  = 33
+	procCodePWSRestartIndication                  = 34
+	procCodeRANConfigurationUpdate                = 35
+	procCodeRerouteNASRequest                     = 36
+	procCodeRRCInactiveTransitionReport           = 37
+	procCodeTraceFailureIndication                = 38
+	procCodeTraceStart                            = 39
+	procCodeUEContextModification                 = 40
+	procCodeUEContextRelease                      = 41
+	procCodeUEContextReleaseRequest               = 42
+	procCodeUERadioCapabilityCheck                = 43
+	procCodeUERadioCapabilityInfoIndication       = 44
+	procCodeUETNLABindingRelease                  = 45
+	procCodeUplinkNASTransport                    = 46
+	procCodeUplinkNonUEAssociatedNRPPaTransport   = 47
+	procCodeUplinkRANConfigurationTransfer        = 48
+	procCodeUplinkRANStatusTransfer               = 49
+	procCodeUplinkUEAssociatedNRPPaTransport      = 50
+	procCodeWriteReplaceWarning                   = 51
+	procCodeSecondaryRATDataUsageReport           = 52
+	maxPrivateIEs                                 = 65535
+	maxProtocolExtensions                         = 65535
+	maxProtocolIEs                                = 65535
+	maxnoofAllowedAreas                           = 16
+	maxnoofAllowedSNSSAIs                         = 8
+	maxnoofBPLMNs                                 = 12
+	maxnoofCellIDforWarning                       = 65535
+	maxnoofCellinAoI                              = 256
+	maxnoofCellinEAI                              = 65535
+	maxnoofCellinTAI                              = 65535
+	maxnoofCellsingNB                             = 16384
+	maxnoofCellsinngeNB                           = 256
+	maxnoofCellsinUEHistoryInfo                   = 16
+	maxnoofCellsUEMovingTrajectory                = 16
+	maxnoofDRBs                                   = 32
+	maxnoofEmergencyAreaID                        = 65535
+	maxnoofEAIforRestart                          = 256
+	maxnoofEPLMNs                                 = 15
+	maxnoofEPLMNsPlusOne                          = 16
+	maxnoofERABs                                  = 256
+	maxnoofErrors                                 = 256
+	maxnoofForbTACs                               = 4096
+	maxnoofMultiConnectivity                      = 4
+	maxnoofMultiConnectivityMinusOne              = 3
+	maxnoofNGConnectionsToReset                   = 65536
+	maxnoofPDUSessions                            = 256
+	maxnoofPLMNs                                  = 12
+	maxnoofQosFlows                               = 64
+	maxnoofRANNodeinAoI                           = 64
+	maxnoofRecommendedCells                       = 16
+	maxnoofRecommendedRANNodes                    = 16
+	maxnoofAoI                                    = 64
+	maxnoofServedGUAMIs                           = 256
+	maxnoofSliceItems                             = 1024
+	maxnoofTACs                                   = 256
+	maxnoofTAIforInactive                         = 16
+	maxnoofTAIforPaging                           = 16
+	maxnoofTAIforRestart                          = 2048
+	maxnoofTAIforWarning                          = 65535
+	maxnoofTAIinAoI                               = 16
+	maxnoofTimePeriods                            = 2
+	maxnoofTNLAssociations                        = 32
+	maxnoofXnExtTLAs                              = 16
+	maxnoofXnGTPTLAs                              = 16
+	maxnoofXnTLAs                                 = 2
+	idAllowedNSSAI                                = 0
+	idAMFName                                     = 1
+	idAMFOverloadResponse                         = 2
+	idAMFSetID                                    = 3
+	idAMFTNLAssociationFailedToSetupList          = 4
+	idAMFTNLAssociationSetupList                  = 5
+	idAMFTNLAssociationToAddList                  = 6
+	idAMFTNLAssociationToRemoveList               = 7
+	idAMFTNLAssociationToUpdateList               = 8
+	idAMFTrafficLoadReductionIndication           = 9
+	idAMFUENGAPID                                 = 10
+	idAssistanceDataForPaging                     = 11
+	idBroadcastCancelledAreaList                  = 12
+	idBroadcastCompletedAreaList                  = 13
+	idCancelAllWarningMessages                    = 14
+	idCause                                       = 15
+	idCellIDListForRestart                        = 16
+	idConcurrentWarningMessageInd                 = 17
+	idCoreNetworkAssistanceInformation            = 18
+	idCriticalityDiagnostics                      = 19
+	idDataCodingScheme                            = 20
+	idDefaultPagingDRX                            = 21
+	idDirectForwardingPathAvailability            = 22
+	idEmergencyAreaIDListForRestart               = 23
+	idEmergencyFallbackIndicator                  = 24
+	idEUTRACGI                                    = 25
+	idFiveGSTMSI                                  = 26
+	idGlobalRANNodeID                             = 27
+	idGUAMI                                       = 28
+	idHandoverType                                = 29
+	idIMSVoiceSupportIndicator                    = 30
+	idIndexToRFSP                                 = 31
+	idInfoOnRecommendedCellsAndRANNodesForPaging  = 32
+	idLocationReportingRequestType                = 33
+	idMaskedIMEISV                                = 34
+	idMessageIdentifier                           = 35
+	idMobilityRestrictionList                     = 36
+	idNASC                                        = 37
+	idNASPDU                                      = 38
+	idNASSecurityParametersFromNGRAN              = 39
+	idNewAMFUENGAPID                              = 40
+	idNewSecurityContextInd                       = 41
+	idNGAPMessage                                 = 42
+	idNGRANCGI                                    = 43
+	idNGRANTraceID                                = 44
+	idNRCGI                                       = 45
+	idNRPPaPDU                                    = 46
+	idNumberOfBroadcastsRequested                 = 47
+	idOldAMF                                      = 48
+	idOverloadStartNSSAIList                      = 49
+	idPagingDRX                                   = 50
+	idPagingOrigin                                = 51
+	idPagingPriority                              = 52
+	idPDUSessionResourceAdmittedList              = 53
+	idPDUSessionResourceFailedToModifyListModRes  = 54
+	idPDUSessionResourceFailedToSetupListCxtRes   = 55
+	idPDUSessionResourceFailedToSetupListHOAck    = 56
+	idPDUSessionResourceFailedToSetupListPSReq    = 57
+	idPDUSessionResourceFailedToSetupListSURes    = 58
+	idPDUSessionResourceHandoverList              = 59
+	idPDUSessionResourceListCxtRelCpl             = 60
+	idPDUSessionResourceListHORqd                 = 61
+	idPDUSessionResourceModifyListModCfm          = 62
+	idPDUSessionResourceModifyListModInd          = 63
+	idPDUSessionResourceModifyListModReq          = 64
+	idPDUSessionResourceModifyListModRes          = 65
+	idPDUSessionResourceNotifyList                = 66
+	idPDUSessionResourceReleasedListNot           = 67
+	idPDUSessionResourceReleasedListPSAck         = 68
+	idPDUSessionResourceReleasedListPSFail        = 69
+	idPDUSessionResourceReleasedListRelRes        = 70
+	idPDUSessionResourceSetupListCxtReq           = 71
+	idPDUSessionResourceSetupListCxtRes           = 72
+	idPDUSessionResourceSetupListHOReq            = 73
+	idPDUSessionResourceSetupListSUReq            = 74
+	idPDUSessionResourceSetupListSURes            = 75
+	idPDUSessionResourceToBeSwitchedDLList        = 76
+	idPDUSessionResourceSwitchedList              = 77
+	idPDUSessionResourceToReleaseListHOCmd        = 78
+	idPDUSessionResourceToReleaseListRelCmd       = 79
+	idPLMNSupportList                             = 80
+	idPWSFailedCellIDList                         = 81
+	idRANNodeName                                 = 82
+	idRANPagingPriority                           = 83
+	idRANStatusTransferTransparentContainer       = 84
+	idRANUENGAPID                                 = 85
+	idRelativeAMFCapacity                         = 86
+	idRepetitionPeriod                            = 87
+	idResetType                                   = 88
+	idRoutingID                                   = 89
+	idRRCEstablishmentCause                       = 90
+	idRRCInactiveTransitionReportRequest          = 91
+	idRRCState                                    = 92
+	idSecurityContext                             = 93
+	idSecurityKey                                 = 94
+	idSerialNumber                                = 95
+	idServedGUAMIList                             = 96
+	idSliceSupportList                            = 97
+	idSONConfigurationTransferDL                  = 98
+	idSONConfigurationTransferUL                  = 99
+	idSourceAMFUENGAPID                           = 100
+	idSourceToTargetTransparentContainer          = 101
+	idSupportedTAList                             = 102
+	idTAIListForPaging                            = 103
+	idTAIListForRestart                           = 104
+	idTargetID                                    = 105
+	idTargetToSourceTransparentContainer          = 106
+	idTimeToWait                                  = 107
+	idTraceActivation                             = 108
+	idTraceCollectionEntityIPAddress              = 109
+	idUEAggregateMaximumBitRate                   = 110
+	idUEAssociatedLogicalNGConnectionList         = 111
+	idUEContextRequest                            = 112
+	idUENGAPIDs                                   = 114
+	idUEPagingIdentity                            = 115
+	idUEPresenceInAreaOfInterestList              = 116
+	idUERadioCapability                           = 117
+	idUERadioCapabilityForPaging                  = 118
+	idUESecurityCapabilities                      = 119
+	idUnavailableGUAMIList                        = 120
+	idUserLocationInformation                     = 121
+	idWarningAreaList                             = 122
+	idWarningMessageContents                      = 123
+	idWarningSecurityInfo                         = 124
+	idWarningType                                 = 125
+	idAdditionalULNGUUPTNLInformation             = 126
+	idDataForwardingNotPossible                   = 127
+	idDLNGUUPTNLInformation                       = 128
+	idNetworkInstance                             = 129
+	idPDUSessionAggregateMaximumBitRate           = 130
+	idPDUSessionResourceFailedToModifyListModCfm  = 131
+	idPDUSessionResourceFailedToSetupListCxtFail  = 132
+	idPDUSessionResourceListCxtRelReq             = 133
+	idPDUSessionType                              = 134
+	idQosFlowAddOrModifyRequestList               = 135
+	idQosFlowSetupRequestList                     = 136
+	idQosFlowToReleaseList                        = 137
+	idSecurityIndication                          = 138
+	idULNGUUPTNLInformation                       = 139
+	idULNGUUPTNLModifyList                        = 140
+	idWarningAreaCoordinates                      = 141
+	idPDUSessionResourceSecondaryRATUsageList     = 142
+	idHandoverFlag                                = 143
+	idSecondaryRATUsageInformation                = 144
+	idPDUSessionResourceReleaseResponseTransfer   = 145
+	idRedirectionVoiceFallback                    = 146
+	idUERetentionInformation                      = 147
+	idSNSSAI                                      = 148
+	idPSCellInformation                           = 149
+	idLastEUTRANPLMNIdentity                      = 150
+	idMaximumIntegrityProtectedDataRateDL         = 151
+	idAdditionalDLForwardingUPTNLInformation      = 152
+	idAdditionalDLUPTNLInformationForHOList       = 153
+	idAdditionalNGUUPTNLInformation               = 154
+	idAdditionalDLQosFlowPerTNLInformation        = 155
+	idSecurityResult                              = 156
+	idENDCSONConfigurationTransferDL              = 157
+	idENDCSONConfigurationTransferUL              = 158
+)
+
+// Criticality is Criticality in NGAP-CommonDataTypes.
+type Criticality int
+
+const (
+	CriticalityReject Criticality = iota
+	CriticalityIgnore
+	CriticalityNotify
+)
+
+func (v Criticality) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 2, false)
+}
+
+func (v *Criticality) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 2, false)
+	*v = Criticality(x)
+	return
+}
+
+// Presence is Presence in NGAP-CommonDataTypes.
+type Presence int
+
+const (
+	PresenceOptional Presence = iota
+	PresenceConditional
+	PresenceMandatory
+)
+
+func (v Presence) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 2, false)
+}
+
+func (v *Presence) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 2, false)
+	*v = Presence(x)
+	return
+}
+
+// ProcedureCode is ProcedureCode in NGAP-CommonDataTypes.
+type ProcedureCode int
+
+func (v ProcedureCode) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 0, 255, false)
+}
+
+func (v *ProcedureCode) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(0, 255, false)
+	if err != nil {
+		return
+	}
+	*v = ProcedureCode(x)
+	return
+}
+
+// ProtocolExtensionID is ProtocolExtensionID in NGAP-CommonDataTypes.
+type ProtocolExtensionID int
+
+func (v ProtocolExtensionID) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 0, 65535, false)
+}
+
+func (v *ProtocolExtensionID) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(0, 65535, false)
+	if err != nil {
+		return
+	}
+	*v = ProtocolExtensionID(x)
+	return
+}
+
+// ProtocolIEID is ProtocolIE-ID in NGAP-CommonDataTypes.
+type ProtocolIEID int
+
+func (v ProtocolIEID) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 0, 65535, false)
+}
+
+func (v *ProtocolIEID) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(0, 65535, false)
+	if err != nil {
+		return
+	}
+	*v = ProtocolIEID(x)
+	return
+}
+
+// TriggeringMessage is TriggeringMessage in NGAP-CommonDataTypes.
+type TriggeringMessage int
+
+const (
+	TriggeringMessageInitiatingMessage TriggeringMessage = iota
+	TriggeringMessageSuccessfulOutcome
+	TriggeringMessageUnsuccessfulOutcome
+)
+
+func (v TriggeringMessage) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 2, false)
+}
+
+func (v *TriggeringMessage) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 2, false)
+	*v = TriggeringMessage(x)
+	return
+}
+
+// ProtocolIEContainer is ProtocolIE-Container in NGAP-Containers.
+type ProtocolIEContainer []ProtocolIEField
+
+func (v ProtocolIEContainer) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 0, maxProtocolIEs, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *ProtocolIEContainer) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		return
+	}
+	*v = make(ProtocolIEContainer, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// ProtocolIESingleContainer is ProtocolIE-SingleContainer in NGAP-Containers.
+type ProtocolIESingleContainer ProtocolIEField
+
+func (v ProtocolIESingleContainer) MarshalPER(w *per.BitWriter) error {
+	return ProtocolIEField(v).MarshalPER(w)
+}
+
+func (v *ProtocolIESingleContainer) UnmarshalPER(r *per.BitReader) error {
+	return (*ProtocolIEField)(v).UnmarshalPER(r)
+}
+
+// ProtocolIEField is ProtocolIE-Field in NGAP-Containers.
+type ProtocolIEField struct {
+	Id          ProtocolIEID
+	Criticality Criticality
+	Value       []byte
+}
+
+func (v ProtocolIEField) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequence(false, 0, 0); err != nil {
+		return
+	}
+	if err = v.Id.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "id")
+		return
+	}
+	if err = v.Criticality.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "criticality")
+		return
+	}
+	if err = w.EncOpenType(func(w *per.BitWriter) error {
+		w.WriteOctets(v.Value)
+		return nil
+	}); err != nil {
+		err = per.WithPath(err, "value")
+		return
+	}
+	return
+}
+
+func (v *ProtocolIEField) UnmarshalPER(r *per.BitReader) (err error) {
+	_, _, err = r.DecSequence(false, 0)
+	if err != nil {
+		return
+	}
+	*v = ProtocolIEField{}
+	if err = v.Id.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "id")
+		return
+	}
+	if err = v.Criticality.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "criticality")
+		return
+	}
+	if err = r.DecOpenType(func(r *per.BitReader) (err error) {
+		v.Value, err = r.ReadOctets(r.Len() / 8)
+		return
+	}); err != nil {
+		err = per.WithPath(err, "value")
+		return
+	}
+	return
+}
+
+// ProtocolExtensionContainer is ProtocolExtensionContainer in NGAP-Containers.
+type ProtocolExtensionContainer []ProtocolExtensionField
+
+func (v ProtocolExtensionContainer) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxProtocolExtensions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *ProtocolExtensionContainer) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxProtocolExtensions, false)
+	if err != nil {
+		return
+	}
+	*v = make(ProtocolExtensionContainer, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// ProtocolExtensionField is ProtocolExtensionField in NGAP-Containers.
+type ProtocolExtensionField struct {
+	Id             ProtocolExtensionID
+	Criticality    Criticality
+	ExtensionValue []byte
+}
+
+func (v ProtocolExtensionField) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequence(false, 0, 0); err != nil {
+		return
+	}
+	if err = v.Id.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "id")
+		return
+	}
+	if err = v.Criticality.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "criticality")
+		return
+	}
+	if err = w.EncOpenType(func(w *per.BitWriter) error {
+		w.WriteOctets(v.ExtensionValue)
+		return nil
+	}); err != nil {
+		err = per.WithPath(err, "extensionValue")
+		return
+	}
+	return
+}
+
+func (v *ProtocolExtensionField) UnmarshalPER(r *per.BitReader) (err error) {
+	_, _, err = r.DecSequence(false, 0)
+	if err != nil {
+		return
+	}
+	*v = ProtocolExtensionField{}
+	if err = v.Id.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "id")
+		return
+	}
+	if err = v.Criticality.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "criticality")
+		return
+	}
+	if err = r.DecOpenType(func(r *per.BitReader) (err error) {
+		v.ExtensionValue, err = r.ReadOctets(r.Len() / 8)
+		return
+	}); err != nil {
+		err = per.WithPath(err, "extensionValue")
+		return
+	}
+	return
+}
+
+// AdditionalQosFlowInformation is AdditionalQosFlowInformation in NGAP-IEs.
+type AdditionalQosFlowInformation int
+
+const (
+	AdditionalQosFlowInformationMoreLikely AdditionalQosFlowInformation = iota
+)
+
+func (v AdditionalQosFlowInformation) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 0, true)
+}
+
+func (v *AdditionalQosFlowInformation) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 0, true)
+	*v = AdditionalQosFlowInformation(x)
+	return
+}
+
+// AllocationAndRetentionPriority is AllocationAndRetentionPriority in NGAP-IEs.
+type AllocationAndRetentionPriority struct {
+	PriorityLevelARP        PriorityLevelARP
+	PreEmptionCapability    PreEmptionCapability
+	PreEmptionVulnerability PreEmptionVulnerability
+	IEExtensions            *ProtocolExtensionContainer
+}
+
+func (v AllocationAndRetentionPriority) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PriorityLevelARP.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "priorityLevelARP")
+		return
+	}
+	if err = v.PreEmptionCapability.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pre-emptionCapability")
+		return
+	}
+	if err = v.PreEmptionVulnerability.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pre-emptionVulnerability")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *AllocationAndRetentionPriority) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = AllocationAndRetentionPriority{}
+	if err = v.PriorityLevelARP.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "priorityLevelARP")
+		return
+	}
+	if err = v.PreEmptionCapability.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pre-emptionCapability")
+		return
+	}
+	if err = v.PreEmptionVulnerability.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pre-emptionVulnerability")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// AllowedNSSAI is AllowedNSSAI in NGAP-IEs.
+type AllowedNSSAI []AllowedNSSAIItem
+
+func (v AllowedNSSAI) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofAllowedSNSSAIs, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *AllowedNSSAI) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofAllowedSNSSAIs, false)
+	if err != nil {
+		return
+	}
+	*v = make(AllowedNSSAI, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// AllowedNSSAIItem is AllowedNSSAI-Item in NGAP-IEs.
+type AllowedNSSAIItem struct {
+	SNSSAI       SNSSAI
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v AllowedNSSAIItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.SNSSAI.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "s-NSSAI")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *AllowedNSSAIItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = AllowedNSSAIItem{}
+	if err = v.SNSSAI.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "s-NSSAI")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// AllowedTACs is AllowedTACs in NGAP-IEs.
+type AllowedTACs []TAC
+
+func (v AllowedTACs) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofAllowedAreas, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *AllowedTACs) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofAllowedAreas, false)
+	if err != nil {
+		return
+	}
+	*v = make(AllowedTACs, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// AMFName is AMFName in NGAP-IEs.
+type AMFName string
+
+func (v AMFName) MarshalPER(w *per.BitWriter) error {
+	return w.EncPrintableString(string(v), "", 1, 150, true)
+}
+
+func (v *AMFName) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecPrintableString("", 1, 150, true)
+	if err != nil {
+		return
+	}
+	*v = AMFName(x)
+	return
+}
+
+// AMFPagingTarget is AMFPagingTarget in NGAP-IEs.
+type AMFPagingTarget struct {
+	GlobalRANNodeID  *GlobalRANNodeID
+	TAI              *TAI
+	ChoiceExtensions *ProtocolIESingleContainer
+}
+
+func (v AMFPagingTarget) MarshalPER(w *per.BitWriter) (err error) {
+	chosen := 0
+	if v.GlobalRANNodeID != nil {
+		chosen++
+	}
+	if v.TAI != nil {
+		chosen++
+	}
+	if v.ChoiceExtensions != nil {
+		chosen++
+	}
+	if chosen > 1 {
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "more than one alternative is chosen"}
+		return
+	}
+	switch {
+	case v.GlobalRANNodeID != nil:
+		if err = w.EncChoice(0, 0, 2, false); err != nil {
+			return
+		}
+		if err = v.GlobalRANNodeID.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "globalRANNodeID")
+			return
+		}
+	case v.TAI != nil:
+		if err = w.EncChoice(1, 0, 2, false); err != nil {
+			return
+		}
+		if err = v.TAI.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "tAI")
+			return
+		}
+	case v.ChoiceExtensions != nil:
+		if err = w.EncChoice(2, 0, 2, false); err != nil {
+			return
+		}
+		if err = v.ChoiceExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	default:
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "no alternative is chosen"}
+	}
+	return
+}
+
+func (v *AMFPagingTarget) UnmarshalPER(r *per.BitReader) (err error) {
+	index, err := r.DecChoice(0, 2, false)
+	if err != nil {
+		return
+	}
+	*v = AMFPagingTarget{}
+	switch index {
+	case 0:
+		v.GlobalRANNodeID = new(GlobalRANNodeID)
+		if err = v.GlobalRANNodeID.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "globalRANNodeID")
+			return
+		}
+	case 1:
+		v.TAI = new(TAI)
+		if err = v.TAI.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "tAI")
+			return
+		}
+	case 2:
+		v.ChoiceExtensions = new(ProtocolIESingleContainer)
+		if err = v.ChoiceExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	}
+	return
+}
+
+// AMFPointer is AMFPointer in NGAP-IEs.
+type AMFPointer per.BitString
+
+func (v AMFPointer) MarshalPER(w *per.BitWriter) error {
+	return w.EncBitString(v.Bytes, v.BitLength, 6, 6, false)
+}
+
+func (v *AMFPointer) UnmarshalPER(r *per.BitReader) (err error) {
+	v.Bytes, v.BitLength, err = r.DecBitString(6, 6, false)
+	return
+}
+
+// AMFRegionID is AMFRegionID in NGAP-IEs.
+type AMFRegionID per.BitString
+
+func (v AMFRegionID) MarshalPER(w *per.BitWriter) error {
+	return w.EncBitString(v.Bytes, v.BitLength, 8, 8, false)
+}
+
+func (v *AMFRegionID) UnmarshalPER(r *per.BitReader) (err error) {
+	v.Bytes, v.BitLength, err = r.DecBitString(8, 8, false)
+	return
+}
+
+// AMFSetID is AMFSetID in NGAP-IEs.
+type AMFSetID per.BitString
+
+func (v AMFSetID) MarshalPER(w *per.BitWriter) error {
+	return w.EncBitString(v.Bytes, v.BitLength, 10, 10, false)
+}
+
+func (v *AMFSetID) UnmarshalPER(r *per.BitReader) (err error) {
+	v.Bytes, v.BitLength, err = r.DecBitString(10, 10, false)
+	return
+}
+
+// AMFUENGAPID is AMF-UE-NGAP-ID in NGAP-IEs.
+type AMFUENGAPID int
+
+func (v AMFUENGAPID) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 0, 1099511627775, false)
+}
+
+func (v *AMFUENGAPID) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(0, 1099511627775, false)
+	if err != nil {
+		return
+	}
+	*v = AMFUENGAPID(x)
+	return
+}
+
+// AssistanceDataForPaging is AssistanceDataForPaging in NGAP-IEs.
+type AssistanceDataForPaging struct {
+	AssistanceDataForRecommendedCells *AssistanceDataForRecommendedCells
+	PagingAttemptInformation          *PagingAttemptInformation
+	IEExtensions                      *ProtocolExtensionContainer
+}
+
+func (v AssistanceDataForPaging) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.AssistanceDataForRecommendedCells != nil {
+		optflag |= 1 << 2
+	}
+	if v.PagingAttemptInformation != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 3, optflag); err != nil {
+		return
+	}
+	if v.AssistanceDataForRecommendedCells != nil {
+		if err = v.AssistanceDataForRecommendedCells.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "assistanceDataForRecommendedCells")
+			return
+		}
+	}
+	if v.PagingAttemptInformation != nil {
+		if err = v.PagingAttemptInformation.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "pagingAttemptInformation")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *AssistanceDataForPaging) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 3)
+	if err != nil {
+		return
+	}
+	*v = AssistanceDataForPaging{}
+	if optflag&(1<<2) != 0 {
+		v.AssistanceDataForRecommendedCells = new(AssistanceDataForRecommendedCells)
+		if err = v.AssistanceDataForRecommendedCells.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "assistanceDataForRecommendedCells")
+			return
+		}
+	}
+	if optflag&(1<<1) != 0 {
+		v.PagingAttemptInformation = new(PagingAttemptInformation)
+		if err = v.PagingAttemptInformation.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "pagingAttemptInformation")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// AssistanceDataForRecommendedCells is AssistanceDataForRecommendedCells in NGAP-IEs.
+type AssistanceDataForRecommendedCells struct {
+	RecommendedCellsForPaging RecommendedCellsForPaging
+	IEExtensions              *ProtocolExtensionContainer
+}
+
+func (v AssistanceDataForRecommendedCells) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.RecommendedCellsForPaging.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "recommendedCellsForPaging")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *AssistanceDataForRecommendedCells) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = AssistanceDataForRecommendedCells{}
+	if err = v.RecommendedCellsForPaging.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "recommendedCellsForPaging")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// AssociatedQosFlowItemQosFlowMappingIndication is the type of AssociatedQosFlowItem-qosFlowMappingIndication.
+type AssociatedQosFlowItemQosFlowMappingIndication int
+
+const (
+	AssociatedQosFlowItemQosFlowMappingIndicationUl AssociatedQosFlowItemQosFlowMappingIndication = iota
+	AssociatedQosFlowItemQosFlowMappingIndicationDl
+)
+
+func (v AssociatedQosFlowItemQosFlowMappingIndication) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 1, true)
+}
+
+func (v *AssociatedQosFlowItemQosFlowMappingIndication) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 1, true)
+	*v = AssociatedQosFlowItemQosFlowMappingIndication(x)
+	return
+}
+
+// AssociatedQosFlowItem is AssociatedQosFlowItem in NGAP-IEs.
+type AssociatedQosFlowItem struct {
+	QosFlowIdentifier        QosFlowIdentifier
+	QosFlowMappingIndication *AssociatedQosFlowItemQosFlowMappingIndication
+	IEExtensions             *ProtocolExtensionContainer
+}
+
+func (v AssociatedQosFlowItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.QosFlowMappingIndication != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 2, optflag); err != nil {
+		return
+	}
+	if err = v.QosFlowIdentifier.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "qosFlowIdentifier")
+		return
+	}
+	if v.QosFlowMappingIndication != nil {
+		if err = v.QosFlowMappingIndication.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "qosFlowMappingIndication")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *AssociatedQosFlowItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 2)
+	if err != nil {
+		return
+	}
+	*v = AssociatedQosFlowItem{}
+	if err = v.QosFlowIdentifier.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "qosFlowIdentifier")
+		return
+	}
+	if optflag&(1<<1) != 0 {
+		v.QosFlowMappingIndication = new(AssociatedQosFlowItemQosFlowMappingIndication)
+		if err = v.QosFlowMappingIndication.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "qosFlowMappingIndication")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// AssociatedQosFlowList is AssociatedQosFlowList in NGAP-IEs.
+type AssociatedQosFlowList []AssociatedQosFlowItem
+
+func (v AssociatedQosFlowList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofQosFlows, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *AssociatedQosFlowList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofQosFlows, false)
+	if err != nil {
+		return
+	}
+	*v = make(AssociatedQosFlowList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// AveragingWindow is AveragingWindow in NGAP-IEs.
+type AveragingWindow int
+
+func (v AveragingWindow) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 0, 4095, true)
+}
+
+func (v *AveragingWindow) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(0, 4095, true)
+	if err != nil {
+		return
+	}
+	*v = AveragingWindow(x)
+	return
+}
+
+// BitRate is BitRate in NGAP-IEs.
+type BitRate int
+
+func (v BitRate) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 0, 4000000000000, true)
+}
+
+func (v *BitRate) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(0, 4000000000000, true)
+	if err != nil {
+		return
+	}
+	*v = BitRate(x)
+	return
+}
+
+// BroadcastPLMNList is BroadcastPLMNList in NGAP-IEs.
+type BroadcastPLMNList []BroadcastPLMNItem
+
+func (v BroadcastPLMNList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofBPLMNs, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *BroadcastPLMNList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofBPLMNs, false)
+	if err != nil {
+		return
+	}
+	*v = make(BroadcastPLMNList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// BroadcastPLMNItem is BroadcastPLMNItem in NGAP-IEs.
+type BroadcastPLMNItem struct {
+	PLMNIdentity        PLMNIdentity
+	TAISliceSupportList SliceSupportList
+	IEExtensions        *ProtocolExtensionContainer
+}
+
+func (v BroadcastPLMNItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PLMNIdentity.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.TAISliceSupportList.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "tAISliceSupportList")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *BroadcastPLMNItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = BroadcastPLMNItem{}
+	if err = v.PLMNIdentity.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.TAISliceSupportList.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "tAISliceSupportList")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// Cause is Cause in NGAP-IEs.
+type Cause struct {
+	RadioNetwork     *CauseRadioNetwork
+	Transport        *CauseTransport
+	Nas              *CauseNas
+	Protocol         *CauseProtocol
+	Misc             *CauseMisc
+	ChoiceExtensions *ProtocolIESingleContainer
+}
+
+func (v Cause) MarshalPER(w *per.BitWriter) (err error) {
+	chosen := 0
+	if v.RadioNetwork != nil {
+		chosen++
+	}
+	if v.Transport != nil {
+		chosen++
+	}
+	if v.Nas != nil {
+		chosen++
+	}
+	if v.Protocol != nil {
+		chosen++
+	}
+	if v.Misc != nil {
+		chosen++
+	}
+	if v.ChoiceExtensions != nil {
+		chosen++
+	}
+	if chosen > 1 {
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "more than one alternative is chosen"}
+		return
+	}
+	switch {
+	case v.RadioNetwork != nil:
+		if err = w.EncChoice(0, 0, 5, false); err != nil {
+			return
+		}
+		if err = v.RadioNetwork.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "radioNetwork")
+			return
+		}
+	case v.Transport != nil:
+		if err = w.EncChoice(1, 0, 5, false); err != nil {
+			return
+		}
+		if err = v.Transport.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "transport")
+			return
+		}
+	case v.Nas != nil:
+		if err = w.EncChoice(2, 0, 5, false); err != nil {
+			return
+		}
+		if err = v.Nas.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "nas")
+			return
+		}
+	case v.Protocol != nil:
+		if err = w.EncChoice(3, 0, 5, false); err != nil {
+			return
+		}
+		if err = v.Protocol.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "protocol")
+			return
+		}
+	case v.Misc != nil:
+		if err = w.EncChoice(4, 0, 5, false); err != nil {
+			return
+		}
+		if err = v.Misc.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "misc")
+			return
+		}
+	case v.ChoiceExtensions != nil:
+		if err = w.EncChoice(5, 0, 5, false); err != nil {
+			return
+		}
+		if err = v.ChoiceExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	default:
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "no alternative is chosen"}
+	}
+	return
+}
+
+func (v *Cause) UnmarshalPER(r *per.BitReader) (err error) {
+	index, err := r.DecChoice(0, 5, false)
+	if err != nil {
+		return
+	}
+	*v = Cause{}
+	switch index {
+	case 0:
+		v.RadioNetwork = new(CauseRadioNetwork)
+		if err = v.RadioNetwork.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "radioNetwork")
+			return
+		}
+	case 1:
+		v.Transport = new(CauseTransport)
+		if err = v.Transport.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "transport")
+			return
+		}
+	case 2:
+		v.Nas = new(CauseNas)
+		if err = v.Nas.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "nas")
+			return
+		}
+	case 3:
+		v.Protocol = new(CauseProtocol)
+		if err = v.Protocol.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "protocol")
+			return
+		}
+	case 4:
+		v.Misc = new(CauseMisc)
+		if err = v.Misc.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "misc")
+			return
+		}
+	case 5:
+		v.ChoiceExtensions = new(ProtocolIESingleContainer)
+		if err = v.ChoiceExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	}
+	return
+}
+
+// CauseMisc is CauseMisc in NGAP-IEs.
+type CauseMisc int
+
+const (
+	CauseMiscControlProcessingOverload CauseMisc = iota
+	CauseMiscNotEnoughUserPlaneProcessingResources
+	CauseMiscHardwareFailure
+	CauseMiscOmIntervention
+	CauseMiscUnknownPLMN
+	CauseMiscUnspecified
+)
+
+func (v CauseMisc) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 5, true)
+}
+
+func (v *CauseMisc) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 5, true)
+	*v = CauseMisc(x)
+	return
+}
+
+// CauseNas is CauseNas in NGAP-IEs.
+type CauseNas int
+
+const (
+	CauseNasNormalRelease CauseNas = iota
+	CauseNasAuthenticationFailure
+	CauseNasDeregister
+	CauseNasUnspecified
+)
+
+func (v CauseNas) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 3, true)
+}
+
+func (v *CauseNas) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 3, true)
+	*v = CauseNas(x)
+	return
+}
+
+// CauseProtocol is CauseProtocol in NGAP-IEs.
+type CauseProtocol int
+
+const (
+	CauseProtocolTransferSyntaxError CauseProtocol = iota
+	CauseProtocolAbstractSyntaxErrorReject
+	CauseProtocolAbstractSyntaxErrorIgnoreAndNotify
+	CauseProtocolMessageNotCompatibleWithReceiverState
+	CauseProtocolSemanticError
+	CauseProtocolAbstractSyntaxErrorFalselyConstructedMessage
+	CauseProtocolUnspecified
+)
+
+func (v CauseProtocol) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 6, true)
+}
+
+func (v *CauseProtocol) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 6, true)
+	*v = CauseProtocol(x)
+	return
+}
+
+// CauseRadioNetwork is CauseRadioNetwork in NGAP-IEs.
+type CauseRadioNetwork int
+
+const (
+	CauseRadioNetworkUnspecified CauseRadioNetwork = iota
+	CauseRadioNetworkTxnrelocoverallExpiry
+	CauseRadioNetworkSuccessfulHandover
+	CauseRadioNetworkReleaseDueToNgranGeneratedReason
+	CauseRadioNetworkReleaseDueTo5gcGeneratedReason
+	CauseRadioNetworkHandoverCancelled
+	CauseRadioNetworkPartialHandover
+	CauseRadioNetworkHoFailureInTarget5GCNgranNodeOrTargetSystem
+	CauseRadioNetworkHoTargetNotAllowed
+	CauseRadioNetworkTngrelocoverallExpiry
+	CauseRadioNetworkTngrelocprepExpiry
+	CauseRadioNetworkCellNotAvailable
+	CauseRadioNetworkUnknownTargetID
+	CauseRadioNetworkNoRadioResourcesAvailableInTargetCell
+	CauseRadioNetworkUnknownLocalUENGAPID
+	CauseRadioNetworkInconsistentRemoteUENGAPID
+	CauseRadioNetworkHandoverDesirableForRadioReason
+	CauseRadioNetworkTimeCriticalHandover
+	CauseRadioNetworkResourceOptimisationHandover
+	CauseRadioNetworkReduceLoadInServingCell
+	CauseRadioNetworkUserInactivity
+	CauseRadioNetworkRadioConnectionWithUeLost
+	CauseRadioNetworkRadioResourcesNotAvailable
+	CauseRadioNetworkInvalidQosCombination
+	CauseRadioNetworkFailureInRadioInterfaceProcedure
+	CauseRadioNetworkInteractionWithOtherProcedure
+	CauseRadioNetworkUnknownPDUSessionID
+	CauseRadioNetworkUnkownQosFlowID
+	CauseRadioNetworkMultiplePDUSessionIDInstances
+	CauseRadioNetworkMultipleQosFlowIDInstances
+	CauseRadioNetworkEncryptionAndOrIntegrityProtectionAlgorithmsNotSupported
+	CauseRadioNetworkNgIntraSystemHandoverTriggered
+	CauseRadioNetworkNgInterSystemHandoverTriggered
+	CauseRadioNetworkXnHandoverTriggered
+	CauseRadioNetworkNotSupported5QIValue
+	CauseRadioNetworkUeContextTransfer
+	CauseRadioNetworkImsVoiceEpsFallbackOrRatFallbackTriggered
+	CauseRadioNetworkUpIntegrityProtectionNotPossible
+	CauseRadioNetworkUpConfidentialityProtectionNotPossible
+	CauseRadioNetworkSliceNotSupported
+	CauseRadioNetworkUeInRrcInactiveStateNotReachable
+	CauseRadioNetworkRedirection
+	CauseRadioNetworkResourcesNotAvailableForTheSlice
+	CauseRadioNetworkUeMaxIntegrityProtectedDataRateReason
+	CauseRadioNetworkReleaseDueToCnDetectedMobility
+)
+
+func (v CauseRadioNetwork) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 44, true)
+}
+
+func (v *CauseRadioNetwork) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 44, true)
+	*v = CauseRadioNetwork(x)
+	return
+}
+
+// CauseTransport is CauseTransport in NGAP-IEs.
+type CauseTransport int
+
+const (
+	CauseTransportTransportResourceUnavailable CauseTransport = iota
+	CauseTransportUnspecified
+)
+
+func (v CauseTransport) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 1, true)
+}
+
+func (v *CauseTransport) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 1, true)
+	*v = CauseTransport(x)
+	return
+}
+
+// ConfidentialityProtectionIndication is ConfidentialityProtectionIndication in NGAP-IEs.
+type ConfidentialityProtectionIndication int
+
+const (
+	ConfidentialityProtectionIndicationRequired ConfidentialityProtectionIndication = iota
+	ConfidentialityProtectionIndicationPreferred
+	ConfidentialityProtectionIndicationNotNeeded
+)
+
+func (v ConfidentialityProtectionIndication) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 2, true)
+}
+
+func (v *ConfidentialityProtectionIndication) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 2, true)
+	*v = ConfidentialityProtectionIndication(x)
+	return
+}
+
+// ConfidentialityProtectionResult is ConfidentialityProtectionResult in NGAP-IEs.
+type ConfidentialityProtectionResult int
+
+const (
+	ConfidentialityProtectionResultPerformed ConfidentialityProtectionResult = iota
+	ConfidentialityProtectionResultNotPerformed
+)
+
+func (v ConfidentialityProtectionResult) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 1, true)
+}
+
+func (v *ConfidentialityProtectionResult) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 1, true)
+	*v = ConfidentialityProtectionResult(x)
+	return
+}
+
+// CoreNetworkAssistanceInformation is CoreNetworkAssistanceInformation in NGAP-IEs.
+type CoreNetworkAssistanceInformation struct {
+	UEIdentityIndexValue            UEIdentityIndexValue
+	UESpecificDRX                   *PagingDRX
+	PeriodicRegistrationUpdateTimer PeriodicRegistrationUpdateTimer
+	MICOModeIndication              *MICOModeIndication
+	TAIListForInactive              TAIListForInactive
+	ExpectedUEBehaviour             *ExpectedUEBehaviour
+	IEExtensions                    *ProtocolExtensionContainer
+}
+
+func (v CoreNetworkAssistanceInformation) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.UESpecificDRX != nil {
+		optflag |= 1 << 3
+	}
+	if v.MICOModeIndication != nil {
+		optflag |= 1 << 2
+	}
+	if v.ExpectedUEBehaviour != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 4, optflag); err != nil {
+		return
+	}
+	if err = v.UEIdentityIndexValue.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "uEIdentityIndexValue")
+		return
+	}
+	if v.UESpecificDRX != nil {
+		if err = v.UESpecificDRX.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "uESpecificDRX")
+			return
+		}
+	}
+	if err = v.PeriodicRegistrationUpdateTimer.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "periodicRegistrationUpdateTimer")
+		return
+	}
+	if v.MICOModeIndication != nil {
+		if err = v.MICOModeIndication.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "mICOModeIndication")
+			return
+		}
+	}
+	if err = v.TAIListForInactive.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "tAIListForInactive")
+		return
+	}
+	if v.ExpectedUEBehaviour != nil {
+		if err = v.ExpectedUEBehaviour.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "expectedUEBehaviour")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *CoreNetworkAssistanceInformation) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 4)
+	if err != nil {
+		return
+	}
+	*v = CoreNetworkAssistanceInformation{}
+	if err = v.UEIdentityIndexValue.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "uEIdentityIndexValue")
+		return
+	}
+	if optflag&(1<<3) != 0 {
+		v.UESpecificDRX = new(PagingDRX)
+		if err = v.UESpecificDRX.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "uESpecificDRX")
+			return
+		}
+	}
+	if err = v.PeriodicRegistrationUpdateTimer.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "periodicRegistrationUpdateTimer")
+		return
+	}
+	if optflag&(1<<2) != 0 {
+		v.MICOModeIndication = new(MICOModeIndication)
+		if err = v.MICOModeIndication.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "mICOModeIndication")
+			return
+		}
+	}
+	if err = v.TAIListForInactive.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "tAIListForInactive")
+		return
+	}
+	if optflag&(1<<1) != 0 {
+		v.ExpectedUEBehaviour = new(ExpectedUEBehaviour)
+		if err = v.ExpectedUEBehaviour.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "expectedUEBehaviour")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// CriticalityDiagnostics is CriticalityDiagnostics in NGAP-IEs.
+type CriticalityDiagnostics struct {
+	ProcedureCode             *ProcedureCode
+	TriggeringMessage         *TriggeringMessage
+	ProcedureCriticality      *Criticality
+	IEsCriticalityDiagnostics *CriticalityDiagnosticsIEList
+	IEExtensions              *ProtocolExtensionContainer
+}
+
+func (v CriticalityDiagnostics) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.ProcedureCode != nil {
+		optflag |= 1 << 4
+	}
+	if v.TriggeringMessage != nil {
+		optflag |= 1 << 3
+	}
+	if v.ProcedureCriticality != nil {
+		optflag |= 1 << 2
+	}
+	if v.IEsCriticalityDiagnostics != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 5, optflag); err != nil {
+		return
+	}
+	if v.ProcedureCode != nil {
+		if err = v.ProcedureCode.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "procedureCode")
+			return
+		}
+	}
+	if v.TriggeringMessage != nil {
+		if err = v.TriggeringMessage.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "triggeringMessage")
+			return
+		}
+	}
+	if v.ProcedureCriticality != nil {
+		if err = v.ProcedureCriticality.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "procedureCriticality")
+			return
+		}
+	}
+	if v.IEsCriticalityDiagnostics != nil {
+		if err = v.IEsCriticalityDiagnostics.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iEsCriticalityDiagnostics")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *CriticalityDiagnostics) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 5)
+	if err != nil {
+		return
+	}
+	*v = CriticalityDiagnostics{}
+	if optflag&(1<<4) != 0 {
+		v.ProcedureCode = new(ProcedureCode)
+		if err = v.ProcedureCode.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "procedureCode")
+			return
+		}
+	}
+	if optflag&(1<<3) != 0 {
+		v.TriggeringMessage = new(TriggeringMessage)
+		if err = v.TriggeringMessage.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "triggeringMessage")
+			return
+		}
+	}
+	if optflag&(1<<2) != 0 {
+		v.ProcedureCriticality = new(Criticality)
+		if err = v.ProcedureCriticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "procedureCriticality")
+			return
+		}
+	}
+	if optflag&(1<<1) != 0 {
+		v.IEsCriticalityDiagnostics = new(CriticalityDiagnosticsIEList)
+		if err = v.IEsCriticalityDiagnostics.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iEsCriticalityDiagnostics")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// CriticalityDiagnosticsIEItem is CriticalityDiagnostics-IE-Item in NGAP-IEs.
+type CriticalityDiagnosticsIEItem struct {
+	IECriticality Criticality
+	IEID          ProtocolIEID
+	TypeOfError   TypeOfError
+	IEExtensions  *ProtocolExtensionContainer
+}
+
+func (v CriticalityDiagnosticsIEItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.IECriticality.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "iECriticality")
+		return
+	}
+	if err = v.IEID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "iE-ID")
+		return
+	}
+	if err = v.TypeOfError.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "typeOfError")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *CriticalityDiagnosticsIEItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = CriticalityDiagnosticsIEItem{}
+	if err = v.IECriticality.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "iECriticality")
+		return
+	}
+	if err = v.IEID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "iE-ID")
+		return
+	}
+	if err = v.TypeOfError.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "typeOfError")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// CriticalityDiagnosticsIEList is CriticalityDiagnostics-IE-List in NGAP-IEs.
+type CriticalityDiagnosticsIEList []CriticalityDiagnosticsIEItem
+
+func (v CriticalityDiagnosticsIEList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofErrors, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *CriticalityDiagnosticsIEList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofErrors, false)
+	if err != nil {
+		return
+	}
+	*v = make(CriticalityDiagnosticsIEList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// DataForwardingNotPossible is DataForwardingNotPossible in NGAP-IEs.
+type DataForwardingNotPossible int
+
+const (
+	DataForwardingNotPossibleDataForwardingNotPossible DataForwardingNotPossible = iota
+)
+
+func (v DataForwardingNotPossible) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 0, true)
+}
+
+func (v *DataForwardingNotPossible) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 0, true)
+	*v = DataForwardingNotPossible(x)
+	return
+}
+
+// DelayCritical is DelayCritical in NGAP-IEs.
+type DelayCritical int
+
+const (
+	DelayCriticalDelayCritical DelayCritical = iota
+	DelayCriticalNonDelayCritical
+)
+
+func (v DelayCritical) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 1, true)
+}
+
+func (v *DelayCritical) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 1, true)
+	*v = DelayCritical(x)
+	return
+}
+
+// Dynamic5QIDescriptor is Dynamic5QIDescriptor in NGAP-IEs.
+type Dynamic5QIDescriptor struct {
+	PriorityLevelQos       PriorityLevelQos
+	PacketDelayBudget      PacketDelayBudget
+	PacketErrorRate        PacketErrorRate
+	FiveQI                 *FiveQI
+	DelayCritical          *DelayCritical
+	AveragingWindow        *AveragingWindow
+	MaximumDataBurstVolume *MaximumDataBurstVolume
+	IEExtensions           *ProtocolExtensionContainer
+}
+
+func (v Dynamic5QIDescriptor) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.FiveQI != nil {
+		optflag |= 1 << 4
+	}
+	if v.DelayCritical != nil {
+		optflag |= 1 << 3
+	}
+	if v.AveragingWindow != nil {
+		optflag |= 1 << 2
+	}
+	if v.MaximumDataBurstVolume != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 5, optflag); err != nil {
+		return
+	}
+	if err = v.PriorityLevelQos.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "priorityLevelQos")
+		return
+	}
+	if err = v.PacketDelayBudget.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "packetDelayBudget")
+		return
+	}
+	if err = v.PacketErrorRate.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "packetErrorRate")
+		return
+	}
+	if v.FiveQI != nil {
+		if err = v.FiveQI.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "fiveQI")
+			return
+		}
+	}
+	if v.DelayCritical != nil {
+		if err = v.DelayCritical.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "delayCritical")
+			return
+		}
+	}
+	if v.AveragingWindow != nil {
+		if err = v.AveragingWindow.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "averagingWindow")
+			return
+		}
+	}
+	if v.MaximumDataBurstVolume != nil {
+		if err = v.MaximumDataBurstVolume.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "maximumDataBurstVolume")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *Dynamic5QIDescriptor) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 5)
+	if err != nil {
+		return
+	}
+	*v = Dynamic5QIDescriptor{}
+	if err = v.PriorityLevelQos.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "priorityLevelQos")
+		return
+	}
+	if err = v.PacketDelayBudget.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "packetDelayBudget")
+		return
+	}
+	if err = v.PacketErrorRate.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "packetErrorRate")
+		return
+	}
+	if optflag&(1<<4) != 0 {
+		v.FiveQI = new(FiveQI)
+		if err = v.FiveQI.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "fiveQI")
+			return
+		}
+	}
+	if optflag&(1<<3) != 0 {
+		v.DelayCritical = new(DelayCritical)
+		if err = v.DelayCritical.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "delayCritical")
+			return
+		}
+	}
+	if optflag&(1<<2) != 0 {
+		v.AveragingWindow = new(AveragingWindow)
+		if err = v.AveragingWindow.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "averagingWindow")
+			return
+		}
+	}
+	if optflag&(1<<1) != 0 {
+		v.MaximumDataBurstVolume = new(MaximumDataBurstVolume)
+		if err = v.MaximumDataBurstVolume.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "maximumDataBurstVolume")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// EmergencyFallbackIndicator is EmergencyFallbackIndicator in NGAP-IEs.
+type EmergencyFallbackIndicator struct {
+	EmergencyFallbackRequestIndicator EmergencyFallbackRequestIndicator
+	EmergencyServiceTargetCN          *EmergencyServiceTargetCN
+	IEExtensions                      *ProtocolExtensionContainer
+}
+
+func (v EmergencyFallbackIndicator) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.EmergencyServiceTargetCN != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 2, optflag); err != nil {
+		return
+	}
+	if err = v.EmergencyFallbackRequestIndicator.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "emergencyFallbackRequestIndicator")
+		return
+	}
+	if v.EmergencyServiceTargetCN != nil {
+		if err = v.EmergencyServiceTargetCN.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "emergencyServiceTargetCN")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *EmergencyFallbackIndicator) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 2)
+	if err != nil {
+		return
+	}
+	*v = EmergencyFallbackIndicator{}
+	if err = v.EmergencyFallbackRequestIndicator.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "emergencyFallbackRequestIndicator")
+		return
+	}
+	if optflag&(1<<1) != 0 {
+		v.EmergencyServiceTargetCN = new(EmergencyServiceTargetCN)
+		if err = v.EmergencyServiceTargetCN.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "emergencyServiceTargetCN")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// EmergencyFallbackRequestIndicator is EmergencyFallbackRequestIndicator in NGAP-IEs.
+type EmergencyFallbackRequestIndicator int
+
+const (
+	EmergencyFallbackRequestIndicatorEmergencyFallbackRequested EmergencyFallbackRequestIndicator = iota
+)
+
+func (v EmergencyFallbackRequestIndicator) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 0, true)
+}
+
+func (v *EmergencyFallbackRequestIndicator) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 0, true)
+	*v = EmergencyFallbackRequestIndicator(x)
+	return
+}
+
+// EmergencyServiceTargetCN is EmergencyServiceTargetCN in NGAP-IEs.
+type EmergencyServiceTargetCN int
+
+const (
+	EmergencyServiceTargetCNFiveGC EmergencyServiceTargetCN = iota
+	EmergencyServiceTargetCNEpc
+)
+
+func (v EmergencyServiceTargetCN) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 1, true)
+}
+
+func (v *EmergencyServiceTargetCN) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 1, true)
+	*v = EmergencyServiceTargetCN(x)
+	return
+}
+
+// EquivalentPLMNs is EquivalentPLMNs in NGAP-IEs.
+type EquivalentPLMNs []PLMNIdentity
+
+func (v EquivalentPLMNs) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofEPLMNs, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *EquivalentPLMNs) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofEPLMNs, false)
+	if err != nil {
+		return
+	}
+	*v = make(EquivalentPLMNs, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// ERABID is E-RAB-ID in NGAP-IEs.
+type ERABID int
+
+func (v ERABID) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 0, 15, true)
+}
+
+func (v *ERABID) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(0, 15, true)
+	if err != nil {
+		return
+	}
+	*v = ERABID(x)
+	return
+}
+
+// EUTRACellIdentity is EUTRACellIdentity in NGAP-IEs.
+type EUTRACellIdentity per.BitString
+
+func (v EUTRACellIdentity) MarshalPER(w *per.BitWriter) error {
+	return w.EncBitString(v.Bytes, v.BitLength, 28, 28, false)
+}
+
+func (v *EUTRACellIdentity) UnmarshalPER(r *per.BitReader) (err error) {
+	v.Bytes, v.BitLength, err = r.DecBitString(28, 28, false)
+	return
+}
+
+// EUTRACGI is EUTRA-CGI in NGAP-IEs.
+type EUTRACGI struct {
+	PLMNIdentity      PLMNIdentity
+	EUTRACellIdentity EUTRACellIdentity
+	IEExtensions      *ProtocolExtensionContainer
+}
+
+func (v EUTRACGI) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PLMNIdentity.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.EUTRACellIdentity.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "eUTRACellIdentity")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *EUTRACGI) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = EUTRACGI{}
+	if err = v.PLMNIdentity.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.EUTRACellIdentity.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "eUTRACellIdentity")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// EUTRAencryptionAlgorithms is EUTRAencryptionAlgorithms in NGAP-IEs.
+type EUTRAencryptionAlgorithms per.BitString
+
+func (v EUTRAencryptionAlgorithms) MarshalPER(w *per.BitWriter) error {
+	return w.EncBitString(v.Bytes, v.BitLength, 16, 16, true)
+}
+
+func (v *EUTRAencryptionAlgorithms) UnmarshalPER(r *per.BitReader) (err error) {
+	v.Bytes, v.BitLength, err = r.DecBitString(16, 16, true)
+	return
+}
+
+// EUTRAintegrityProtectionAlgorithms is EUTRAintegrityProtectionAlgorithms in NGAP-IEs.
+type EUTRAintegrityProtectionAlgorithms per.BitString
+
+func (v EUTRAintegrityProtectionAlgorithms) MarshalPER(w *per.BitWriter) error {
+	return w.EncBitString(v.Bytes, v.BitLength, 16, 16, true)
+}
+
+func (v *EUTRAintegrityProtectionAlgorithms) UnmarshalPER(r *per.BitReader) (err error) {
+	v.Bytes, v.BitLength, err = r.DecBitString(16, 16, true)
+	return
+}
+
+// ExpectedActivityPeriod is ExpectedActivityPeriod in NGAP-IEs.
+type ExpectedActivityPeriod int
+
+func (v ExpectedActivityPeriod) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 1, 181, true)
+}
+
+func (v *ExpectedActivityPeriod) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(1, 181, true)
+	if err != nil {
+		return
+	}
+	*v = ExpectedActivityPeriod(x)
+	return
+}
+
+// ExpectedHOInterval is ExpectedHOInterval in NGAP-IEs.
+type ExpectedHOInterval int
+
+const (
+	ExpectedHOIntervalSec15 ExpectedHOInterval = iota
+	ExpectedHOIntervalSec30
+	ExpectedHOIntervalSec60
+	ExpectedHOIntervalSec90
+	ExpectedHOIntervalSec120
+	ExpectedHOIntervalSec180
+	ExpectedHOIntervalLongTime
+)
+
+func (v ExpectedHOInterval) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 6, true)
+}
+
+func (v *ExpectedHOInterval) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 6, true)
+	*v = ExpectedHOInterval(x)
+	return
+}
+
+// ExpectedIdlePeriod is ExpectedIdlePeriod in NGAP-IEs.
+type ExpectedIdlePeriod int
+
+func (v ExpectedIdlePeriod) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 1, 181, true)
+}
+
+func (v *ExpectedIdlePeriod) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(1, 181, true)
+	if err != nil {
+		return
+	}
+	*v = ExpectedIdlePeriod(x)
+	return
+}
+
+// ExpectedUEActivityBehaviour is ExpectedUEActivityBehaviour in NGAP-IEs.
+type ExpectedUEActivityBehaviour struct {
+	ExpectedActivityPeriod                 *ExpectedActivityPeriod
+	ExpectedIdlePeriod                     *ExpectedIdlePeriod
+	SourceOfUEActivityBehaviourInformation *SourceOfUEActivityBehaviourInformation
+	IEExtensions                           *ProtocolExtensionContainer
+}
+
+func (v ExpectedUEActivityBehaviour) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.ExpectedActivityPeriod != nil {
+		optflag |= 1 << 3
+	}
+	if v.ExpectedIdlePeriod != nil {
+		optflag |= 1 << 2
+	}
+	if v.SourceOfUEActivityBehaviourInformation != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 4, optflag); err != nil {
+		return
+	}
+	if v.ExpectedActivityPeriod != nil {
+		if err = v.ExpectedActivityPeriod.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "expectedActivityPeriod")
+			return
+		}
+	}
+	if v.ExpectedIdlePeriod != nil {
+		if err = v.ExpectedIdlePeriod.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "expectedIdlePeriod")
+			return
+		}
+	}
+	if v.SourceOfUEActivityBehaviourInformation != nil {
+		if err = v.SourceOfUEActivityBehaviourInformation.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "sourceOfUEActivityBehaviourInformation")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *ExpectedUEActivityBehaviour) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 4)
+	if err != nil {
+		return
+	}
+	*v = ExpectedUEActivityBehaviour{}
+	if optflag&(1<<3) != 0 {
+		v.ExpectedActivityPeriod = new(ExpectedActivityPeriod)
+		if err = v.ExpectedActivityPeriod.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "expectedActivityPeriod")
+			return
+		}
+	}
+	if optflag&(1<<2) != 0 {
+		v.ExpectedIdlePeriod = new(ExpectedIdlePeriod)
+		if err = v.ExpectedIdlePeriod.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "expectedIdlePeriod")
+			return
+		}
+	}
+	if optflag&(1<<1) != 0 {
+		v.SourceOfUEActivityBehaviourInformation = new(SourceOfUEActivityBehaviourInformation)
+		if err = v.SourceOfUEActivityBehaviourInformation.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "sourceOfUEActivityBehaviourInformation")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// ExpectedUEBehaviour is ExpectedUEBehaviour in NGAP-IEs.
+type ExpectedUEBehaviour struct {
+	ExpectedUEActivityBehaviour *ExpectedUEActivityBehaviour
+	ExpectedHOInterval          *ExpectedHOInterval
+	ExpectedUEMobility          *ExpectedUEMobility
+	ExpectedUEMovingTrajectory  *ExpectedUEMovingTrajectory
+	IEExtensions                *ProtocolExtensionContainer
+}
+
+func (v ExpectedUEBehaviour) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.ExpectedUEActivityBehaviour != nil {
+		optflag |= 1 << 4
+	}
+	if v.ExpectedHOInterval != nil {
+		optflag |= 1 << 3
+	}
+	if v.ExpectedUEMobility != nil {
+		optflag |= 1 << 2
+	}
+	if v.ExpectedUEMovingTrajectory != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 5, optflag); err != nil {
+		return
+	}
+	if v.ExpectedUEActivityBehaviour != nil {
+		if err = v.ExpectedUEActivityBehaviour.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "expectedUEActivityBehaviour")
+			return
+		}
+	}
+	if v.ExpectedHOInterval != nil {
+		if err = v.ExpectedHOInterval.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "expectedHOInterval")
+			return
+		}
+	}
+	if v.ExpectedUEMobility != nil {
+		if err = v.ExpectedUEMobility.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "expectedUEMobility")
+			return
+		}
+	}
+	if v.ExpectedUEMovingTrajectory != nil {
+		if err = v.ExpectedUEMovingTrajectory.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "expectedUEMovingTrajectory")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *ExpectedUEBehaviour) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 5)
+	if err != nil {
+		return
+	}
+	*v = ExpectedUEBehaviour{}
+	if optflag&(1<<4) != 0 {
+		v.ExpectedUEActivityBehaviour = new(ExpectedUEActivityBehaviour)
+		if err = v.ExpectedUEActivityBehaviour.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "expectedUEActivityBehaviour")
+			return
+		}
+	}
+	if optflag&(1<<3) != 0 {
+		v.ExpectedHOInterval = new(ExpectedHOInterval)
+		if err = v.ExpectedHOInterval.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "expectedHOInterval")
+			return
+		}
+	}
+	if optflag&(1<<2) != 0 {
+		v.ExpectedUEMobility = new(ExpectedUEMobility)
+		if err = v.ExpectedUEMobility.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "expectedUEMobility")
+			return
+		}
+	}
+	if optflag&(1<<1) != 0 {
+		v.ExpectedUEMovingTrajectory = new(ExpectedUEMovingTrajectory)
+		if err = v.ExpectedUEMovingTrajectory.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "expectedUEMovingTrajectory")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// ExpectedUEMobility is ExpectedUEMobility in NGAP-IEs.
+type ExpectedUEMobility int
+
+const (
+	ExpectedUEMobilityStationary ExpectedUEMobility = iota
+	ExpectedUEMobilityMobile
+)
+
+func (v ExpectedUEMobility) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 1, true)
+}
+
+func (v *ExpectedUEMobility) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 1, true)
+	*v = ExpectedUEMobility(x)
+	return
+}
+
+// ExpectedUEMovingTrajectory is ExpectedUEMovingTrajectory in NGAP-IEs.
+type ExpectedUEMovingTrajectory []ExpectedUEMovingTrajectoryItem
+
+func (v ExpectedUEMovingTrajectory) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofCellsUEMovingTrajectory, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *ExpectedUEMovingTrajectory) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofCellsUEMovingTrajectory, false)
+	if err != nil {
+		return
+	}
+	*v = make(ExpectedUEMovingTrajectory, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// ExpectedUEMovingTrajectoryItem is ExpectedUEMovingTrajectoryItem in NGAP-IEs.
+type ExpectedUEMovingTrajectoryItem struct {
+	NGRANCGI         NGRANCGI
+	TimeStayedInCell *int
+	IEExtensions     *ProtocolExtensionContainer
+}
+
+func (v ExpectedUEMovingTrajectoryItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.TimeStayedInCell != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 2, optflag); err != nil {
+		return
+	}
+	if err = v.NGRANCGI.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "nGRAN-CGI")
+		return
+	}
+	if v.TimeStayedInCell != nil {
+		if err = w.EncInteger(*v.TimeStayedInCell, 0, 4095, false); err != nil {
+			err = per.WithPath(err, "timeStayedInCell")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *ExpectedUEMovingTrajectoryItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 2)
+	if err != nil {
+		return
+	}
+	*v = ExpectedUEMovingTrajectoryItem{}
+	if err = v.NGRANCGI.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "nGRAN-CGI")
+		return
+	}
+	if optflag&(1<<1) != 0 {
+		v.TimeStayedInCell = new(int)
+		if *v.TimeStayedInCell, err = r.DecInteger(0, 4095, false); err != nil {
+			err = per.WithPath(err, "timeStayedInCell")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// FiveGSTMSI is FiveG-S-TMSI in NGAP-IEs.
+type FiveGSTMSI struct {
+	AMFSetID     AMFSetID
+	AMFPointer   AMFPointer
+	FiveGTMSI    FiveGTMSI
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v FiveGSTMSI) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.AMFSetID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "aMFSetID")
+		return
+	}
+	if err = v.AMFPointer.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "aMFPointer")
+		return
+	}
+	if err = v.FiveGTMSI.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "fiveG-TMSI")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *FiveGSTMSI) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = FiveGSTMSI{}
+	if err = v.AMFSetID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "aMFSetID")
+		return
+	}
+	if err = v.AMFPointer.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "aMFPointer")
+		return
+	}
+	if err = v.FiveGTMSI.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "fiveG-TMSI")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// FiveGTMSI is FiveG-TMSI in NGAP-IEs.
+type FiveGTMSI []byte
+
+func (v FiveGTMSI) MarshalPER(w *per.BitWriter) error {
+	return w.EncOctetString(v, 4, 4, false)
+}
+
+func (v *FiveGTMSI) UnmarshalPER(r *per.BitReader) (err error) {
+	*v, err = r.DecOctetString(4, 4, false)
+	return
+}
+
+// FiveQI is FiveQI in NGAP-IEs.
+type FiveQI int
+
+func (v FiveQI) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 0, 255, true)
+}
+
+func (v *FiveQI) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(0, 255, true)
+	if err != nil {
+		return
+	}
+	*v = FiveQI(x)
+	return
+}
+
+// ForbiddenAreaInformation is ForbiddenAreaInformation in NGAP-IEs.
+type ForbiddenAreaInformation []ForbiddenAreaInformationItem
+
+func (v ForbiddenAreaInformation) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofEPLMNsPlusOne, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *ForbiddenAreaInformation) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofEPLMNsPlusOne, false)
+	if err != nil {
+		return
+	}
+	*v = make(ForbiddenAreaInformation, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// ForbiddenAreaInformationItem is ForbiddenAreaInformation-Item in NGAP-IEs.
+type ForbiddenAreaInformationItem struct {
+	PLMNIdentity  PLMNIdentity
+	ForbiddenTACs ForbiddenTACs
+	IEExtensions  *ProtocolExtensionContainer
+}
+
+func (v ForbiddenAreaInformationItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PLMNIdentity.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.ForbiddenTACs.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "forbiddenTACs")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *ForbiddenAreaInformationItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = ForbiddenAreaInformationItem{}
+	if err = v.PLMNIdentity.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.ForbiddenTACs.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "forbiddenTACs")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// ForbiddenTACs is ForbiddenTACs in NGAP-IEs.
+type ForbiddenTACs []TAC
+
+func (v ForbiddenTACs) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofForbTACs, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *ForbiddenTACs) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofForbTACs, false)
+	if err != nil {
+		return
+	}
+	*v = make(ForbiddenTACs, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// GBRQosInformation is GBR-QosInformation in NGAP-IEs.
+type GBRQosInformation struct {
+	MaximumFlowBitRateDL    BitRate
+	MaximumFlowBitRateUL    BitRate
+	GuaranteedFlowBitRateDL BitRate
+	GuaranteedFlowBitRateUL BitRate
+	NotificationControl     *NotificationControl
+	MaximumPacketLossRateDL *PacketLossRate
+	MaximumPacketLossRateUL *PacketLossRate
+	IEExtensions            *ProtocolExtensionContainer
+}
+
+func (v GBRQosInformation) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.NotificationControl != nil {
+		optflag |= 1 << 3
+	}
+	if v.MaximumPacketLossRateDL != nil {
+		optflag |= 1 << 2
+	}
+	if v.MaximumPacketLossRateUL != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 4, optflag); err != nil {
+		return
+	}
+	if err = v.MaximumFlowBitRateDL.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "maximumFlowBitRateDL")
+		return
+	}
+	if err = v.MaximumFlowBitRateUL.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "maximumFlowBitRateUL")
+		return
+	}
+	if err = v.GuaranteedFlowBitRateDL.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "guaranteedFlowBitRateDL")
+		return
+	}
+	if err = v.GuaranteedFlowBitRateUL.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "guaranteedFlowBitRateUL")
+		return
+	}
+	if v.NotificationControl != nil {
+		if err = v.NotificationControl.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "notificationControl")
+			return
+		}
+	}
+	if v.MaximumPacketLossRateDL != nil {
+		if err = v.MaximumPacketLossRateDL.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "maximumPacketLossRateDL")
+			return
+		}
+	}
+	if v.MaximumPacketLossRateUL != nil {
+		if err = v.MaximumPacketLossRateUL.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "maximumPacketLossRateUL")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *GBRQosInformation) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 4)
+	if err != nil {
+		return
+	}
+	*v = GBRQosInformation{}
+	if err = v.MaximumFlowBitRateDL.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "maximumFlowBitRateDL")
+		return
+	}
+	if err = v.MaximumFlowBitRateUL.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "maximumFlowBitRateUL")
+		return
+	}
+	if err = v.GuaranteedFlowBitRateDL.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "guaranteedFlowBitRateDL")
+		return
+	}
+	if err = v.GuaranteedFlowBitRateUL.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "guaranteedFlowBitRateUL")
+		return
+	}
+	if optflag&(1<<3) != 0 {
+		v.NotificationControl = new(NotificationControl)
+		if err = v.NotificationControl.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "notificationControl")
+			return
+		}
+	}
+	if optflag&(1<<2) != 0 {
+		v.MaximumPacketLossRateDL = new(PacketLossRate)
+		if err = v.MaximumPacketLossRateDL.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "maximumPacketLossRateDL")
+			return
+		}
+	}
+	if optflag&(1<<1) != 0 {
+		v.MaximumPacketLossRateUL = new(PacketLossRate)
+		if err = v.MaximumPacketLossRateUL.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "maximumPacketLossRateUL")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// GlobalGNBID is GlobalGNB-ID in NGAP-IEs.
+type GlobalGNBID struct {
+	PLMNIdentity PLMNIdentity
+	GNBID        GNBID
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v GlobalGNBID) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PLMNIdentity.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.GNBID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "gNB-ID")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *GlobalGNBID) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = GlobalGNBID{}
+	if err = v.PLMNIdentity.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.GNBID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "gNB-ID")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// GlobalN3IWFID is GlobalN3IWF-ID in NGAP-IEs.
+type GlobalN3IWFID struct {
+	PLMNIdentity PLMNIdentity
+	N3IWFID      N3IWFID
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v GlobalN3IWFID) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PLMNIdentity.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.N3IWFID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "n3IWF-ID")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *GlobalN3IWFID) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = GlobalN3IWFID{}
+	if err = v.PLMNIdentity.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.N3IWFID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "n3IWF-ID")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// GlobalNgENBID is GlobalNgENB-ID in NGAP-IEs.
+type GlobalNgENBID struct {
+	PLMNIdentity PLMNIdentity
+	NgENBID      NgENBID
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v GlobalNgENBID) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PLMNIdentity.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.NgENBID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "ngENB-ID")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *GlobalNgENBID) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = GlobalNgENBID{}
+	if err = v.PLMNIdentity.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.NgENBID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "ngENB-ID")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// GlobalRANNodeID is GlobalRANNodeID in NGAP-IEs.
+type GlobalRANNodeID struct {
+	GlobalGNBID      *GlobalGNBID
+	GlobalNgENBID    *GlobalNgENBID
+	GlobalN3IWFID    *GlobalN3IWFID
+	ChoiceExtensions *ProtocolIESingleContainer
+}
+
+func (v GlobalRANNodeID) MarshalPER(w *per.BitWriter) (err error) {
+	chosen := 0
+	if v.GlobalGNBID != nil {
+		chosen++
+	}
+	if v.GlobalNgENBID != nil {
+		chosen++
+	}
+	if v.GlobalN3IWFID != nil {
+		chosen++
+	}
+	if v.ChoiceExtensions != nil {
+		chosen++
+	}
+	if chosen > 1 {
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "more than one alternative is chosen"}
+		return
+	}
+	switch {
+	case v.GlobalGNBID != nil:
+		if err = w.EncChoice(0, 0, 3, false); err != nil {
+			return
+		}
+		if err = v.GlobalGNBID.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "globalGNB-ID")
+			return
+		}
+	case v.GlobalNgENBID != nil:
+		if err = w.EncChoice(1, 0, 3, false); err != nil {
+			return
+		}
+		if err = v.GlobalNgENBID.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "globalNgENB-ID")
+			return
+		}
+	case v.GlobalN3IWFID != nil:
+		if err = w.EncChoice(2, 0, 3, false); err != nil {
+			return
+		}
+		if err = v.GlobalN3IWFID.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "globalN3IWF-ID")
+			return
+		}
+	case v.ChoiceExtensions != nil:
+		if err = w.EncChoice(3, 0, 3, false); err != nil {
+			return
+		}
+		if err = v.ChoiceExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	default:
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "no alternative is chosen"}
+	}
+	return
+}
+
+func (v *GlobalRANNodeID) UnmarshalPER(r *per.BitReader) (err error) {
+	index, err := r.DecChoice(0, 3, false)
+	if err != nil {
+		return
+	}
+	*v = GlobalRANNodeID{}
+	switch index {
+	case 0:
+		v.GlobalGNBID = new(GlobalGNBID)
+		if err = v.GlobalGNBID.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "globalGNB-ID")
+			return
+		}
+	case 1:
+		v.GlobalNgENBID = new(GlobalNgENBID)
+		if err = v.GlobalNgENBID.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "globalNgENB-ID")
+			return
+		}
+	case 2:
+		v.GlobalN3IWFID = new(GlobalN3IWFID)
+		if err = v.GlobalN3IWFID.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "globalN3IWF-ID")
+			return
+		}
+	case 3:
+		v.ChoiceExtensions = new(ProtocolIESingleContainer)
+		if err = v.ChoiceExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	}
+	return
+}
+
+// GNBID is GNB-ID in NGAP-IEs.
+type GNBID struct {
+	GNBID            *per.BitString
+	ChoiceExtensions *ProtocolIESingleContainer
+}
+
+func (v GNBID) MarshalPER(w *per.BitWriter) (err error) {
+	chosen := 0
+	if v.GNBID != nil {
+		chosen++
+	}
+	if v.ChoiceExtensions != nil {
+		chosen++
+	}
+	if chosen > 1 {
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "more than one alternative is chosen"}
+		return
+	}
+	switch {
+	case v.GNBID != nil:
+		if err = w.EncChoice(0, 0, 1, false); err != nil {
+			return
+		}
+		if err = w.EncBitString(v.GNBID.Bytes, v.GNBID.BitLength, 22, 32, false); err != nil {
+			err = per.WithPath(err, "gNB-ID")
+			return
+		}
+	case v.ChoiceExtensions != nil:
+		if err = w.EncChoice(1, 0, 1, false); err != nil {
+			return
+		}
+		if err = v.ChoiceExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	default:
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "no alternative is chosen"}
+	}
+	return
+}
+
+func (v *GNBID) UnmarshalPER(r *per.BitReader) (err error) {
+	index, err := r.DecChoice(0, 1, false)
+	if err != nil {
+		return
+	}
+	*v = GNBID{}
+	switch index {
+	case 0:
+		v.GNBID = new(per.BitString)
+		if v.GNBID.Bytes, v.GNBID.BitLength, err = r.DecBitString(22, 32, false); err != nil {
+			err = per.WithPath(err, "gNB-ID")
+			return
+		}
+	case 1:
+		v.ChoiceExtensions = new(ProtocolIESingleContainer)
+		if err = v.ChoiceExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	}
+	return
+}
+
+// GTPTEID is GTP-TEID in NGAP-IEs.
+type GTPTEID []byte
+
+func (v GTPTEID) MarshalPER(w *per.BitWriter) error {
+	return w.EncOctetString(v, 4, 4, false)
+}
+
+func (v *GTPTEID) UnmarshalPER(r *per.BitReader) (err error) {
+	*v, err = r.DecOctetString(4, 4, false)
+	return
+}
+
+// GTPTunnel is GTPTunnel in NGAP-IEs.
+type GTPTunnel struct {
+	TransportLayerAddress TransportLayerAddress
+	GTPTEID               GTPTEID
+	IEExtensions          *ProtocolExtensionContainer
+}
+
+func (v GTPTunnel) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.TransportLayerAddress.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "transportLayerAddress")
+		return
+	}
+	if err = v.GTPTEID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "gTP-TEID")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *GTPTunnel) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = GTPTunnel{}
+	if err = v.TransportLayerAddress.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "transportLayerAddress")
+		return
+	}
+	if err = v.GTPTEID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "gTP-TEID")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// GUAMI is GUAMI in NGAP-IEs.
+type GUAMI struct {
+	PLMNIdentity PLMNIdentity
+	AMFRegionID  AMFRegionID
+	AMFSetID     AMFSetID
+	AMFPointer   AMFPointer
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v GUAMI) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PLMNIdentity.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.AMFRegionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "aMFRegionID")
+		return
+	}
+	if err = v.AMFSetID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "aMFSetID")
+		return
+	}
+	if err = v.AMFPointer.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "aMFPointer")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *GUAMI) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = GUAMI{}
+	if err = v.PLMNIdentity.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.AMFRegionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "aMFRegionID")
+		return
+	}
+	if err = v.AMFSetID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "aMFSetID")
+		return
+	}
+	if err = v.AMFPointer.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "aMFPointer")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// IndexToRFSP is IndexToRFSP in NGAP-IEs.
+type IndexToRFSP int
+
+func (v IndexToRFSP) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 1, 256, true)
+}
+
+func (v *IndexToRFSP) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(1, 256, true)
+	if err != nil {
+		return
+	}
+	*v = IndexToRFSP(x)
+	return
+}
+
+// InfoOnRecommendedCellsAndRANNodesForPaging is InfoOnRecommendedCellsAndRANNodesForPaging in NGAP-IEs.
+type InfoOnRecommendedCellsAndRANNodesForPaging struct {
+	RecommendedCellsForPaging  RecommendedCellsForPaging
+	RecommendRANNodesForPaging RecommendedRANNodesForPaging
+	IEExtensions               *ProtocolExtensionContainer
+}
+
+func (v InfoOnRecommendedCellsAndRANNodesForPaging) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.RecommendedCellsForPaging.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "recommendedCellsForPaging")
+		return
+	}
+	if err = v.RecommendRANNodesForPaging.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "recommendRANNodesForPaging")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *InfoOnRecommendedCellsAndRANNodesForPaging) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = InfoOnRecommendedCellsAndRANNodesForPaging{}
+	if err = v.RecommendedCellsForPaging.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "recommendedCellsForPaging")
+		return
+	}
+	if err = v.RecommendRANNodesForPaging.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "recommendRANNodesForPaging")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// IntegrityProtectionIndication is IntegrityProtectionIndication in NGAP-IEs.
+type IntegrityProtectionIndication int
+
+const (
+	IntegrityProtectionIndicationRequired IntegrityProtectionIndication = iota
+	IntegrityProtectionIndicationPreferred
+	IntegrityProtectionIndicationNotNeeded
+)
+
+func (v IntegrityProtectionIndication) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 2, true)
+}
+
+func (v *IntegrityProtectionIndication) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 2, true)
+	*v = IntegrityProtectionIndication(x)
+	return
+}
+
+// IntegrityProtectionResult is IntegrityProtectionResult in NGAP-IEs.
+type IntegrityProtectionResult int
+
+const (
+	IntegrityProtectionResultPerformed IntegrityProtectionResult = iota
+	IntegrityProtectionResultNotPerformed
+)
+
+func (v IntegrityProtectionResult) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 1, true)
+}
+
+func (v *IntegrityProtectionResult) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 1, true)
+	*v = IntegrityProtectionResult(x)
+	return
+}
+
+// IntendedNumberOfPagingAttempts is IntendedNumberOfPagingAttempts in NGAP-IEs.
+type IntendedNumberOfPagingAttempts int
+
+func (v IntendedNumberOfPagingAttempts) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 1, 16, true)
+}
+
+func (v *IntendedNumberOfPagingAttempts) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(1, 16, true)
+	if err != nil {
+		return
+	}
+	*v = IntendedNumberOfPagingAttempts(x)
+	return
+}
+
+// InterfacesToTrace is InterfacesToTrace in NGAP-IEs.
+type InterfacesToTrace per.BitString
+
+func (v InterfacesToTrace) MarshalPER(w *per.BitWriter) error {
+	return w.EncBitString(v.Bytes, v.BitLength, 8, 8, false)
+}
+
+func (v *InterfacesToTrace) UnmarshalPER(r *per.BitReader) (err error) {
+	v.Bytes, v.BitLength, err = r.DecBitString(8, 8, false)
+	return
+}
+
+// MaskedIMEISV is MaskedIMEISV in NGAP-IEs.
+type MaskedIMEISV per.BitString
+
+func (v MaskedIMEISV) MarshalPER(w *per.BitWriter) error {
+	return w.EncBitString(v.Bytes, v.BitLength, 64, 64, false)
+}
+
+func (v *MaskedIMEISV) UnmarshalPER(r *per.BitReader) (err error) {
+	v.Bytes, v.BitLength, err = r.DecBitString(64, 64, false)
+	return
+}
+
+// MaximumDataBurstVolume is MaximumDataBurstVolume in NGAP-IEs.
+type MaximumDataBurstVolume int
+
+func (v MaximumDataBurstVolume) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 0, 4095, true)
+}
+
+func (v *MaximumDataBurstVolume) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(0, 4095, true)
+	if err != nil {
+		return
+	}
+	*v = MaximumDataBurstVolume(x)
+	return
+}
+
+// MaximumIntegrityProtectedDataRate is MaximumIntegrityProtectedDataRate in NGAP-IEs.
+type MaximumIntegrityProtectedDataRate int
+
+const (
+	MaximumIntegrityProtectedDataRateBitrate64kbs MaximumIntegrityProtectedDataRate = iota
+	MaximumIntegrityProtectedDataRateMaximumUERate
+)
+
+func (v MaximumIntegrityProtectedDataRate) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 1, true)
+}
+
+func (v *MaximumIntegrityProtectedDataRate) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 1, true)
+	*v = MaximumIntegrityProtectedDataRate(x)
+	return
+}
+
+// MICOModeIndication is MICOModeIndication in NGAP-IEs.
+type MICOModeIndication int
+
+const (
+	MICOModeIndicationTrue MICOModeIndication = iota
+)
+
+func (v MICOModeIndication) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 0, true)
+}
+
+func (v *MICOModeIndication) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 0, true)
+	*v = MICOModeIndication(x)
+	return
+}
+
+// MobilityRestrictionList is MobilityRestrictionList in NGAP-IEs.
+type MobilityRestrictionList struct {
+	ServingPLMN              PLMNIdentity
+	EquivalentPLMNs          *EquivalentPLMNs
+	RATRestrictions          *RATRestrictions
+	ForbiddenAreaInformation *ForbiddenAreaInformation
+	ServiceAreaInformation   *ServiceAreaInformation
+	IEExtensions             *ProtocolExtensionContainer
+}
+
+func (v MobilityRestrictionList) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.EquivalentPLMNs != nil {
+		optflag |= 1 << 4
+	}
+	if v.RATRestrictions != nil {
+		optflag |= 1 << 3
+	}
+	if v.ForbiddenAreaInformation != nil {
+		optflag |= 1 << 2
+	}
+	if v.ServiceAreaInformation != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 5, optflag); err != nil {
+		return
+	}
+	if err = v.ServingPLMN.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "servingPLMN")
+		return
+	}
+	if v.EquivalentPLMNs != nil {
+		if err = v.EquivalentPLMNs.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "equivalentPLMNs")
+			return
+		}
+	}
+	if v.RATRestrictions != nil {
+		if err = v.RATRestrictions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "rATRestrictions")
+			return
+		}
+	}
+	if v.ForbiddenAreaInformation != nil {
+		if err = v.ForbiddenAreaInformation.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "forbiddenAreaInformation")
+			return
+		}
+	}
+	if v.ServiceAreaInformation != nil {
+		if err = v.ServiceAreaInformation.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "serviceAreaInformation")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *MobilityRestrictionList) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 5)
+	if err != nil {
+		return
+	}
+	*v = MobilityRestrictionList{}
+	if err = v.ServingPLMN.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "servingPLMN")
+		return
+	}
+	if optflag&(1<<4) != 0 {
+		v.EquivalentPLMNs = new(EquivalentPLMNs)
+		if err = v.EquivalentPLMNs.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "equivalentPLMNs")
+			return
+		}
+	}
+	if optflag&(1<<3) != 0 {
+		v.RATRestrictions = new(RATRestrictions)
+		if err = v.RATRestrictions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "rATRestrictions")
+			return
+		}
+	}
+	if optflag&(1<<2) != 0 {
+		v.ForbiddenAreaInformation = new(ForbiddenAreaInformation)
+		if err = v.ForbiddenAreaInformation.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "forbiddenAreaInformation")
+			return
+		}
+	}
+	if optflag&(1<<1) != 0 {
+		v.ServiceAreaInformation = new(ServiceAreaInformation)
+		if err = v.ServiceAreaInformation.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "serviceAreaInformation")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// N3IWFID is N3IWF-ID in NGAP-IEs.
+type N3IWFID struct {
+	N3IWFID          *per.BitString
+	ChoiceExtensions *ProtocolIESingleContainer
+}
+
+func (v N3IWFID) MarshalPER(w *per.BitWriter) (err error) {
+	chosen := 0
+	if v.N3IWFID != nil {
+		chosen++
+	}
+	if v.ChoiceExtensions != nil {
+		chosen++
+	}
+	if chosen > 1 {
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "more than one alternative is chosen"}
+		return
+	}
+	switch {
+	case v.N3IWFID != nil:
+		if err = w.EncChoice(0, 0, 1, false); err != nil {
+			return
+		}
+		if err = w.EncBitString(v.N3IWFID.Bytes, v.N3IWFID.BitLength, 16, 16, false); err != nil {
+			err = per.WithPath(err, "n3IWF-ID")
+			return
+		}
+	case v.ChoiceExtensions != nil:
+		if err = w.EncChoice(1, 0, 1, false); err != nil {
+			return
+		}
+		if err = v.ChoiceExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	default:
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "no alternative is chosen"}
+	}
+	return
+}
+
+func (v *N3IWFID) UnmarshalPER(r *per.BitReader) (err error) {
+	index, err := r.DecChoice(0, 1, false)
+	if err != nil {
+		return
+	}
+	*v = N3IWFID{}
+	switch index {
+	case 0:
+		v.N3IWFID = new(per.BitString)
+		if v.N3IWFID.Bytes, v.N3IWFID.BitLength, err = r.DecBitString(16, 16, false); err != nil {
+			err = per.WithPath(err, "n3IWF-ID")
+			return
+		}
+	case 1:
+		v.ChoiceExtensions = new(ProtocolIESingleContainer)
+		if err = v.ChoiceExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	}
+	return
+}
+
+// NASPDU is NAS-PDU in NGAP-IEs.
+type NASPDU []byte
+
+func (v NASPDU) MarshalPER(w *per.BitWriter) error {
+	return w.EncOctetString(v, 0, per.NoUpperBound, false)
+}
+
+func (v *NASPDU) UnmarshalPER(r *per.BitReader) (err error) {
+	*v, err = r.DecOctetString(0, per.NoUpperBound, false)
+	return
+}
+
+// NetworkInstance is NetworkInstance in NGAP-IEs.
+type NetworkInstance int
+
+func (v NetworkInstance) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 1, 256, true)
+}
+
+func (v *NetworkInstance) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(1, 256, true)
+	if err != nil {
+		return
+	}
+	*v = NetworkInstance(x)
+	return
+}
+
+// NextPagingAreaScope is NextPagingAreaScope in NGAP-IEs.
+type NextPagingAreaScope int
+
+const (
+	NextPagingAreaScopeSame NextPagingAreaScope = iota
+	NextPagingAreaScopeChanged
+)
+
+func (v NextPagingAreaScope) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 1, true)
+}
+
+func (v *NextPagingAreaScope) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 1, true)
+	*v = NextPagingAreaScope(x)
+	return
+}
+
+// NgENBID is NgENB-ID in NGAP-IEs.
+type NgENBID struct {
+	MacroNgENBID      *per.BitString
+	ShortMacroNgENBID *per.BitString
+	LongMacroNgENBID  *per.BitString
+	ChoiceExtensions  *ProtocolIESingleContainer
+}
+
+func (v NgENBID) MarshalPER(w *per.BitWriter) (err error) {
+	chosen := 0
+	if v.MacroNgENBID != nil {
+		chosen++
+	}
+	if v.ShortMacroNgENBID != nil {
+		chosen++
+	}
+	if v.LongMacroNgENBID != nil {
+		chosen++
+	}
+	if v.ChoiceExtensions != nil {
+		chosen++
+	}
+	if chosen > 1 {
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "more than one alternative is chosen"}
+		return
+	}
+	switch {
+	case v.MacroNgENBID != nil:
+		if err = w.EncChoice(0, 0, 3, false); err != nil {
+			return
+		}
+		if err = w.EncBitString(v.MacroNgENBID.Bytes, v.MacroNgENBID.BitLength, 20, 20, false); err != nil {
+			err = per.WithPath(err, "macroNgENB-ID")
+			return
+		}
+	case v.ShortMacroNgENBID != nil:
+		if err = w.EncChoice(1, 0, 3, false); err != nil {
+			return
+		}
+		if err = w.EncBitString(v.ShortMacroNgENBID.Bytes, v.ShortMacroNgENBID.BitLength, 18, 18, false); err != nil {
+			err = per.WithPath(err, "shortMacroNgENB-ID")
+			return
+		}
+	case v.LongMacroNgENBID != nil:
+		if err = w.EncChoice(2, 0, 3, false); err != nil {
+			return
+		}
+		if err = w.EncBitString(v.LongMacroNgENBID.Bytes, v.LongMacroNgENBID.BitLength, 21, 21, false); err != nil {
+			err = per.WithPath(err, "longMacroNgENB-ID")
+			return
+		}
+	case v.ChoiceExtensions != nil:
+		if err = w.EncChoice(3, 0, 3, false); err != nil {
+			return
+		}
+		if err = v.ChoiceExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	default:
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "no alternative is chosen"}
+	}
+	return
+}
+
+func (v *NgENBID) UnmarshalPER(r *per.BitReader) (err error) {
+	index, err := r.DecChoice(0, 3, false)
+	if err != nil {
+		return
+	}
+	*v = NgENBID{}
+	switch index {
+	case 0:
+		v.MacroNgENBID = new(per.BitString)
+		if v.MacroNgENBID.Bytes, v.MacroNgENBID.BitLength, err = r.DecBitString(20, 20, false); err != nil {
+			err = per.WithPath(err, "macroNgENB-ID")
+			return
+		}
+	case 1:
+		v.ShortMacroNgENBID = new(per.BitString)
+		if v.ShortMacroNgENBID.Bytes, v.ShortMacroNgENBID.BitLength, err = r.DecBitString(18, 18, false); err != nil {
+			err = per.WithPath(err, "shortMacroNgENB-ID")
+			return
+		}
+	case 2:
+		v.LongMacroNgENBID = new(per.BitString)
+		if v.LongMacroNgENBID.Bytes, v.LongMacroNgENBID.BitLength, err = r.DecBitString(21, 21, false); err != nil {
+			err = per.WithPath(err, "longMacroNgENB-ID")
+			return
+		}
+	case 3:
+		v.ChoiceExtensions = new(ProtocolIESingleContainer)
+		if err = v.ChoiceExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	}
+	return
+}
+
+// NGRANCGI is NGRAN-CGI in NGAP-IEs.
+type NGRANCGI struct {
+	NRCGI            *NRCGI
+	EUTRACGI         *EUTRACGI
+	ChoiceExtensions *ProtocolIESingleContainer
+}
+
+func (v NGRANCGI) MarshalPER(w *per.BitWriter) (err error) {
+	chosen := 0
+	if v.NRCGI != nil {
+		chosen++
+	}
+	if v.EUTRACGI != nil {
+		chosen++
+	}
+	if v.ChoiceExtensions != nil {
+		chosen++
+	}
+	if chosen > 1 {
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "more than one alternative is chosen"}
+		return
+	}
+	switch {
+	case v.NRCGI != nil:
+		if err = w.EncChoice(0, 0, 2, false); err != nil {
+			return
+		}
+		if err = v.NRCGI.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "nR-CGI")
+			return
+		}
+	case v.EUTRACGI != nil:
+		if err = w.EncChoice(1, 0, 2, false); err != nil {
+			return
+		}
+		if err = v.EUTRACGI.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "eUTRA-CGI")
+			return
+		}
+	case v.ChoiceExtensions != nil:
+		if err = w.EncChoice(2, 0, 2, false); err != nil {
+			return
+		}
+		if err = v.ChoiceExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	default:
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "no alternative is chosen"}
+	}
+	return
+}
+
+func (v *NGRANCGI) UnmarshalPER(r *per.BitReader) (err error) {
+	index, err := r.DecChoice(0, 2, false)
+	if err != nil {
+		return
+	}
+	*v = NGRANCGI{}
+	switch index {
+	case 0:
+		v.NRCGI = new(NRCGI)
+		if err = v.NRCGI.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "nR-CGI")
+			return
+		}
+	case 1:
+		v.EUTRACGI = new(EUTRACGI)
+		if err = v.EUTRACGI.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "eUTRA-CGI")
+			return
+		}
+	case 2:
+		v.ChoiceExtensions = new(ProtocolIESingleContainer)
+		if err = v.ChoiceExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	}
+	return
+}
+
+// NGRANTraceID is NGRANTraceID in NGAP-IEs.
+type NGRANTraceID []byte
+
+func (v NGRANTraceID) MarshalPER(w *per.BitWriter) error {
+	return w.EncOctetString(v, 8, 8, false)
+}
+
+func (v *NGRANTraceID) UnmarshalPER(r *per.BitReader) (err error) {
+	*v, err = r.DecOctetString(8, 8, false)
+	return
+}
+
+// NonDynamic5QIDescriptor is NonDynamic5QIDescriptor in NGAP-IEs.
+type NonDynamic5QIDescriptor struct {
+	FiveQI                 FiveQI
+	PriorityLevelQos       *PriorityLevelQos
+	AveragingWindow        *AveragingWindow
+	MaximumDataBurstVolume *MaximumDataBurstVolume
+	IEExtensions           *ProtocolExtensionContainer
+}
+
+func (v NonDynamic5QIDescriptor) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.PriorityLevelQos != nil {
+		optflag |= 1 << 3
+	}
+	if v.AveragingWindow != nil {
+		optflag |= 1 << 2
+	}
+	if v.MaximumDataBurstVolume != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 4, optflag); err != nil {
+		return
+	}
+	if err = v.FiveQI.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "fiveQI")
+		return
+	}
+	if v.PriorityLevelQos != nil {
+		if err = v.PriorityLevelQos.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "priorityLevelQos")
+			return
+		}
+	}
+	if v.AveragingWindow != nil {
+		if err = v.AveragingWindow.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "averagingWindow")
+			return
+		}
+	}
+	if v.MaximumDataBurstVolume != nil {
+		if err = v.MaximumDataBurstVolume.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "maximumDataBurstVolume")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *NonDynamic5QIDescriptor) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 4)
+	if err != nil {
+		return
+	}
+	*v = NonDynamic5QIDescriptor{}
+	if err = v.FiveQI.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "fiveQI")
+		return
+	}
+	if optflag&(1<<3) != 0 {
+		v.PriorityLevelQos = new(PriorityLevelQos)
+		if err = v.PriorityLevelQos.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "priorityLevelQos")
+			return
+		}
+	}
+	if optflag&(1<<2) != 0 {
+		v.AveragingWindow = new(AveragingWindow)
+		if err = v.AveragingWindow.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "averagingWindow")
+			return
+		}
+	}
+	if optflag&(1<<1) != 0 {
+		v.MaximumDataBurstVolume = new(MaximumDataBurstVolume)
+		if err = v.MaximumDataBurstVolume.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "maximumDataBurstVolume")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// NotAllowedTACs is NotAllowedTACs in NGAP-IEs.
+type NotAllowedTACs []TAC
+
+func (v NotAllowedTACs) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofAllowedAreas, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *NotAllowedTACs) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofAllowedAreas, false)
+	if err != nil {
+		return
+	}
+	*v = make(NotAllowedTACs, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// NotificationCause is NotificationCause in NGAP-IEs.
+type NotificationCause int
+
+const (
+	NotificationCauseFulfilled NotificationCause = iota
+	NotificationCauseNotFulfilled
+)
+
+func (v NotificationCause) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 1, true)
+}
+
+func (v *NotificationCause) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 1, true)
+	*v = NotificationCause(x)
+	return
+}
+
+// NotificationControl is NotificationControl in NGAP-IEs.
+type NotificationControl int
+
+const (
+	NotificationControlNotificationRequested NotificationControl = iota
+)
+
+func (v NotificationControl) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 0, true)
+}
+
+func (v *NotificationControl) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 0, true)
+	*v = NotificationControl(x)
+	return
+}
+
+// NRCellIdentity is NRCellIdentity in NGAP-IEs.
+type NRCellIdentity per.BitString
+
+func (v NRCellIdentity) MarshalPER(w *per.BitWriter) error {
+	return w.EncBitString(v.Bytes, v.BitLength, 36, 36, false)
+}
+
+func (v *NRCellIdentity) UnmarshalPER(r *per.BitReader) (err error) {
+	v.Bytes, v.BitLength, err = r.DecBitString(36, 36, false)
+	return
+}
+
+// NRCGI is NR-CGI in NGAP-IEs.
+type NRCGI struct {
+	PLMNIdentity   PLMNIdentity
+	NRCellIdentity NRCellIdentity
+	IEExtensions   *ProtocolExtensionContainer
+}
+
+func (v NRCGI) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PLMNIdentity.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.NRCellIdentity.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "nRCellIdentity")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *NRCGI) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = NRCGI{}
+	if err = v.PLMNIdentity.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.NRCellIdentity.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "nRCellIdentity")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// NRencryptionAlgorithms is NRencryptionAlgorithms in NGAP-IEs.
+type NRencryptionAlgorithms per.BitString
+
+func (v NRencryptionAlgorithms) MarshalPER(w *per.BitWriter) error {
+	return w.EncBitString(v.Bytes, v.BitLength, 16, 16, true)
+}
+
+func (v *NRencryptionAlgorithms) UnmarshalPER(r *per.BitReader) (err error) {
+	v.Bytes, v.BitLength, err = r.DecBitString(16, 16, true)
+	return
+}
+
+// NRintegrityProtectionAlgorithms is NRintegrityProtectionAlgorithms in NGAP-IEs.
+type NRintegrityProtectionAlgorithms per.BitString
+
+func (v NRintegrityProtectionAlgorithms) MarshalPER(w *per.BitWriter) error {
+	return w.EncBitString(v.Bytes, v.BitLength, 16, 16, true)
+}
+
+func (v *NRintegrityProtectionAlgorithms) UnmarshalPER(r *per.BitReader) (err error) {
+	v.Bytes, v.BitLength, err = r.DecBitString(16, 16, true)
+	return
+}
+
+// PacketDelayBudget is PacketDelayBudget in NGAP-IEs.
+type PacketDelayBudget int
+
+func (v PacketDelayBudget) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 0, 1023, true)
+}
+
+func (v *PacketDelayBudget) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(0, 1023, true)
+	if err != nil {
+		return
+	}
+	*v = PacketDelayBudget(x)
+	return
+}
+
+// PacketErrorRate is PacketErrorRate in NGAP-IEs.
+type PacketErrorRate struct {
+	PERScalar    int
+	PERExponent  int
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v PacketErrorRate) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = w.EncInteger(v.PERScalar, 0, 9, true); err != nil {
+		err = per.WithPath(err, "pERScalar")
+		return
+	}
+	if err = w.EncInteger(v.PERExponent, 0, 9, true); err != nil {
+		err = per.WithPath(err, "pERExponent")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PacketErrorRate) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PacketErrorRate{}
+	if v.PERScalar, err = r.DecInteger(0, 9, true); err != nil {
+		err = per.WithPath(err, "pERScalar")
+		return
+	}
+	if v.PERExponent, err = r.DecInteger(0, 9, true); err != nil {
+		err = per.WithPath(err, "pERExponent")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PacketLossRate is PacketLossRate in NGAP-IEs.
+type PacketLossRate int
+
+func (v PacketLossRate) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 0, 1000, true)
+}
+
+func (v *PacketLossRate) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(0, 1000, true)
+	if err != nil {
+		return
+	}
+	*v = PacketLossRate(x)
+	return
+}
+
+// PagingAttemptCount is PagingAttemptCount in NGAP-IEs.
+type PagingAttemptCount int
+
+func (v PagingAttemptCount) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 1, 16, true)
+}
+
+func (v *PagingAttemptCount) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(1, 16, true)
+	if err != nil {
+		return
+	}
+	*v = PagingAttemptCount(x)
+	return
+}
+
+// PagingAttemptInformation is PagingAttemptInformation in NGAP-IEs.
+type PagingAttemptInformation struct {
+	PagingAttemptCount             PagingAttemptCount
+	IntendedNumberOfPagingAttempts IntendedNumberOfPagingAttempts
+	NextPagingAreaScope            *NextPagingAreaScope
+	IEExtensions                   *ProtocolExtensionContainer
+}
+
+func (v PagingAttemptInformation) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.NextPagingAreaScope != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 2, optflag); err != nil {
+		return
+	}
+	if err = v.PagingAttemptCount.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pagingAttemptCount")
+		return
+	}
+	if err = v.IntendedNumberOfPagingAttempts.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "intendedNumberOfPagingAttempts")
+		return
+	}
+	if v.NextPagingAreaScope != nil {
+		if err = v.NextPagingAreaScope.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "nextPagingAreaScope")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PagingAttemptInformation) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 2)
+	if err != nil {
+		return
+	}
+	*v = PagingAttemptInformation{}
+	if err = v.PagingAttemptCount.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pagingAttemptCount")
+		return
+	}
+	if err = v.IntendedNumberOfPagingAttempts.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "intendedNumberOfPagingAttempts")
+		return
+	}
+	if optflag&(1<<1) != 0 {
+		v.NextPagingAreaScope = new(NextPagingAreaScope)
+		if err = v.NextPagingAreaScope.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "nextPagingAreaScope")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PagingDRX is PagingDRX in NGAP-IEs.
+type PagingDRX int
+
+const (
+	PagingDRXV32 PagingDRX = iota
+	PagingDRXV64
+	PagingDRXV128
+	PagingDRXV256
+)
+
+func (v PagingDRX) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 3, true)
+}
+
+func (v *PagingDRX) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 3, true)
+	*v = PagingDRX(x)
+	return
+}
+
+// PagingOrigin is PagingOrigin in NGAP-IEs.
+type PagingOrigin int
+
+const (
+	PagingOriginNon3gpp PagingOrigin = iota
+)
+
+func (v PagingOrigin) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 0, true)
+}
+
+func (v *PagingOrigin) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 0, true)
+	*v = PagingOrigin(x)
+	return
+}
+
+// PagingPriority is PagingPriority in NGAP-IEs.
+type PagingPriority int
+
+const (
+	PagingPriorityPriolevel1 PagingPriority = iota
+	PagingPriorityPriolevel2
+	PagingPriorityPriolevel3
+	PagingPriorityPriolevel4
+	PagingPriorityPriolevel5
+	PagingPriorityPriolevel6
+	PagingPriorityPriolevel7
+	PagingPriorityPriolevel8
+)
+
+func (v PagingPriority) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 7, true)
+}
+
+func (v *PagingPriority) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 7, true)
+	*v = PagingPriority(x)
+	return
+}
+
+// PDUSessionAggregateMaximumBitRate is PDUSessionAggregateMaximumBitRate in NGAP-IEs.
+type PDUSessionAggregateMaximumBitRate struct {
+	PDUSessionAggregateMaximumBitRateDL BitRate
+	PDUSessionAggregateMaximumBitRateUL BitRate
+	IEExtensions                        *ProtocolExtensionContainer
+}
+
+func (v PDUSessionAggregateMaximumBitRate) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionAggregateMaximumBitRateDL.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionAggregateMaximumBitRateDL")
+		return
+	}
+	if err = v.PDUSessionAggregateMaximumBitRateUL.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionAggregateMaximumBitRateUL")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionAggregateMaximumBitRate) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionAggregateMaximumBitRate{}
+	if err = v.PDUSessionAggregateMaximumBitRateDL.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionAggregateMaximumBitRateDL")
+		return
+	}
+	if err = v.PDUSessionAggregateMaximumBitRateUL.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionAggregateMaximumBitRateUL")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionID is PDUSessionID in NGAP-IEs.
+type PDUSessionID int
+
+func (v PDUSessionID) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 0, 255, false)
+}
+
+func (v *PDUSessionID) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(0, 255, false)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionID(x)
+	return
+}
+
+// PDUSessionResourceFailedToModifyItemModCfm is PDUSessionResourceFailedToModifyItemModCfm in NGAP-IEs.
+type PDUSessionResourceFailedToModifyItemModCfm struct {
+	PDUSessionID                                           PDUSessionID
+	PDUSessionResourceModifyIndicationUnsuccessfulTransfer []byte
+	IEExtensions                                           *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceFailedToModifyItemModCfm) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if err = w.EncOctetString(v.PDUSessionResourceModifyIndicationUnsuccessfulTransfer, 0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceModifyIndicationUnsuccessfulTransfer")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceFailedToModifyItemModCfm) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceFailedToModifyItemModCfm{}
+	if err = v.PDUSessionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if v.PDUSessionResourceModifyIndicationUnsuccessfulTransfer, err = r.DecOctetString(0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceModifyIndicationUnsuccessfulTransfer")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceFailedToModifyItemModRes is PDUSessionResourceFailedToModifyItemModRes in NGAP-IEs.
+type PDUSessionResourceFailedToModifyItemModRes struct {
+	PDUSessionID                                 PDUSessionID
+	PDUSessionResourceModifyUnsuccessfulTransfer []byte
+	IEExtensions                                 *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceFailedToModifyItemModRes) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if err = w.EncOctetString(v.PDUSessionResourceModifyUnsuccessfulTransfer, 0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceModifyUnsuccessfulTransfer")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceFailedToModifyItemModRes) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceFailedToModifyItemModRes{}
+	if err = v.PDUSessionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if v.PDUSessionResourceModifyUnsuccessfulTransfer, err = r.DecOctetString(0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceModifyUnsuccessfulTransfer")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceFailedToModifyListModCfm is PDUSessionResourceFailedToModifyListModCfm in NGAP-IEs.
+type PDUSessionResourceFailedToModifyListModCfm []PDUSessionResourceFailedToModifyItemModCfm
+
+func (v PDUSessionResourceFailedToModifyListModCfm) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPDUSessions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceFailedToModifyListModCfm) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPDUSessions, false)
+	if err != nil {
+		return
+	}
+	*v = make(PDUSessionResourceFailedToModifyListModCfm, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionResourceFailedToModifyListModRes is PDUSessionResourceFailedToModifyListModRes in NGAP-IEs.
+type PDUSessionResourceFailedToModifyListModRes []PDUSessionResourceFailedToModifyItemModRes
+
+func (v PDUSessionResourceFailedToModifyListModRes) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPDUSessions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceFailedToModifyListModRes) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPDUSessions, false)
+	if err != nil {
+		return
+	}
+	*v = make(PDUSessionResourceFailedToModifyListModRes, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionResourceFailedToSetupItemCxtFail is PDUSessionResourceFailedToSetupItemCxtFail in NGAP-IEs.
+type PDUSessionResourceFailedToSetupItemCxtFail struct {
+	PDUSessionID                                PDUSessionID
+	PDUSessionResourceSetupUnsuccessfulTransfer []byte
+	IEExtensions                                *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceFailedToSetupItemCxtFail) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if err = w.EncOctetString(v.PDUSessionResourceSetupUnsuccessfulTransfer, 0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceSetupUnsuccessfulTransfer")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceFailedToSetupItemCxtFail) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceFailedToSetupItemCxtFail{}
+	if err = v.PDUSessionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if v.PDUSessionResourceSetupUnsuccessfulTransfer, err = r.DecOctetString(0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceSetupUnsuccessfulTransfer")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceFailedToSetupItemCxtRes is PDUSessionResourceFailedToSetupItemCxtRes in NGAP-IEs.
+type PDUSessionResourceFailedToSetupItemCxtRes struct {
+	PDUSessionID                                PDUSessionID
+	PDUSessionResourceSetupUnsuccessfulTransfer []byte
+	IEExtensions                                *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceFailedToSetupItemCxtRes) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if err = w.EncOctetString(v.PDUSessionResourceSetupUnsuccessfulTransfer, 0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceSetupUnsuccessfulTransfer")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceFailedToSetupItemCxtRes) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceFailedToSetupItemCxtRes{}
+	if err = v.PDUSessionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if v.PDUSessionResourceSetupUnsuccessfulTransfer, err = r.DecOctetString(0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceSetupUnsuccessfulTransfer")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceFailedToSetupItemSURes is PDUSessionResourceFailedToSetupItemSURes in NGAP-IEs.
+type PDUSessionResourceFailedToSetupItemSURes struct {
+	PDUSessionID                                PDUSessionID
+	PDUSessionResourceSetupUnsuccessfulTransfer []byte
+	IEExtensions                                *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceFailedToSetupItemSURes) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if err = w.EncOctetString(v.PDUSessionResourceSetupUnsuccessfulTransfer, 0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceSetupUnsuccessfulTransfer")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceFailedToSetupItemSURes) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceFailedToSetupItemSURes{}
+	if err = v.PDUSessionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if v.PDUSessionResourceSetupUnsuccessfulTransfer, err = r.DecOctetString(0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceSetupUnsuccessfulTransfer")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceFailedToSetupListCxtFail is PDUSessionResourceFailedToSetupListCxtFail in NGAP-IEs.
+type PDUSessionResourceFailedToSetupListCxtFail []PDUSessionResourceFailedToSetupItemCxtFail
+
+func (v PDUSessionResourceFailedToSetupListCxtFail) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPDUSessions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceFailedToSetupListCxtFail) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPDUSessions, false)
+	if err != nil {
+		return
+	}
+	*v = make(PDUSessionResourceFailedToSetupListCxtFail, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionResourceFailedToSetupListCxtRes is PDUSessionResourceFailedToSetupListCxtRes in NGAP-IEs.
+type PDUSessionResourceFailedToSetupListCxtRes []PDUSessionResourceFailedToSetupItemCxtRes
+
+func (v PDUSessionResourceFailedToSetupListCxtRes) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPDUSessions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceFailedToSetupListCxtRes) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPDUSessions, false)
+	if err != nil {
+		return
+	}
+	*v = make(PDUSessionResourceFailedToSetupListCxtRes, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionResourceFailedToSetupListSURes is PDUSessionResourceFailedToSetupListSURes in NGAP-IEs.
+type PDUSessionResourceFailedToSetupListSURes []PDUSessionResourceFailedToSetupItemSURes
+
+func (v PDUSessionResourceFailedToSetupListSURes) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPDUSessions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceFailedToSetupListSURes) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPDUSessions, false)
+	if err != nil {
+		return
+	}
+	*v = make(PDUSessionResourceFailedToSetupListSURes, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionResourceItemCxtRelCpl is PDUSessionResourceItemCxtRelCpl in NGAP-IEs.
+type PDUSessionResourceItemCxtRelCpl struct {
+	PDUSessionID PDUSessionID
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceItemCxtRelCpl) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceItemCxtRelCpl) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceItemCxtRelCpl{}
+	if err = v.PDUSessionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceItemCxtRelReq is PDUSessionResourceItemCxtRelReq in NGAP-IEs.
+type PDUSessionResourceItemCxtRelReq struct {
+	PDUSessionID PDUSessionID
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceItemCxtRelReq) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceItemCxtRelReq) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceItemCxtRelReq{}
+	if err = v.PDUSessionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceListCxtRelCpl is PDUSessionResourceListCxtRelCpl in NGAP-IEs.
+type PDUSessionResourceListCxtRelCpl []PDUSessionResourceItemCxtRelCpl
+
+func (v PDUSessionResourceListCxtRelCpl) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPDUSessions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceListCxtRelCpl) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPDUSessions, false)
+	if err != nil {
+		return
+	}
+	*v = make(PDUSessionResourceListCxtRelCpl, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionResourceListCxtRelReq is PDUSessionResourceListCxtRelReq in NGAP-IEs.
+type PDUSessionResourceListCxtRelReq []PDUSessionResourceItemCxtRelReq
+
+func (v PDUSessionResourceListCxtRelReq) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPDUSessions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceListCxtRelReq) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPDUSessions, false)
+	if err != nil {
+		return
+	}
+	*v = make(PDUSessionResourceListCxtRelReq, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionResourceModifyConfirmTransfer is PDUSessionResourceModifyConfirmTransfer in NGAP-IEs.
+type PDUSessionResourceModifyConfirmTransfer struct {
+	QosFlowModifyConfirmList      QosFlowModifyConfirmList
+	ULNGUUPTNLInformation         UPTransportLayerInformation
+	AdditionalNGUUPTNLInformation *UPTransportLayerInformationPairList
+	QosFlowFailedToModifyList     *QosFlowList
+	IEExtensions                  *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceModifyConfirmTransfer) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.AdditionalNGUUPTNLInformation != nil {
+		optflag |= 1 << 2
+	}
+	if v.QosFlowFailedToModifyList != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 3, optflag); err != nil {
+		return
+	}
+	if err = v.QosFlowModifyConfirmList.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "qosFlowModifyConfirmList")
+		return
+	}
+	if err = v.ULNGUUPTNLInformation.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "uLNGU-UP-TNLInformation")
+		return
+	}
+	if v.AdditionalNGUUPTNLInformation != nil {
+		if err = v.AdditionalNGUUPTNLInformation.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "additionalNG-UUPTNLInformation")
+			return
+		}
+	}
+	if v.QosFlowFailedToModifyList != nil {
+		if err = v.QosFlowFailedToModifyList.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "qosFlowFailedToModifyList")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceModifyConfirmTransfer) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 3)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceModifyConfirmTransfer{}
+	if err = v.QosFlowModifyConfirmList.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "qosFlowModifyConfirmList")
+		return
+	}
+	if err = v.ULNGUUPTNLInformation.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "uLNGU-UP-TNLInformation")
+		return
+	}
+	if optflag&(1<<2) != 0 {
+		v.AdditionalNGUUPTNLInformation = new(UPTransportLayerInformationPairList)
+		if err = v.AdditionalNGUUPTNLInformation.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "additionalNG-UUPTNLInformation")
+			return
+		}
+	}
+	if optflag&(1<<1) != 0 {
+		v.QosFlowFailedToModifyList = new(QosFlowList)
+		if err = v.QosFlowFailedToModifyList.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "qosFlowFailedToModifyList")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceModifyIndicationTransfer is PDUSessionResourceModifyIndicationTransfer in NGAP-IEs.
+type PDUSessionResourceModifyIndicationTransfer struct {
+	DLQosFlowPerTNLInformation           QosFlowPerTNLInformation
+	AdditionalDLQosFlowPerTNLInformation *QosFlowPerTNLInformationList
+	IEExtensions                         *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceModifyIndicationTransfer) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.AdditionalDLQosFlowPerTNLInformation != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 2, optflag); err != nil {
+		return
+	}
+	if err = v.DLQosFlowPerTNLInformation.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "dLQosFlowPerTNLInformation")
+		return
+	}
+	if v.AdditionalDLQosFlowPerTNLInformation != nil {
+		if err = v.AdditionalDLQosFlowPerTNLInformation.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "additionalDLQosFlowPerTNLInformation")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceModifyIndicationTransfer) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 2)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceModifyIndicationTransfer{}
+	if err = v.DLQosFlowPerTNLInformation.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "dLQosFlowPerTNLInformation")
+		return
+	}
+	if optflag&(1<<1) != 0 {
+		v.AdditionalDLQosFlowPerTNLInformation = new(QosFlowPerTNLInformationList)
+		if err = v.AdditionalDLQosFlowPerTNLInformation.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "additionalDLQosFlowPerTNLInformation")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceModifyIndicationUnsuccessfulTransfer is PDUSessionResourceModifyIndicationUnsuccessfulTransfer in NGAP-IEs.
+type PDUSessionResourceModifyIndicationUnsuccessfulTransfer struct {
+	Cause        Cause
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceModifyIndicationUnsuccessfulTransfer) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.Cause.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "cause")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceModifyIndicationUnsuccessfulTransfer) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceModifyIndicationUnsuccessfulTransfer{}
+	if err = v.Cause.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "cause")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceModifyItemModCfm is PDUSessionResourceModifyItemModCfm in NGAP-IEs.
+type PDUSessionResourceModifyItemModCfm struct {
+	PDUSessionID                            PDUSessionID
+	PDUSessionResourceModifyConfirmTransfer []byte
+	IEExtensions                            *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceModifyItemModCfm) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if err = w.EncOctetString(v.PDUSessionResourceModifyConfirmTransfer, 0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceModifyConfirmTransfer")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceModifyItemModCfm) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceModifyItemModCfm{}
+	if err = v.PDUSessionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if v.PDUSessionResourceModifyConfirmTransfer, err = r.DecOctetString(0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceModifyConfirmTransfer")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceModifyItemModInd is PDUSessionResourceModifyItemModInd in NGAP-IEs.
+type PDUSessionResourceModifyItemModInd struct {
+	PDUSessionID                               PDUSessionID
+	PDUSessionResourceModifyIndicationTransfer []byte
+	IEExtensions                               *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceModifyItemModInd) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if err = w.EncOctetString(v.PDUSessionResourceModifyIndicationTransfer, 0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceModifyIndicationTransfer")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceModifyItemModInd) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceModifyItemModInd{}
+	if err = v.PDUSessionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if v.PDUSessionResourceModifyIndicationTransfer, err = r.DecOctetString(0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceModifyIndicationTransfer")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceModifyItemModReq is PDUSessionResourceModifyItemModReq in NGAP-IEs.
+type PDUSessionResourceModifyItemModReq struct {
+	PDUSessionID                            PDUSessionID
+	NASPDU                                  *NASPDU
+	PDUSessionResourceModifyRequestTransfer []byte
+	IEExtensions                            *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceModifyItemModReq) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.NASPDU != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 2, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if v.NASPDU != nil {
+		if err = v.NASPDU.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "nAS-PDU")
+			return
+		}
+	}
+	if err = w.EncOctetString(v.PDUSessionResourceModifyRequestTransfer, 0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceModifyRequestTransfer")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceModifyItemModReq) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 2)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceModifyItemModReq{}
+	if err = v.PDUSessionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if optflag&(1<<1) != 0 {
+		v.NASPDU = new(NASPDU)
+		if err = v.NASPDU.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "nAS-PDU")
+			return
+		}
+	}
+	if v.PDUSessionResourceModifyRequestTransfer, err = r.DecOctetString(0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceModifyRequestTransfer")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceModifyItemModRes is PDUSessionResourceModifyItemModRes in NGAP-IEs.
+type PDUSessionResourceModifyItemModRes struct {
+	PDUSessionID                             PDUSessionID
+	PDUSessionResourceModifyResponseTransfer []byte
+	IEExtensions                             *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceModifyItemModRes) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if err = w.EncOctetString(v.PDUSessionResourceModifyResponseTransfer, 0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceModifyResponseTransfer")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceModifyItemModRes) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceModifyItemModRes{}
+	if err = v.PDUSessionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if v.PDUSessionResourceModifyResponseTransfer, err = r.DecOctetString(0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceModifyResponseTransfer")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceModifyListModCfm is PDUSessionResourceModifyListModCfm in NGAP-IEs.
+type PDUSessionResourceModifyListModCfm []PDUSessionResourceModifyItemModCfm
+
+func (v PDUSessionResourceModifyListModCfm) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPDUSessions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceModifyListModCfm) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPDUSessions, false)
+	if err != nil {
+		return
+	}
+	*v = make(PDUSessionResourceModifyListModCfm, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionResourceModifyListModInd is PDUSessionResourceModifyListModInd in NGAP-IEs.
+type PDUSessionResourceModifyListModInd []PDUSessionResourceModifyItemModInd
+
+func (v PDUSessionResourceModifyListModInd) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPDUSessions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceModifyListModInd) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPDUSessions, false)
+	if err != nil {
+		return
+	}
+	*v = make(PDUSessionResourceModifyListModInd, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionResourceModifyListModReq is PDUSessionResourceModifyListModReq in NGAP-IEs.
+type PDUSessionResourceModifyListModReq []PDUSessionResourceModifyItemModReq
+
+func (v PDUSessionResourceModifyListModReq) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPDUSessions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceModifyListModReq) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPDUSessions, false)
+	if err != nil {
+		return
+	}
+	*v = make(PDUSessionResourceModifyListModReq, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionResourceModifyListModRes is PDUSessionResourceModifyListModRes in NGAP-IEs.
+type PDUSessionResourceModifyListModRes []PDUSessionResourceModifyItemModRes
+
+func (v PDUSessionResourceModifyListModRes) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPDUSessions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceModifyListModRes) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPDUSessions, false)
+	if err != nil {
+		return
+	}
+	*v = make(PDUSessionResourceModifyListModRes, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionResourceModifyRequestTransfer is PDUSessionResourceModifyRequestTransfer in NGAP-IEs.
+type PDUSessionResourceModifyRequestTransfer struct {
+	PDUSessionAggregateMaximumBitRate *PDUSessionAggregateMaximumBitRate
+	ULNGUUPTNLModifyList              *ULNGUUPTNLModifyList
+	NetworkInstance                   *NetworkInstance
+	QosFlowAddOrModifyRequestList     *QosFlowAddOrModifyRequestList
+	QosFlowToReleaseList              *QosFlowList
+	AdditionalULNGUUPTNLInformation   *UPTransportLayerInformationList
+}
+
+func (v PDUSessionResourceModifyRequestTransfer) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 0
+	if v.PDUSessionAggregateMaximumBitRate != nil {
+		n++
+	}
+	if v.ULNGUUPTNLModifyList != nil {
+		n++
+	}
+	if v.NetworkInstance != nil {
+		n++
+	}
+	if v.QosFlowAddOrModifyRequestList != nil {
+		n++
+	}
+	if v.QosFlowToReleaseList != nil {
+		n++
+	}
+	if v.AdditionalULNGUUPTNLInformation != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if v.PDUSessionAggregateMaximumBitRate != nil {
+		if err = ProtocolIEID(idPDUSessionAggregateMaximumBitRate).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.PDUSessionAggregateMaximumBitRate.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionAggregateMaximumBitRate", i))
+			return
+		}
+		i++
+	}
+	if v.ULNGUUPTNLModifyList != nil {
+		if err = ProtocolIEID(idULNGUUPTNLModifyList).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.ULNGUUPTNLModifyList.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UL-NGU-UP-TNLModifyList", i))
+			return
+		}
+		i++
+	}
+	if v.NetworkInstance != nil {
+		if err = ProtocolIEID(idNetworkInstance).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.NetworkInstance.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.NetworkInstance", i))
+			return
+		}
+		i++
+	}
+	if v.QosFlowAddOrModifyRequestList != nil {
+		if err = ProtocolIEID(idQosFlowAddOrModifyRequestList).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.QosFlowAddOrModifyRequestList.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.QosFlowAddOrModifyRequestList", i))
+			return
+		}
+		i++
+	}
+	if v.QosFlowToReleaseList != nil {
+		if err = ProtocolIEID(idQosFlowToReleaseList).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.QosFlowToReleaseList.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.QosFlowList", i))
+			return
+		}
+		i++
+	}
+	if v.AdditionalULNGUUPTNLInformation != nil {
+		if err = ProtocolIEID(idAdditionalULNGUUPTNLInformation).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.AdditionalULNGUUPTNLInformation.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UPTransportLayerInformationList", i))
+			return
+		}
+		i++
+	}
+	return
+}
+
+func (v *PDUSessionResourceModifyRequestTransfer) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceModifyRequestTransfer{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idPDUSessionAggregateMaximumBitRate:
+			v.PDUSessionAggregateMaximumBitRate = new(PDUSessionAggregateMaximumBitRate)
+			if err = r.DecOpenType(v.PDUSessionAggregateMaximumBitRate.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionAggregateMaximumBitRate", i))
+				return
+			}
+		case idULNGUUPTNLModifyList:
+			v.ULNGUUPTNLModifyList = new(ULNGUUPTNLModifyList)
+			if err = r.DecOpenType(v.ULNGUUPTNLModifyList.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UL-NGU-UP-TNLModifyList", i))
+				return
+			}
+		case idNetworkInstance:
+			v.NetworkInstance = new(NetworkInstance)
+			if err = r.DecOpenType(v.NetworkInstance.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.NetworkInstance", i))
+				return
+			}
+		case idQosFlowAddOrModifyRequestList:
+			v.QosFlowAddOrModifyRequestList = new(QosFlowAddOrModifyRequestList)
+			if err = r.DecOpenType(v.QosFlowAddOrModifyRequestList.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.QosFlowAddOrModifyRequestList", i))
+				return
+			}
+		case idQosFlowToReleaseList:
+			v.QosFlowToReleaseList = new(QosFlowList)
+			if err = r.DecOpenType(v.QosFlowToReleaseList.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.QosFlowList", i))
+				return
+			}
+		case idAdditionalULNGUUPTNLInformation:
+			v.AdditionalULNGUUPTNLInformation = new(UPTransportLayerInformationList)
+			if err = r.DecOpenType(v.AdditionalULNGUUPTNLInformation.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UPTransportLayerInformationList", i))
+				return
+			}
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceModifyResponseTransfer is PDUSessionResourceModifyResponseTransfer in NGAP-IEs.
+type PDUSessionResourceModifyResponseTransfer struct {
+	DLNGUUPTNLInformation                *UPTransportLayerInformation
+	ULNGUUPTNLInformation                *UPTransportLayerInformation
+	QosFlowAddOrModifyResponseList       *QosFlowAddOrModifyResponseList
+	AdditionalDLQosFlowPerTNLInformation *QosFlowPerTNLInformationList
+	QosFlowFailedToAddOrModifyList       *QosFlowList
+	IEExtensions                         *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceModifyResponseTransfer) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.DLNGUUPTNLInformation != nil {
+		optflag |= 1 << 5
+	}
+	if v.ULNGUUPTNLInformation != nil {
+		optflag |= 1 << 4
+	}
+	if v.QosFlowAddOrModifyResponseList != nil {
+		optflag |= 1 << 3
+	}
+	if v.AdditionalDLQosFlowPerTNLInformation != nil {
+		optflag |= 1 << 2
+	}
+	if v.QosFlowFailedToAddOrModifyList != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 6, optflag); err != nil {
+		return
+	}
+	if v.DLNGUUPTNLInformation != nil {
+		if err = v.DLNGUUPTNLInformation.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "dL-NGU-UP-TNLInformation")
+			return
+		}
+	}
+	if v.ULNGUUPTNLInformation != nil {
+		if err = v.ULNGUUPTNLInformation.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "uL-NGU-UP-TNLInformation")
+			return
+		}
+	}
+	if v.QosFlowAddOrModifyResponseList != nil {
+		if err = v.QosFlowAddOrModifyResponseList.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "qosFlowAddOrModifyResponseList")
+			return
+		}
+	}
+	if v.AdditionalDLQosFlowPerTNLInformation != nil {
+		if err = v.AdditionalDLQosFlowPerTNLInformation.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "additionalDLQosFlowPerTNLInformation")
+			return
+		}
+	}
+	if v.QosFlowFailedToAddOrModifyList != nil {
+		if err = v.QosFlowFailedToAddOrModifyList.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "qosFlowFailedToAddOrModifyList")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceModifyResponseTransfer) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 6)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceModifyResponseTransfer{}
+	if optflag&(1<<5) != 0 {
+		v.DLNGUUPTNLInformation = new(UPTransportLayerInformation)
+		if err = v.DLNGUUPTNLInformation.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "dL-NGU-UP-TNLInformation")
+			return
+		}
+	}
+	if optflag&(1<<4) != 0 {
+		v.ULNGUUPTNLInformation = new(UPTransportLayerInformation)
+		if err = v.ULNGUUPTNLInformation.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "uL-NGU-UP-TNLInformation")
+			return
+		}
+	}
+	if optflag&(1<<3) != 0 {
+		v.QosFlowAddOrModifyResponseList = new(QosFlowAddOrModifyResponseList)
+		if err = v.QosFlowAddOrModifyResponseList.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "qosFlowAddOrModifyResponseList")
+			return
+		}
+	}
+	if optflag&(1<<2) != 0 {
+		v.AdditionalDLQosFlowPerTNLInformation = new(QosFlowPerTNLInformationList)
+		if err = v.AdditionalDLQosFlowPerTNLInformation.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "additionalDLQosFlowPerTNLInformation")
+			return
+		}
+	}
+	if optflag&(1<<1) != 0 {
+		v.QosFlowFailedToAddOrModifyList = new(QosFlowList)
+		if err = v.QosFlowFailedToAddOrModifyList.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "qosFlowFailedToAddOrModifyList")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceModifyUnsuccessfulTransfer is PDUSessionResourceModifyUnsuccessfulTransfer in NGAP-IEs.
+type PDUSessionResourceModifyUnsuccessfulTransfer struct {
+	Cause                  Cause
+	CriticalityDiagnostics *CriticalityDiagnostics
+	IEExtensions           *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceModifyUnsuccessfulTransfer) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.CriticalityDiagnostics != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 2, optflag); err != nil {
+		return
+	}
+	if err = v.Cause.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "cause")
+		return
+	}
+	if v.CriticalityDiagnostics != nil {
+		if err = v.CriticalityDiagnostics.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "criticalityDiagnostics")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceModifyUnsuccessfulTransfer) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 2)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceModifyUnsuccessfulTransfer{}
+	if err = v.Cause.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "cause")
+		return
+	}
+	if optflag&(1<<1) != 0 {
+		v.CriticalityDiagnostics = new(CriticalityDiagnostics)
+		if err = v.CriticalityDiagnostics.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "criticalityDiagnostics")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceNotifyItem is PDUSessionResourceNotifyItem in NGAP-IEs.
+type PDUSessionResourceNotifyItem struct {
+	PDUSessionID                     PDUSessionID
+	PDUSessionResourceNotifyTransfer []byte
+	IEExtensions                     *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceNotifyItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if err = w.EncOctetString(v.PDUSessionResourceNotifyTransfer, 0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceNotifyTransfer")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceNotifyItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceNotifyItem{}
+	if err = v.PDUSessionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if v.PDUSessionResourceNotifyTransfer, err = r.DecOctetString(0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceNotifyTransfer")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceNotifyList is PDUSessionResourceNotifyList in NGAP-IEs.
+type PDUSessionResourceNotifyList []PDUSessionResourceNotifyItem
+
+func (v PDUSessionResourceNotifyList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPDUSessions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceNotifyList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPDUSessions, false)
+	if err != nil {
+		return
+	}
+	*v = make(PDUSessionResourceNotifyList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionResourceNotifyReleasedTransfer is PDUSessionResourceNotifyReleasedTransfer in NGAP-IEs.
+type PDUSessionResourceNotifyReleasedTransfer struct {
+	Cause        Cause
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceNotifyReleasedTransfer) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.Cause.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "cause")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceNotifyReleasedTransfer) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceNotifyReleasedTransfer{}
+	if err = v.Cause.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "cause")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceNotifyTransfer is PDUSessionResourceNotifyTransfer in NGAP-IEs.
+type PDUSessionResourceNotifyTransfer struct {
+	QosFlowNotifyList   *QosFlowNotifyList
+	QosFlowReleasedList *QosFlowList
+	IEExtensions        *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceNotifyTransfer) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.QosFlowNotifyList != nil {
+		optflag |= 1 << 2
+	}
+	if v.QosFlowReleasedList != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 3, optflag); err != nil {
+		return
+	}
+	if v.QosFlowNotifyList != nil {
+		if err = v.QosFlowNotifyList.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "qosFlowNotifyList")
+			return
+		}
+	}
+	if v.QosFlowReleasedList != nil {
+		if err = v.QosFlowReleasedList.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "qosFlowReleasedList")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceNotifyTransfer) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 3)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceNotifyTransfer{}
+	if optflag&(1<<2) != 0 {
+		v.QosFlowNotifyList = new(QosFlowNotifyList)
+		if err = v.QosFlowNotifyList.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "qosFlowNotifyList")
+			return
+		}
+	}
+	if optflag&(1<<1) != 0 {
+		v.QosFlowReleasedList = new(QosFlowList)
+		if err = v.QosFlowReleasedList.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "qosFlowReleasedList")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceReleaseCommandTransfer is PDUSessionResourceReleaseCommandTransfer in NGAP-IEs.
+type PDUSessionResourceReleaseCommandTransfer struct {
+	Cause        Cause
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceReleaseCommandTransfer) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.Cause.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "cause")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceReleaseCommandTransfer) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceReleaseCommandTransfer{}
+	if err = v.Cause.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "cause")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceReleasedItemNot is PDUSessionResourceReleasedItemNot in NGAP-IEs.
+type PDUSessionResourceReleasedItemNot struct {
+	PDUSessionID                             PDUSessionID
+	PDUSessionResourceNotifyReleasedTransfer []byte
+	IEExtensions                             *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceReleasedItemNot) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if err = w.EncOctetString(v.PDUSessionResourceNotifyReleasedTransfer, 0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceNotifyReleasedTransfer")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceReleasedItemNot) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceReleasedItemNot{}
+	if err = v.PDUSessionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if v.PDUSessionResourceNotifyReleasedTransfer, err = r.DecOctetString(0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceNotifyReleasedTransfer")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceReleasedItemRelRes is PDUSessionResourceReleasedItemRelRes in NGAP-IEs.
+type PDUSessionResourceReleasedItemRelRes struct {
+	PDUSessionID                              PDUSessionID
+	PDUSessionResourceReleaseResponseTransfer []byte
+	IEExtensions                              *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceReleasedItemRelRes) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if err = w.EncOctetString(v.PDUSessionResourceReleaseResponseTransfer, 0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceReleaseResponseTransfer")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceReleasedItemRelRes) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceReleasedItemRelRes{}
+	if err = v.PDUSessionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if v.PDUSessionResourceReleaseResponseTransfer, err = r.DecOctetString(0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceReleaseResponseTransfer")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceReleasedListNot is PDUSessionResourceReleasedListNot in NGAP-IEs.
+type PDUSessionResourceReleasedListNot []PDUSessionResourceReleasedItemNot
+
+func (v PDUSessionResourceReleasedListNot) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPDUSessions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceReleasedListNot) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPDUSessions, false)
+	if err != nil {
+		return
+	}
+	*v = make(PDUSessionResourceReleasedListNot, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionResourceReleasedListRelRes is PDUSessionResourceReleasedListRelRes in NGAP-IEs.
+type PDUSessionResourceReleasedListRelRes []PDUSessionResourceReleasedItemRelRes
+
+func (v PDUSessionResourceReleasedListRelRes) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPDUSessions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceReleasedListRelRes) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPDUSessions, false)
+	if err != nil {
+		return
+	}
+	*v = make(PDUSessionResourceReleasedListRelRes, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionResourceReleaseResponseTransfer is PDUSessionResourceReleaseResponseTransfer in NGAP-IEs.
+type PDUSessionResourceReleaseResponseTransfer struct {
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceReleaseResponseTransfer) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceReleaseResponseTransfer) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceReleaseResponseTransfer{}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceSetupItemCxtReq is PDUSessionResourceSetupItemCxtReq in NGAP-IEs.
+type PDUSessionResourceSetupItemCxtReq struct {
+	PDUSessionID                           PDUSessionID
+	NASPDU                                 *NASPDU
+	SNSSAI                                 SNSSAI
+	PDUSessionResourceSetupRequestTransfer []byte
+	IEExtensions                           *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceSetupItemCxtReq) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.NASPDU != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 2, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if v.NASPDU != nil {
+		if err = v.NASPDU.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "nAS-PDU")
+			return
+		}
+	}
+	if err = v.SNSSAI.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "s-NSSAI")
+		return
+	}
+	if err = w.EncOctetString(v.PDUSessionResourceSetupRequestTransfer, 0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceSetupRequestTransfer")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceSetupItemCxtReq) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 2)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceSetupItemCxtReq{}
+	if err = v.PDUSessionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if optflag&(1<<1) != 0 {
+		v.NASPDU = new(NASPDU)
+		if err = v.NASPDU.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "nAS-PDU")
+			return
+		}
+	}
+	if err = v.SNSSAI.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "s-NSSAI")
+		return
+	}
+	if v.PDUSessionResourceSetupRequestTransfer, err = r.DecOctetString(0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceSetupRequestTransfer")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceSetupItemCxtRes is PDUSessionResourceSetupItemCxtRes in NGAP-IEs.
+type PDUSessionResourceSetupItemCxtRes struct {
+	PDUSessionID                            PDUSessionID
+	PDUSessionResourceSetupResponseTransfer []byte
+	IEExtensions                            *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceSetupItemCxtRes) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if err = w.EncOctetString(v.PDUSessionResourceSetupResponseTransfer, 0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceSetupResponseTransfer")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceSetupItemCxtRes) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceSetupItemCxtRes{}
+	if err = v.PDUSessionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if v.PDUSessionResourceSetupResponseTransfer, err = r.DecOctetString(0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceSetupResponseTransfer")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceSetupItemSUReq is PDUSessionResourceSetupItemSUReq in NGAP-IEs.
+type PDUSessionResourceSetupItemSUReq struct {
+	PDUSessionID                           PDUSessionID
+	PDUSessionNASPDU                       *NASPDU
+	SNSSAI                                 SNSSAI
+	PDUSessionResourceSetupRequestTransfer []byte
+	IEExtensions                           *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceSetupItemSUReq) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.PDUSessionNASPDU != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 2, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if v.PDUSessionNASPDU != nil {
+		if err = v.PDUSessionNASPDU.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "pDUSessionNAS-PDU")
+			return
+		}
+	}
+	if err = v.SNSSAI.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "s-NSSAI")
+		return
+	}
+	if err = w.EncOctetString(v.PDUSessionResourceSetupRequestTransfer, 0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceSetupRequestTransfer")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceSetupItemSUReq) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 2)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceSetupItemSUReq{}
+	if err = v.PDUSessionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if optflag&(1<<1) != 0 {
+		v.PDUSessionNASPDU = new(NASPDU)
+		if err = v.PDUSessionNASPDU.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "pDUSessionNAS-PDU")
+			return
+		}
+	}
+	if err = v.SNSSAI.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "s-NSSAI")
+		return
+	}
+	if v.PDUSessionResourceSetupRequestTransfer, err = r.DecOctetString(0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceSetupRequestTransfer")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceSetupItemSURes is PDUSessionResourceSetupItemSURes in NGAP-IEs.
+type PDUSessionResourceSetupItemSURes struct {
+	PDUSessionID                            PDUSessionID
+	PDUSessionResourceSetupResponseTransfer []byte
+	IEExtensions                            *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceSetupItemSURes) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if err = w.EncOctetString(v.PDUSessionResourceSetupResponseTransfer, 0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceSetupResponseTransfer")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceSetupItemSURes) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceSetupItemSURes{}
+	if err = v.PDUSessionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if v.PDUSessionResourceSetupResponseTransfer, err = r.DecOctetString(0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceSetupResponseTransfer")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceSetupListCxtReq is PDUSessionResourceSetupListCxtReq in NGAP-IEs.
+type PDUSessionResourceSetupListCxtReq []PDUSessionResourceSetupItemCxtReq
+
+func (v PDUSessionResourceSetupListCxtReq) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPDUSessions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceSetupListCxtReq) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPDUSessions, false)
+	if err != nil {
+		return
+	}
+	*v = make(PDUSessionResourceSetupListCxtReq, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionResourceSetupListCxtRes is PDUSessionResourceSetupListCxtRes in NGAP-IEs.
+type PDUSessionResourceSetupListCxtRes []PDUSessionResourceSetupItemCxtRes
+
+func (v PDUSessionResourceSetupListCxtRes) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPDUSessions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceSetupListCxtRes) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPDUSessions, false)
+	if err != nil {
+		return
+	}
+	*v = make(PDUSessionResourceSetupListCxtRes, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionResourceSetupListSUReq is PDUSessionResourceSetupListSUReq in NGAP-IEs.
+type PDUSessionResourceSetupListSUReq []PDUSessionResourceSetupItemSUReq
+
+func (v PDUSessionResourceSetupListSUReq) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPDUSessions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceSetupListSUReq) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPDUSessions, false)
+	if err != nil {
+		return
+	}
+	*v = make(PDUSessionResourceSetupListSUReq, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionResourceSetupListSURes is PDUSessionResourceSetupListSURes in NGAP-IEs.
+type PDUSessionResourceSetupListSURes []PDUSessionResourceSetupItemSURes
+
+func (v PDUSessionResourceSetupListSURes) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPDUSessions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceSetupListSURes) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPDUSessions, false)
+	if err != nil {
+		return
+	}
+	*v = make(PDUSessionResourceSetupListSURes, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionResourceSetupRequestTransfer is PDUSessionResourceSetupRequestTransfer in NGAP-IEs.
+type PDUSessionResourceSetupRequestTransfer struct {
+	PDUSessionAggregateMaximumBitRate *PDUSessionAggregateMaximumBitRate
+	ULNGUUPTNLInformation             UPTransportLayerInformation
+	AdditionalULNGUUPTNLInformation   *UPTransportLayerInformationList
+	DataForwardingNotPossible         *DataForwardingNotPossible
+	PDUSessionType                    PDUSessionType
+	SecurityIndication                *SecurityIndication
+	NetworkInstance                   *NetworkInstance
+	QosFlowSetupRequestList           QosFlowSetupRequestList
+}
+
+func (v PDUSessionResourceSetupRequestTransfer) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 3
+	if v.PDUSessionAggregateMaximumBitRate != nil {
+		n++
+	}
+	if v.AdditionalULNGUUPTNLInformation != nil {
+		n++
+	}
+	if v.DataForwardingNotPossible != nil {
+		n++
+	}
+	if v.SecurityIndication != nil {
+		n++
+	}
+	if v.NetworkInstance != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if v.PDUSessionAggregateMaximumBitRate != nil {
+		if err = ProtocolIEID(idPDUSessionAggregateMaximumBitRate).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.PDUSessionAggregateMaximumBitRate.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionAggregateMaximumBitRate", i))
+			return
+		}
+		i++
+	}
+	if err = ProtocolIEID(idULNGUUPTNLInformation).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.ULNGUUPTNLInformation.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UPTransportLayerInformation", i))
+		return
+	}
+	i++
+	if v.AdditionalULNGUUPTNLInformation != nil {
+		if err = ProtocolIEID(idAdditionalULNGUUPTNLInformation).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.AdditionalULNGUUPTNLInformation.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UPTransportLayerInformationList", i))
+			return
+		}
+		i++
+	}
+	if v.DataForwardingNotPossible != nil {
+		if err = ProtocolIEID(idDataForwardingNotPossible).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.DataForwardingNotPossible.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.DataForwardingNotPossible", i))
+			return
+		}
+		i++
+	}
+	if err = ProtocolIEID(idPDUSessionType).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.PDUSessionType.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionType", i))
+		return
+	}
+	i++
+	if v.SecurityIndication != nil {
+		if err = ProtocolIEID(idSecurityIndication).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.SecurityIndication.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.SecurityIndication", i))
+			return
+		}
+		i++
+	}
+	if v.NetworkInstance != nil {
+		if err = ProtocolIEID(idNetworkInstance).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.NetworkInstance.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.NetworkInstance", i))
+			return
+		}
+		i++
+	}
+	if err = ProtocolIEID(idQosFlowSetupRequestList).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.QosFlowSetupRequestList.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.QosFlowSetupRequestList", i))
+		return
+	}
+	i++
+	return
+}
+
+func (v *PDUSessionResourceSetupRequestTransfer) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceSetupRequestTransfer{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasULNGUUPTNLInformation, hasPDUSessionType, hasQosFlowSetupRequestList bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idPDUSessionAggregateMaximumBitRate:
+			v.PDUSessionAggregateMaximumBitRate = new(PDUSessionAggregateMaximumBitRate)
+			if err = r.DecOpenType(v.PDUSessionAggregateMaximumBitRate.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionAggregateMaximumBitRate", i))
+				return
+			}
+		case idULNGUUPTNLInformation:
+			if err = r.DecOpenType(v.ULNGUUPTNLInformation.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UPTransportLayerInformation", i))
+				return
+			}
+			hasULNGUUPTNLInformation = true
+		case idAdditionalULNGUUPTNLInformation:
+			v.AdditionalULNGUUPTNLInformation = new(UPTransportLayerInformationList)
+			if err = r.DecOpenType(v.AdditionalULNGUUPTNLInformation.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UPTransportLayerInformationList", i))
+				return
+			}
+		case idDataForwardingNotPossible:
+			v.DataForwardingNotPossible = new(DataForwardingNotPossible)
+			if err = r.DecOpenType(v.DataForwardingNotPossible.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.DataForwardingNotPossible", i))
+				return
+			}
+		case idPDUSessionType:
+			if err = r.DecOpenType(v.PDUSessionType.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionType", i))
+				return
+			}
+			hasPDUSessionType = true
+		case idSecurityIndication:
+			v.SecurityIndication = new(SecurityIndication)
+			if err = r.DecOpenType(v.SecurityIndication.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.SecurityIndication", i))
+				return
+			}
+		case idNetworkInstance:
+			v.NetworkInstance = new(NetworkInstance)
+			if err = r.DecOpenType(v.NetworkInstance.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.NetworkInstance", i))
+				return
+			}
+		case idQosFlowSetupRequestList:
+			if err = r.DecOpenType(v.QosFlowSetupRequestList.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.QosFlowSetupRequestList", i))
+				return
+			}
+			hasQosFlowSetupRequestList = true
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasULNGUUPTNLInformation == false {
+		err = &per.MissingIEError{IE: "ULNGUUPTNLInformation"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasPDUSessionType == false {
+		err = &per.MissingIEError{IE: "PDUSessionType"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasQosFlowSetupRequestList == false {
+		err = &per.MissingIEError{IE: "QosFlowSetupRequestList"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceSetupResponseTransfer is PDUSessionResourceSetupResponseTransfer in NGAP-IEs.
+type PDUSessionResourceSetupResponseTransfer struct {
+	QosFlowPerTNLInformation           QosFlowPerTNLInformation
+	AdditionalQosFlowPerTNLInformation *QosFlowPerTNLInformation
+	SecurityResult                     *SecurityResult
+	QosFlowFailedToSetupList           *QosFlowList
+	IEExtensions                       *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceSetupResponseTransfer) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.AdditionalQosFlowPerTNLInformation != nil {
+		optflag |= 1 << 3
+	}
+	if v.SecurityResult != nil {
+		optflag |= 1 << 2
+	}
+	if v.QosFlowFailedToSetupList != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 4, optflag); err != nil {
+		return
+	}
+	if err = v.QosFlowPerTNLInformation.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "qosFlowPerTNLInformation")
+		return
+	}
+	if v.AdditionalQosFlowPerTNLInformation != nil {
+		if err = v.AdditionalQosFlowPerTNLInformation.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "additionalQosFlowPerTNLInformation")
+			return
+		}
+	}
+	if v.SecurityResult != nil {
+		if err = v.SecurityResult.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "securityResult")
+			return
+		}
+	}
+	if v.QosFlowFailedToSetupList != nil {
+		if err = v.QosFlowFailedToSetupList.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "qosFlowFailedToSetupList")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceSetupResponseTransfer) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 4)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceSetupResponseTransfer{}
+	if err = v.QosFlowPerTNLInformation.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "qosFlowPerTNLInformation")
+		return
+	}
+	if optflag&(1<<3) != 0 {
+		v.AdditionalQosFlowPerTNLInformation = new(QosFlowPerTNLInformation)
+		if err = v.AdditionalQosFlowPerTNLInformation.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "additionalQosFlowPerTNLInformation")
+			return
+		}
+	}
+	if optflag&(1<<2) != 0 {
+		v.SecurityResult = new(SecurityResult)
+		if err = v.SecurityResult.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "securityResult")
+			return
+		}
+	}
+	if optflag&(1<<1) != 0 {
+		v.QosFlowFailedToSetupList = new(QosFlowList)
+		if err = v.QosFlowFailedToSetupList.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "qosFlowFailedToSetupList")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceSetupUnsuccessfulTransfer is PDUSessionResourceSetupUnsuccessfulTransfer in NGAP-IEs.
+type PDUSessionResourceSetupUnsuccessfulTransfer struct {
+	Cause                  Cause
+	CriticalityDiagnostics *CriticalityDiagnostics
+	IEExtensions           *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceSetupUnsuccessfulTransfer) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.CriticalityDiagnostics != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 2, optflag); err != nil {
+		return
+	}
+	if err = v.Cause.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "cause")
+		return
+	}
+	if v.CriticalityDiagnostics != nil {
+		if err = v.CriticalityDiagnostics.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "criticalityDiagnostics")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceSetupUnsuccessfulTransfer) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 2)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceSetupUnsuccessfulTransfer{}
+	if err = v.Cause.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "cause")
+		return
+	}
+	if optflag&(1<<1) != 0 {
+		v.CriticalityDiagnostics = new(CriticalityDiagnostics)
+		if err = v.CriticalityDiagnostics.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "criticalityDiagnostics")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceToReleaseItemRelCmd is PDUSessionResourceToReleaseItemRelCmd in NGAP-IEs.
+type PDUSessionResourceToReleaseItemRelCmd struct {
+	PDUSessionID                             PDUSessionID
+	PDUSessionResourceReleaseCommandTransfer []byte
+	IEExtensions                             *ProtocolExtensionContainer
+}
+
+func (v PDUSessionResourceToReleaseItemRelCmd) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PDUSessionID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if err = w.EncOctetString(v.PDUSessionResourceReleaseCommandTransfer, 0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceReleaseCommandTransfer")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceToReleaseItemRelCmd) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceToReleaseItemRelCmd{}
+	if err = v.PDUSessionID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pDUSessionID")
+		return
+	}
+	if v.PDUSessionResourceReleaseCommandTransfer, err = r.DecOctetString(0, per.NoUpperBound, false); err != nil {
+		err = per.WithPath(err, "pDUSessionResourceReleaseCommandTransfer")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceToReleaseListRelCmd is PDUSessionResourceToReleaseListRelCmd in NGAP-IEs.
+type PDUSessionResourceToReleaseListRelCmd []PDUSessionResourceToReleaseItemRelCmd
+
+func (v PDUSessionResourceToReleaseListRelCmd) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPDUSessions, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PDUSessionResourceToReleaseListRelCmd) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPDUSessions, false)
+	if err != nil {
+		return
+	}
+	*v = make(PDUSessionResourceToReleaseListRelCmd, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionType is PDUSessionType in NGAP-IEs.
+type PDUSessionType int
+
+const (
+	PDUSessionTypeIpv4 PDUSessionType = iota
+	PDUSessionTypeIpv6
+	PDUSessionTypeIpv4v6
+	PDUSessionTypeEthernet
+	PDUSessionTypeUnstructured
+)
+
+func (v PDUSessionType) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 4, true)
+}
+
+func (v *PDUSessionType) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 4, true)
+	*v = PDUSessionType(x)
+	return
+}
+
+// PeriodicRegistrationUpdateTimer is PeriodicRegistrationUpdateTimer in NGAP-IEs.
+type PeriodicRegistrationUpdateTimer per.BitString
+
+func (v PeriodicRegistrationUpdateTimer) MarshalPER(w *per.BitWriter) error {
+	return w.EncBitString(v.Bytes, v.BitLength, 8, 8, false)
+}
+
+func (v *PeriodicRegistrationUpdateTimer) UnmarshalPER(r *per.BitReader) (err error) {
+	v.Bytes, v.BitLength, err = r.DecBitString(8, 8, false)
+	return
+}
+
+// PLMNIdentity is PLMNIdentity in NGAP-IEs.
+type PLMNIdentity []byte
+
+func (v PLMNIdentity) MarshalPER(w *per.BitWriter) error {
+	return w.EncOctetString(v, 3, 3, false)
+}
+
+func (v *PLMNIdentity) UnmarshalPER(r *per.BitReader) (err error) {
+	*v, err = r.DecOctetString(3, 3, false)
+	return
+}
+
+// PLMNSupportItem is PLMNSupportItem in NGAP-IEs.
+type PLMNSupportItem struct {
+	PLMNIdentity     PLMNIdentity
+	SliceSupportList SliceSupportList
+	IEExtensions     *ProtocolExtensionContainer
+}
+
+func (v PLMNSupportItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PLMNIdentity.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.SliceSupportList.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "sliceSupportList")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *PLMNSupportItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = PLMNSupportItem{}
+	if err = v.PLMNIdentity.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.SliceSupportList.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "sliceSupportList")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PLMNSupportList is PLMNSupportList in NGAP-IEs.
+type PLMNSupportList []PLMNSupportItem
+
+func (v PLMNSupportList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofPLMNs, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *PLMNSupportList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofPLMNs, false)
+	if err != nil {
+		return
+	}
+	*v = make(PLMNSupportList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// PortNumber is PortNumber in NGAP-IEs.
+type PortNumber []byte
+
+func (v PortNumber) MarshalPER(w *per.BitWriter) error {
+	return w.EncOctetString(v, 2, 2, false)
+}
+
+func (v *PortNumber) UnmarshalPER(r *per.BitReader) (err error) {
+	*v, err = r.DecOctetString(2, 2, false)
+	return
+}
+
+// PreEmptionCapability is Pre-emptionCapability in NGAP-IEs.
+type PreEmptionCapability int
+
+const (
+	PreEmptionCapabilityShallNotTriggerPreEmption PreEmptionCapability = iota
+	PreEmptionCapabilityMayTriggerPreEmption
+)
+
+func (v PreEmptionCapability) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 1, true)
+}
+
+func (v *PreEmptionCapability) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 1, true)
+	*v = PreEmptionCapability(x)
+	return
+}
+
+// PreEmptionVulnerability is Pre-emptionVulnerability in NGAP-IEs.
+type PreEmptionVulnerability int
+
+const (
+	PreEmptionVulnerabilityNotPreEmptable PreEmptionVulnerability = iota
+	PreEmptionVulnerabilityPreEmptable
+)
+
+func (v PreEmptionVulnerability) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 1, true)
+}
+
+func (v *PreEmptionVulnerability) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 1, true)
+	*v = PreEmptionVulnerability(x)
+	return
+}
+
+// PriorityLevelARP is PriorityLevelARP in NGAP-IEs.
+type PriorityLevelARP int
+
+func (v PriorityLevelARP) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 1, 15, false)
+}
+
+func (v *PriorityLevelARP) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(1, 15, false)
+	if err != nil {
+		return
+	}
+	*v = PriorityLevelARP(x)
+	return
+}
+
+// PriorityLevelQos is PriorityLevelQos in NGAP-IEs.
+type PriorityLevelQos int
+
+func (v PriorityLevelQos) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 1, 127, true)
+}
+
+func (v *PriorityLevelQos) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(1, 127, true)
+	if err != nil {
+		return
+	}
+	*v = PriorityLevelQos(x)
+	return
+}
+
+// QosCharacteristics is QosCharacteristics in NGAP-IEs.
+type QosCharacteristics struct {
+	NonDynamic5QI    *NonDynamic5QIDescriptor
+	Dynamic5QI       *Dynamic5QIDescriptor
+	ChoiceExtensions *ProtocolIESingleContainer
+}
+
+func (v QosCharacteristics) MarshalPER(w *per.BitWriter) (err error) {
+	chosen := 0
+	if v.NonDynamic5QI != nil {
+		chosen++
+	}
+	if v.Dynamic5QI != nil {
+		chosen++
+	}
+	if v.ChoiceExtensions != nil {
+		chosen++
+	}
+	if chosen > 1 {
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "more than one alternative is chosen"}
+		return
+	}
+	switch {
+	case v.NonDynamic5QI != nil:
+		if err = w.EncChoice(0, 0, 2, false); err != nil {
+			return
+		}
+		if err = v.NonDynamic5QI.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "nonDynamic5QI")
+			return
+		}
+	case v.Dynamic5QI != nil:
+		if err = w.EncChoice(1, 0, 2, false); err != nil {
+			return
+		}
+		if err = v.Dynamic5QI.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "dynamic5QI")
+			return
+		}
+	case v.ChoiceExtensions != nil:
+		if err = w.EncChoice(2, 0, 2, false); err != nil {
+			return
+		}
+		if err = v.ChoiceExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	default:
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "no alternative is chosen"}
+	}
+	return
+}
+
+func (v *QosCharacteristics) UnmarshalPER(r *per.BitReader) (err error) {
+	index, err := r.DecChoice(0, 2, false)
+	if err != nil {
+		return
+	}
+	*v = QosCharacteristics{}
+	switch index {
+	case 0:
+		v.NonDynamic5QI = new(NonDynamic5QIDescriptor)
+		if err = v.NonDynamic5QI.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "nonDynamic5QI")
+			return
+		}
+	case 1:
+		v.Dynamic5QI = new(Dynamic5QIDescriptor)
+		if err = v.Dynamic5QI.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "dynamic5QI")
+			return
+		}
+	case 2:
+		v.ChoiceExtensions = new(ProtocolIESingleContainer)
+		if err = v.ChoiceExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	}
+	return
+}
+
+// QosFlowAddOrModifyRequestItem is QosFlowAddOrModifyRequestItem in NGAP-IEs.
+type QosFlowAddOrModifyRequestItem struct {
+	QosFlowIdentifier         QosFlowIdentifier
+	QosFlowLevelQosParameters *QosFlowLevelQosParameters
+	ERABID                    *ERABID
+	IEExtensions              *ProtocolExtensionContainer
+}
+
+func (v QosFlowAddOrModifyRequestItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.QosFlowLevelQosParameters != nil {
+		optflag |= 1 << 2
+	}
+	if v.ERABID != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 3, optflag); err != nil {
+		return
+	}
+	if err = v.QosFlowIdentifier.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "qosFlowIdentifier")
+		return
+	}
+	if v.QosFlowLevelQosParameters != nil {
+		if err = v.QosFlowLevelQosParameters.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "qosFlowLevelQosParameters")
+			return
+		}
+	}
+	if v.ERABID != nil {
+		if err = v.ERABID.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "e-RAB-ID")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *QosFlowAddOrModifyRequestItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 3)
+	if err != nil {
+		return
+	}
+	*v = QosFlowAddOrModifyRequestItem{}
+	if err = v.QosFlowIdentifier.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "qosFlowIdentifier")
+		return
+	}
+	if optflag&(1<<2) != 0 {
+		v.QosFlowLevelQosParameters = new(QosFlowLevelQosParameters)
+		if err = v.QosFlowLevelQosParameters.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "qosFlowLevelQosParameters")
+			return
+		}
+	}
+	if optflag&(1<<1) != 0 {
+		v.ERABID = new(ERABID)
+		if err = v.ERABID.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "e-RAB-ID")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// QosFlowAddOrModifyRequestList is QosFlowAddOrModifyRequestList in NGAP-IEs.
+type QosFlowAddOrModifyRequestList []QosFlowAddOrModifyRequestItem
+
+func (v QosFlowAddOrModifyRequestList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofQosFlows, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *QosFlowAddOrModifyRequestList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofQosFlows, false)
+	if err != nil {
+		return
+	}
+	*v = make(QosFlowAddOrModifyRequestList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// QosFlowAddOrModifyResponseItem is QosFlowAddOrModifyResponseItem in NGAP-IEs.
+type QosFlowAddOrModifyResponseItem struct {
+	QosFlowIdentifier QosFlowIdentifier
+	IEExtensions      *ProtocolExtensionContainer
+}
+
+func (v QosFlowAddOrModifyResponseItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.QosFlowIdentifier.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "qosFlowIdentifier")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *QosFlowAddOrModifyResponseItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = QosFlowAddOrModifyResponseItem{}
+	if err = v.QosFlowIdentifier.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "qosFlowIdentifier")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// QosFlowAddOrModifyResponseList is QosFlowAddOrModifyResponseList in NGAP-IEs.
+type QosFlowAddOrModifyResponseList []QosFlowAddOrModifyResponseItem
+
+func (v QosFlowAddOrModifyResponseList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofQosFlows, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *QosFlowAddOrModifyResponseList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofQosFlows, false)
+	if err != nil {
+		return
+	}
+	*v = make(QosFlowAddOrModifyResponseList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// QosFlowIdentifier is QosFlowIdentifier in NGAP-IEs.
+type QosFlowIdentifier int
+
+func (v QosFlowIdentifier) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 0, 63, true)
+}
+
+func (v *QosFlowIdentifier) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(0, 63, true)
+	if err != nil {
+		return
+	}
+	*v = QosFlowIdentifier(x)
+	return
+}
+
+// QosFlowItem is QosFlowItem in NGAP-IEs.
+type QosFlowItem struct {
+	QosFlowIdentifier QosFlowIdentifier
+	Cause             Cause
+	IEExtensions      *ProtocolExtensionContainer
+}
+
+func (v QosFlowItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.QosFlowIdentifier.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "qosFlowIdentifier")
+		return
+	}
+	if err = v.Cause.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "cause")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *QosFlowItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = QosFlowItem{}
+	if err = v.QosFlowIdentifier.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "qosFlowIdentifier")
+		return
+	}
+	if err = v.Cause.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "cause")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// QosFlowLevelQosParameters is QosFlowLevelQosParameters in NGAP-IEs.
+type QosFlowLevelQosParameters struct {
+	QosCharacteristics             QosCharacteristics
+	AllocationAndRetentionPriority AllocationAndRetentionPriority
+	GBRQosInformation              *GBRQosInformation
+	ReflectiveQosAttribute         *ReflectiveQosAttribute
+	AdditionalQosFlowInformation   *AdditionalQosFlowInformation
+	IEExtensions                   *ProtocolExtensionContainer
+}
+
+func (v QosFlowLevelQosParameters) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.GBRQosInformation != nil {
+		optflag |= 1 << 3
+	}
+	if v.ReflectiveQosAttribute != nil {
+		optflag |= 1 << 2
+	}
+	if v.AdditionalQosFlowInformation != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 4, optflag); err != nil {
+		return
+	}
+	if err = v.QosCharacteristics.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "qosCharacteristics")
+		return
+	}
+	if err = v.AllocationAndRetentionPriority.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "allocationAndRetentionPriority")
+		return
+	}
+	if v.GBRQosInformation != nil {
+		if err = v.GBRQosInformation.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "gBR-QosInformation")
+			return
+		}
+	}
+	if v.ReflectiveQosAttribute != nil {
+		if err = v.ReflectiveQosAttribute.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "reflectiveQosAttribute")
+			return
+		}
+	}
+	if v.AdditionalQosFlowInformation != nil {
+		if err = v.AdditionalQosFlowInformation.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "additionalQosFlowInformation")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *QosFlowLevelQosParameters) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 4)
+	if err != nil {
+		return
+	}
+	*v = QosFlowLevelQosParameters{}
+	if err = v.QosCharacteristics.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "qosCharacteristics")
+		return
+	}
+	if err = v.AllocationAndRetentionPriority.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "allocationAndRetentionPriority")
+		return
+	}
+	if optflag&(1<<3) != 0 {
+		v.GBRQosInformation = new(GBRQosInformation)
+		if err = v.GBRQosInformation.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "gBR-QosInformation")
+			return
+		}
+	}
+	if optflag&(1<<2) != 0 {
+		v.ReflectiveQosAttribute = new(ReflectiveQosAttribute)
+		if err = v.ReflectiveQosAttribute.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "reflectiveQosAttribute")
+			return
+		}
+	}
+	if optflag&(1<<1) != 0 {
+		v.AdditionalQosFlowInformation = new(AdditionalQosFlowInformation)
+		if err = v.AdditionalQosFlowInformation.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "additionalQosFlowInformation")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// QosFlowList is QosFlowList in NGAP-IEs.
+type QosFlowList []QosFlowItem
+
+func (v QosFlowList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofQosFlows, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *QosFlowList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofQosFlows, false)
+	if err != nil {
+		return
+	}
+	*v = make(QosFlowList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// QosFlowModifyConfirmItem is QosFlowModifyConfirmItem in NGAP-IEs.
+type QosFlowModifyConfirmItem struct {
+	QosFlowIdentifier QosFlowIdentifier
+	IEExtensions      *ProtocolExtensionContainer
+}
+
+func (v QosFlowModifyConfirmItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.QosFlowIdentifier.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "qosFlowIdentifier")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *QosFlowModifyConfirmItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = QosFlowModifyConfirmItem{}
+	if err = v.QosFlowIdentifier.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "qosFlowIdentifier")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// QosFlowModifyConfirmList is QosFlowModifyConfirmList in NGAP-IEs.
+type QosFlowModifyConfirmList []QosFlowModifyConfirmItem
+
+func (v QosFlowModifyConfirmList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofQosFlows, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *QosFlowModifyConfirmList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofQosFlows, false)
+	if err != nil {
+		return
+	}
+	*v = make(QosFlowModifyConfirmList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// QosFlowNotifyItem is QosFlowNotifyItem in NGAP-IEs.
+type QosFlowNotifyItem struct {
+	QosFlowIdentifier QosFlowIdentifier
+	NotificationCause NotificationCause
+	IEExtensions      *ProtocolExtensionContainer
+}
+
+func (v QosFlowNotifyItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.QosFlowIdentifier.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "qosFlowIdentifier")
+		return
+	}
+	if err = v.NotificationCause.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "notificationCause")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *QosFlowNotifyItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = QosFlowNotifyItem{}
+	if err = v.QosFlowIdentifier.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "qosFlowIdentifier")
+		return
+	}
+	if err = v.NotificationCause.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "notificationCause")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// QosFlowNotifyList is QosFlowNotifyList in NGAP-IEs.
+type QosFlowNotifyList []QosFlowNotifyItem
+
+func (v QosFlowNotifyList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofQosFlows, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *QosFlowNotifyList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofQosFlows, false)
+	if err != nil {
+		return
+	}
+	*v = make(QosFlowNotifyList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// QosFlowPerTNLInformation is QosFlowPerTNLInformation in NGAP-IEs.
+type QosFlowPerTNLInformation struct {
+	UPTransportLayerInformation UPTransportLayerInformation
+	AssociatedQosFlowList       AssociatedQosFlowList
+	IEExtensions                *ProtocolExtensionContainer
+}
+
+func (v QosFlowPerTNLInformation) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.UPTransportLayerInformation.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "uPTransportLayerInformation")
+		return
+	}
+	if err = v.AssociatedQosFlowList.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "associatedQosFlowList")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *QosFlowPerTNLInformation) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = QosFlowPerTNLInformation{}
+	if err = v.UPTransportLayerInformation.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "uPTransportLayerInformation")
+		return
+	}
+	if err = v.AssociatedQosFlowList.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "associatedQosFlowList")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// QosFlowPerTNLInformationItem is QosFlowPerTNLInformationItem in NGAP-IEs.
+type QosFlowPerTNLInformationItem struct {
+	QosFlowPerTNLInformation QosFlowPerTNLInformation
+	IEExtensions             *ProtocolExtensionContainer
+}
+
+func (v QosFlowPerTNLInformationItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.QosFlowPerTNLInformation.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "qosFlowPerTNLInformation")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *QosFlowPerTNLInformationItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = QosFlowPerTNLInformationItem{}
+	if err = v.QosFlowPerTNLInformation.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "qosFlowPerTNLInformation")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// QosFlowPerTNLInformationList is QosFlowPerTNLInformationList in NGAP-IEs.
+type QosFlowPerTNLInformationList []QosFlowPerTNLInformationItem
+
+func (v QosFlowPerTNLInformationList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofMultiConnectivityMinusOne, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *QosFlowPerTNLInformationList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofMultiConnectivityMinusOne, false)
+	if err != nil {
+		return
+	}
+	*v = make(QosFlowPerTNLInformationList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// QosFlowSetupRequestItem is QosFlowSetupRequestItem in NGAP-IEs.
+type QosFlowSetupRequestItem struct {
+	QosFlowIdentifier         QosFlowIdentifier
+	QosFlowLevelQosParameters QosFlowLevelQosParameters
+	ERABID                    *ERABID
+	IEExtensions              *ProtocolExtensionContainer
+}
+
+func (v QosFlowSetupRequestItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.ERABID != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 2, optflag); err != nil {
+		return
+	}
+	if err = v.QosFlowIdentifier.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "qosFlowIdentifier")
+		return
+	}
+	if err = v.QosFlowLevelQosParameters.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "qosFlowLevelQosParameters")
+		return
+	}
+	if v.ERABID != nil {
+		if err = v.ERABID.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "e-RAB-ID")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *QosFlowSetupRequestItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 2)
+	if err != nil {
+		return
+	}
+	*v = QosFlowSetupRequestItem{}
+	if err = v.QosFlowIdentifier.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "qosFlowIdentifier")
+		return
+	}
+	if err = v.QosFlowLevelQosParameters.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "qosFlowLevelQosParameters")
+		return
+	}
+	if optflag&(1<<1) != 0 {
+		v.ERABID = new(ERABID)
+		if err = v.ERABID.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "e-RAB-ID")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// QosFlowSetupRequestList is QosFlowSetupRequestList in NGAP-IEs.
+type QosFlowSetupRequestList []QosFlowSetupRequestItem
+
+func (v QosFlowSetupRequestList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofQosFlows, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *QosFlowSetupRequestList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofQosFlows, false)
+	if err != nil {
+		return
+	}
+	*v = make(QosFlowSetupRequestList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// RANNodeName is RANNodeName in NGAP-IEs.
+type RANNodeName string
+
+func (v RANNodeName) MarshalPER(w *per.BitWriter) error {
+	return w.EncPrintableString(string(v), "", 1, 150, true)
+}
+
+func (v *RANNodeName) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecPrintableString("", 1, 150, true)
+	if err != nil {
+		return
+	}
+	*v = RANNodeName(x)
+	return
+}
+
+// RANPagingPriority is RANPagingPriority in NGAP-IEs.
+type RANPagingPriority int
+
+func (v RANPagingPriority) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 1, 256, false)
+}
+
+func (v *RANPagingPriority) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(1, 256, false)
+	if err != nil {
+		return
+	}
+	*v = RANPagingPriority(x)
+	return
+}
+
+// RANUENGAPID is RAN-UE-NGAP-ID in NGAP-IEs.
+type RANUENGAPID int
+
+func (v RANUENGAPID) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 0, 4294967295, false)
+}
+
+func (v *RANUENGAPID) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(0, 4294967295, false)
+	if err != nil {
+		return
+	}
+	*v = RANUENGAPID(x)
+	return
+}
+
+// RATRestrictionInformation is RATRestrictionInformation in NGAP-IEs.
+type RATRestrictionInformation per.BitString
+
+func (v RATRestrictionInformation) MarshalPER(w *per.BitWriter) error {
+	return w.EncBitString(v.Bytes, v.BitLength, 8, 8, true)
+}
+
+func (v *RATRestrictionInformation) UnmarshalPER(r *per.BitReader) (err error) {
+	v.Bytes, v.BitLength, err = r.DecBitString(8, 8, true)
+	return
+}
+
+// RATRestrictions is RATRestrictions in NGAP-IEs.
+type RATRestrictions []RATRestrictionsItem
+
+func (v RATRestrictions) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofEPLMNsPlusOne, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *RATRestrictions) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofEPLMNsPlusOne, false)
+	if err != nil {
+		return
+	}
+	*v = make(RATRestrictions, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// RATRestrictionsItem is RATRestrictions-Item in NGAP-IEs.
+type RATRestrictionsItem struct {
+	PLMNIdentity              PLMNIdentity
+	RATRestrictionInformation RATRestrictionInformation
+	IEExtensions              *ProtocolExtensionContainer
+}
+
+func (v RATRestrictionsItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PLMNIdentity.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.RATRestrictionInformation.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "rATRestrictionInformation")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *RATRestrictionsItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = RATRestrictionsItem{}
+	if err = v.PLMNIdentity.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.RATRestrictionInformation.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "rATRestrictionInformation")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// RecommendedCellItem is RecommendedCellItem in NGAP-IEs.
+type RecommendedCellItem struct {
+	NGRANCGI         NGRANCGI
+	TimeStayedInCell *int
+	IEExtensions     *ProtocolExtensionContainer
+}
+
+func (v RecommendedCellItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.TimeStayedInCell != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 2, optflag); err != nil {
+		return
+	}
+	if err = v.NGRANCGI.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "nGRAN-CGI")
+		return
+	}
+	if v.TimeStayedInCell != nil {
+		if err = w.EncInteger(*v.TimeStayedInCell, 0, 4095, false); err != nil {
+			err = per.WithPath(err, "timeStayedInCell")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *RecommendedCellItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 2)
+	if err != nil {
+		return
+	}
+	*v = RecommendedCellItem{}
+	if err = v.NGRANCGI.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "nGRAN-CGI")
+		return
+	}
+	if optflag&(1<<1) != 0 {
+		v.TimeStayedInCell = new(int)
+		if *v.TimeStayedInCell, err = r.DecInteger(0, 4095, false); err != nil {
+			err = per.WithPath(err, "timeStayedInCell")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// RecommendedCellList is RecommendedCellList in NGAP-IEs.
+type RecommendedCellList []RecommendedCellItem
+
+func (v RecommendedCellList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofRecommendedCells, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *RecommendedCellList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofRecommendedCells, false)
+	if err != nil {
+		return
+	}
+	*v = make(RecommendedCellList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// RecommendedCellsForPaging is RecommendedCellsForPaging in NGAP-IEs.
+type RecommendedCellsForPaging struct {
+	RecommendedCellList RecommendedCellList
+	IEExtensions        *ProtocolExtensionContainer
+}
+
+func (v RecommendedCellsForPaging) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.RecommendedCellList.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "recommendedCellList")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *RecommendedCellsForPaging) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = RecommendedCellsForPaging{}
+	if err = v.RecommendedCellList.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "recommendedCellList")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// RecommendedRANNodeItem is RecommendedRANNodeItem in NGAP-IEs.
+type RecommendedRANNodeItem struct {
+	AMFPagingTarget AMFPagingTarget
+	IEExtensions    *ProtocolExtensionContainer
+}
+
+func (v RecommendedRANNodeItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.AMFPagingTarget.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "aMFPagingTarget")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *RecommendedRANNodeItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = RecommendedRANNodeItem{}
+	if err = v.AMFPagingTarget.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "aMFPagingTarget")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// RecommendedRANNodeList is RecommendedRANNodeList in NGAP-IEs.
+type RecommendedRANNodeList []RecommendedRANNodeItem
+
+func (v RecommendedRANNodeList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofRecommendedRANNodes, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *RecommendedRANNodeList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofRecommendedRANNodes, false)
+	if err != nil {
+		return
+	}
+	*v = make(RecommendedRANNodeList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// RecommendedRANNodesForPaging is RecommendedRANNodesForPaging in NGAP-IEs.
+type RecommendedRANNodesForPaging struct {
+	RecommendedRANNodeList RecommendedRANNodeList
+	IEExtensions           *ProtocolExtensionContainer
+}
+
+func (v RecommendedRANNodesForPaging) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.RecommendedRANNodeList.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "recommendedRANNodeList")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *RecommendedRANNodesForPaging) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = RecommendedRANNodesForPaging{}
+	if err = v.RecommendedRANNodeList.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "recommendedRANNodeList")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// RedirectionVoiceFallback is RedirectionVoiceFallback in NGAP-IEs.
+type RedirectionVoiceFallback int
+
+const (
+	RedirectionVoiceFallbackPossible RedirectionVoiceFallback = iota
+	RedirectionVoiceFallbackNotPossible
+)
+
+func (v RedirectionVoiceFallback) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 1, true)
+}
+
+func (v *RedirectionVoiceFallback) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 1, true)
+	*v = RedirectionVoiceFallback(x)
+	return
+}
+
+// ReflectiveQosAttribute is ReflectiveQosAttribute in NGAP-IEs.
+type ReflectiveQosAttribute int
+
+const (
+	ReflectiveQosAttributeSubjectTo ReflectiveQosAttribute = iota
+)
+
+func (v ReflectiveQosAttribute) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 0, true)
+}
+
+func (v *ReflectiveQosAttribute) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 0, true)
+	*v = ReflectiveQosAttribute(x)
+	return
+}
+
+// RelativeAMFCapacity is RelativeAMFCapacity in NGAP-IEs.
+type RelativeAMFCapacity int
+
+func (v RelativeAMFCapacity) MarshalPER(w *per.BitWriter) error {
+	return w.EncInteger(int(v), 0, 255, false)
+}
+
+func (v *RelativeAMFCapacity) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecInteger(0, 255, false)
+	if err != nil {
+		return
+	}
+	*v = RelativeAMFCapacity(x)
+	return
+}
+
+// ResetAll is ResetAll in NGAP-IEs.
+type ResetAll int
+
+const (
+	ResetAllResetAll ResetAll = iota
+)
+
+func (v ResetAll) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 0, true)
+}
+
+func (v *ResetAll) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 0, true)
+	*v = ResetAll(x)
+	return
+}
+
+// ResetType is ResetType in NGAP-IEs.
+type ResetType struct {
+	NGInterface       *ResetAll
+	PartOfNGInterface *UEAssociatedLogicalNGConnectionList
+	ChoiceExtensions  *ProtocolIESingleContainer
+}
+
+func (v ResetType) MarshalPER(w *per.BitWriter) (err error) {
+	chosen := 0
+	if v.NGInterface != nil {
+		chosen++
+	}
+	if v.PartOfNGInterface != nil {
+		chosen++
+	}
+	if v.ChoiceExtensions != nil {
+		chosen++
+	}
+	if chosen > 1 {
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "more than one alternative is chosen"}
+		return
+	}
+	switch {
+	case v.NGInterface != nil:
+		if err = w.EncChoice(0, 0, 2, false); err != nil {
+			return
+		}
+		if err = v.NGInterface.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "nG-Interface")
+			return
+		}
+	case v.PartOfNGInterface != nil:
+		if err = w.EncChoice(1, 0, 2, false); err != nil {
+			return
+		}
+		if err = v.PartOfNGInterface.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "partOfNG-Interface")
+			return
+		}
+	case v.ChoiceExtensions != nil:
+		if err = w.EncChoice(2, 0, 2, false); err != nil {
+			return
+		}
+		if err = v.ChoiceExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	default:
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "no alternative is chosen"}
+	}
+	return
+}
+
+func (v *ResetType) UnmarshalPER(r *per.BitReader) (err error) {
+	index, err := r.DecChoice(0, 2, false)
+	if err != nil {
+		return
+	}
+	*v = ResetType{}
+	switch index {
+	case 0:
+		v.NGInterface = new(ResetAll)
+		if err = v.NGInterface.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "nG-Interface")
+			return
+		}
+	case 1:
+		v.PartOfNGInterface = new(UEAssociatedLogicalNGConnectionList)
+		if err = v.PartOfNGInterface.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "partOfNG-Interface")
+			return
+		}
+	case 2:
+		v.ChoiceExtensions = new(ProtocolIESingleContainer)
+		if err = v.ChoiceExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	}
+	return
+}
+
+// RRCEstablishmentCause is RRCEstablishmentCause in NGAP-IEs.
+type RRCEstablishmentCause int
+
+const (
+	RRCEstablishmentCauseEmergency RRCEstablishmentCause = iota
+	RRCEstablishmentCauseHighPriorityAccess
+	RRCEstablishmentCauseMtAccess
+	RRCEstablishmentCauseMoSignalling
+	RRCEstablishmentCauseMoData
+	RRCEstablishmentCauseMoVoiceCall
+	RRCEstablishmentCauseMoVideoCall
+	RRCEstablishmentCauseMoSMS
+	RRCEstablishmentCauseMpsPriorityAccess
+	RRCEstablishmentCauseMcsPriorityAccess
+	RRCEstablishmentCauseNotAvailable
+)
+
+func (v RRCEstablishmentCause) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 9, true)
+}
+
+func (v *RRCEstablishmentCause) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 9, true)
+	*v = RRCEstablishmentCause(x)
+	return
+}
+
+// RRCInactiveTransitionReportRequest is RRCInactiveTransitionReportRequest in NGAP-IEs.
+type RRCInactiveTransitionReportRequest int
+
+const (
+	RRCInactiveTransitionReportRequestSubsequentStateTransitionReport RRCInactiveTransitionReportRequest = iota
+	RRCInactiveTransitionReportRequestSingleRrcConnectedStateReport
+	RRCInactiveTransitionReportRequestCancelReport
+)
+
+func (v RRCInactiveTransitionReportRequest) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 2, true)
+}
+
+func (v *RRCInactiveTransitionReportRequest) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 2, true)
+	*v = RRCInactiveTransitionReportRequest(x)
+	return
+}
+
+// SD is SD in NGAP-IEs.
+type SD []byte
+
+func (v SD) MarshalPER(w *per.BitWriter) error {
+	return w.EncOctetString(v, 3, 3, false)
+}
+
+func (v *SD) UnmarshalPER(r *per.BitReader) (err error) {
+	*v, err = r.DecOctetString(3, 3, false)
+	return
+}
+
+// SecurityIndication is SecurityIndication in NGAP-IEs.
+type SecurityIndication struct {
+	IntegrityProtectionIndication       IntegrityProtectionIndication
+	ConfidentialityProtectionIndication ConfidentialityProtectionIndication
+	MaximumIntegrityProtectedDataRate   *MaximumIntegrityProtectedDataRate
+	IEExtensions                        *ProtocolExtensionContainer
+}
+
+func (v SecurityIndication) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.MaximumIntegrityProtectedDataRate != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 2, optflag); err != nil {
+		return
+	}
+	if err = v.IntegrityProtectionIndication.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "integrityProtectionIndication")
+		return
+	}
+	if err = v.ConfidentialityProtectionIndication.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "confidentialityProtectionIndication")
+		return
+	}
+	if v.MaximumIntegrityProtectedDataRate != nil {
+		if err = v.MaximumIntegrityProtectedDataRate.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "maximumIntegrityProtectedDataRate")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *SecurityIndication) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 2)
+	if err != nil {
+		return
+	}
+	*v = SecurityIndication{}
+	if err = v.IntegrityProtectionIndication.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "integrityProtectionIndication")
+		return
+	}
+	if err = v.ConfidentialityProtectionIndication.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "confidentialityProtectionIndication")
+		return
+	}
+	if optflag&(1<<1) != 0 {
+		v.MaximumIntegrityProtectedDataRate = new(MaximumIntegrityProtectedDataRate)
+		if err = v.MaximumIntegrityProtectedDataRate.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "maximumIntegrityProtectedDataRate")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// SecurityKey is SecurityKey in NGAP-IEs.
+type SecurityKey per.BitString
+
+func (v SecurityKey) MarshalPER(w *per.BitWriter) error {
+	return w.EncBitString(v.Bytes, v.BitLength, 256, 256, false)
+}
+
+func (v *SecurityKey) UnmarshalPER(r *per.BitReader) (err error) {
+	v.Bytes, v.BitLength, err = r.DecBitString(256, 256, false)
+	return
+}
+
+// SecurityResult is SecurityResult in NGAP-IEs.
+type SecurityResult struct {
+	IntegrityProtectionResult       IntegrityProtectionResult
+	ConfidentialityProtectionResult ConfidentialityProtectionResult
+	IEExtensions                    *ProtocolExtensionContainer
+}
+
+func (v SecurityResult) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.IntegrityProtectionResult.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "integrityProtectionResult")
+		return
+	}
+	if err = v.ConfidentialityProtectionResult.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "confidentialityProtectionResult")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *SecurityResult) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = SecurityResult{}
+	if err = v.IntegrityProtectionResult.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "integrityProtectionResult")
+		return
+	}
+	if err = v.ConfidentialityProtectionResult.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "confidentialityProtectionResult")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// ServedGUAMIItem is ServedGUAMIItem in NGAP-IEs.
+type ServedGUAMIItem struct {
+	GUAMI         GUAMI
+	BackupAMFName *AMFName
+	IEExtensions  *ProtocolExtensionContainer
+}
+
+func (v ServedGUAMIItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.BackupAMFName != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 2, optflag); err != nil {
+		return
+	}
+	if err = v.GUAMI.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "gUAMI")
+		return
+	}
+	if v.BackupAMFName != nil {
+		if err = v.BackupAMFName.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "backupAMFName")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *ServedGUAMIItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 2)
+	if err != nil {
+		return
+	}
+	*v = ServedGUAMIItem{}
+	if err = v.GUAMI.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "gUAMI")
+		return
+	}
+	if optflag&(1<<1) != 0 {
+		v.BackupAMFName = new(AMFName)
+		if err = v.BackupAMFName.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "backupAMFName")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// ServedGUAMIList is ServedGUAMIList in NGAP-IEs.
+type ServedGUAMIList []ServedGUAMIItem
+
+func (v ServedGUAMIList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofServedGUAMIs, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *ServedGUAMIList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofServedGUAMIs, false)
+	if err != nil {
+		return
+	}
+	*v = make(ServedGUAMIList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// ServiceAreaInformation is ServiceAreaInformation in NGAP-IEs.
+type ServiceAreaInformation []ServiceAreaInformationItem
+
+func (v ServiceAreaInformation) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofEPLMNsPlusOne, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *ServiceAreaInformation) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofEPLMNsPlusOne, false)
+	if err != nil {
+		return
+	}
+	*v = make(ServiceAreaInformation, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// ServiceAreaInformationItem is ServiceAreaInformation-Item in NGAP-IEs.
+type ServiceAreaInformationItem struct {
+	PLMNIdentity   PLMNIdentity
+	AllowedTACs    *AllowedTACs
+	NotAllowedTACs *NotAllowedTACs
+	IEExtensions   *ProtocolExtensionContainer
+}
+
+func (v ServiceAreaInformationItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.AllowedTACs != nil {
+		optflag |= 1 << 2
+	}
+	if v.NotAllowedTACs != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 3, optflag); err != nil {
+		return
+	}
+	if err = v.PLMNIdentity.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if v.AllowedTACs != nil {
+		if err = v.AllowedTACs.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "allowedTACs")
+			return
+		}
+	}
+	if v.NotAllowedTACs != nil {
+		if err = v.NotAllowedTACs.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "notAllowedTACs")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *ServiceAreaInformationItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 3)
+	if err != nil {
+		return
+	}
+	*v = ServiceAreaInformationItem{}
+	if err = v.PLMNIdentity.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if optflag&(1<<2) != 0 {
+		v.AllowedTACs = new(AllowedTACs)
+		if err = v.AllowedTACs.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "allowedTACs")
+			return
+		}
+	}
+	if optflag&(1<<1) != 0 {
+		v.NotAllowedTACs = new(NotAllowedTACs)
+		if err = v.NotAllowedTACs.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "notAllowedTACs")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// SliceSupportItem is SliceSupportItem in NGAP-IEs.
+type SliceSupportItem struct {
+	SNSSAI       SNSSAI
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v SliceSupportItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.SNSSAI.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "s-NSSAI")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *SliceSupportItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = SliceSupportItem{}
+	if err = v.SNSSAI.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "s-NSSAI")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// SliceSupportList is SliceSupportList in NGAP-IEs.
+type SliceSupportList []SliceSupportItem
+
+func (v SliceSupportList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofSliceItems, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *SliceSupportList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofSliceItems, false)
+	if err != nil {
+		return
+	}
+	*v = make(SliceSupportList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// SNSSAI is S-NSSAI in NGAP-IEs.
+type SNSSAI struct {
+	SST          SST
+	SD           *SD
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v SNSSAI) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.SD != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 2, optflag); err != nil {
+		return
+	}
+	if err = v.SST.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "sST")
+		return
+	}
+	if v.SD != nil {
+		if err = v.SD.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "sD")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *SNSSAI) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 2)
+	if err != nil {
+		return
+	}
+	*v = SNSSAI{}
+	if err = v.SST.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "sST")
+		return
+	}
+	if optflag&(1<<1) != 0 {
+		v.SD = new(SD)
+		if err = v.SD.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "sD")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// SourceOfUEActivityBehaviourInformation is SourceOfUEActivityBehaviourInformation in NGAP-IEs.
+type SourceOfUEActivityBehaviourInformation int
+
+const (
+	SourceOfUEActivityBehaviourInformationSubscriptionInformation SourceOfUEActivityBehaviourInformation = iota
+	SourceOfUEActivityBehaviourInformationStatistics
+)
+
+func (v SourceOfUEActivityBehaviourInformation) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 1, true)
+}
+
+func (v *SourceOfUEActivityBehaviourInformation) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 1, true)
+	*v = SourceOfUEActivityBehaviourInformation(x)
+	return
+}
+
+// SST is SST in NGAP-IEs.
+type SST []byte
+
+func (v SST) MarshalPER(w *per.BitWriter) error {
+	return w.EncOctetString(v, 1, 1, false)
+}
+
+func (v *SST) UnmarshalPER(r *per.BitReader) (err error) {
+	*v, err = r.DecOctetString(1, 1, false)
+	return
+}
+
+// SupportedTAItem is SupportedTAItem in NGAP-IEs.
+type SupportedTAItem struct {
+	TAC               TAC
+	BroadcastPLMNList BroadcastPLMNList
+	IEExtensions      *ProtocolExtensionContainer
+}
+
+func (v SupportedTAItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.TAC.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "tAC")
+		return
+	}
+	if err = v.BroadcastPLMNList.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "broadcastPLMNList")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *SupportedTAItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = SupportedTAItem{}
+	if err = v.TAC.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "tAC")
+		return
+	}
+	if err = v.BroadcastPLMNList.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "broadcastPLMNList")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// SupportedTAList is SupportedTAList in NGAP-IEs.
+type SupportedTAList []SupportedTAItem
+
+func (v SupportedTAList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofTACs, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *SupportedTAList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofTACs, false)
+	if err != nil {
+		return
+	}
+	*v = make(SupportedTAList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// TAC is TAC in NGAP-IEs.
+type TAC []byte
+
+func (v TAC) MarshalPER(w *per.BitWriter) error {
+	return w.EncOctetString(v, 3, 3, false)
+}
+
+func (v *TAC) UnmarshalPER(r *per.BitReader) (err error) {
+	*v, err = r.DecOctetString(3, 3, false)
+	return
+}
+
+// TAI is TAI in NGAP-IEs.
+type TAI struct {
+	PLMNIdentity PLMNIdentity
+	TAC          TAC
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v TAI) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.PLMNIdentity.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.TAC.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "tAC")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *TAI) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = TAI{}
+	if err = v.PLMNIdentity.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if err = v.TAC.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "tAC")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// TAIListForInactive is TAIListForInactive in NGAP-IEs.
+type TAIListForInactive []TAIListForInactiveItem
+
+func (v TAIListForInactive) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofTAIforInactive, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *TAIListForInactive) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofTAIforInactive, false)
+	if err != nil {
+		return
+	}
+	*v = make(TAIListForInactive, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// TAIListForInactiveItem is TAIListForInactive-Item in NGAP-IEs.
+type TAIListForInactiveItem struct {
+	TAI          TAI
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v TAIListForInactiveItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.TAI.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "tAI")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *TAIListForInactiveItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = TAIListForInactiveItem{}
+	if err = v.TAI.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "tAI")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// TAIListForPaging is TAIListForPaging in NGAP-IEs.
+type TAIListForPaging []TAIListForPagingItem
+
+func (v TAIListForPaging) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofTAIforPaging, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *TAIListForPaging) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofTAIforPaging, false)
+	if err != nil {
+		return
+	}
+	*v = make(TAIListForPaging, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// TAIListForPagingItem is TAIListForPagingItem in NGAP-IEs.
+type TAIListForPagingItem struct {
+	TAI          TAI
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v TAIListForPagingItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.TAI.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "tAI")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *TAIListForPagingItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = TAIListForPagingItem{}
+	if err = v.TAI.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "tAI")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// TimeStamp is TimeStamp in NGAP-IEs.
+type TimeStamp []byte
+
+func (v TimeStamp) MarshalPER(w *per.BitWriter) error {
+	return w.EncOctetString(v, 4, 4, false)
+}
+
+func (v *TimeStamp) UnmarshalPER(r *per.BitReader) (err error) {
+	*v, err = r.DecOctetString(4, 4, false)
+	return
+}
+
+// TimeToWait is TimeToWait in NGAP-IEs.
+type TimeToWait int
+
+const (
+	TimeToWaitV1s TimeToWait = iota
+	TimeToWaitV2s
+	TimeToWaitV5s
+	TimeToWaitV10s
+	TimeToWaitV20s
+	TimeToWaitV60s
+)
+
+func (v TimeToWait) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 5, true)
+}
+
+func (v *TimeToWait) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 5, true)
+	*v = TimeToWait(x)
+	return
+}
+
+// TraceActivation is TraceActivation in NGAP-IEs.
+type TraceActivation struct {
+	NGRANTraceID                   NGRANTraceID
+	InterfacesToTrace              InterfacesToTrace
+	TraceDepth                     TraceDepth
+	TraceCollectionEntityIPAddress TransportLayerAddress
+	IEExtensions                   *ProtocolExtensionContainer
+}
+
+func (v TraceActivation) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.NGRANTraceID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "nGRANTraceID")
+		return
+	}
+	if err = v.InterfacesToTrace.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "interfacesToTrace")
+		return
+	}
+	if err = v.TraceDepth.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "traceDepth")
+		return
+	}
+	if err = v.TraceCollectionEntityIPAddress.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "traceCollectionEntityIPAddress")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *TraceActivation) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = TraceActivation{}
+	if err = v.NGRANTraceID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "nGRANTraceID")
+		return
+	}
+	if err = v.InterfacesToTrace.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "interfacesToTrace")
+		return
+	}
+	if err = v.TraceDepth.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "traceDepth")
+		return
+	}
+	if err = v.TraceCollectionEntityIPAddress.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "traceCollectionEntityIPAddress")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// TraceDepth is TraceDepth in NGAP-IEs.
+type TraceDepth int
+
+const (
+	TraceDepthMinimum TraceDepth = iota
+	TraceDepthMedium
+	TraceDepthMaximum
+	TraceDepthMinimumWithoutVendorSpecificExtension
+	TraceDepthMediumWithoutVendorSpecificExtension
+	TraceDepthMaximumWithoutVendorSpecificExtension
+)
+
+func (v TraceDepth) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 5, true)
+}
+
+func (v *TraceDepth) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 5, true)
+	*v = TraceDepth(x)
+	return
+}
+
+// TransportLayerAddress is TransportLayerAddress in NGAP-IEs.
+type TransportLayerAddress per.BitString
+
+func (v TransportLayerAddress) MarshalPER(w *per.BitWriter) error {
+	return w.EncBitString(v.Bytes, v.BitLength, 1, 160, true)
+}
+
+func (v *TransportLayerAddress) UnmarshalPER(r *per.BitReader) (err error) {
+	v.Bytes, v.BitLength, err = r.DecBitString(1, 160, true)
+	return
+}
+
+// TypeOfError is TypeOfError in NGAP-IEs.
+type TypeOfError int
+
+const (
+	TypeOfErrorNotUnderstood TypeOfError = iota
+	TypeOfErrorMissing
+)
+
+func (v TypeOfError) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 1, true)
+}
+
+func (v *TypeOfError) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 1, true)
+	*v = TypeOfError(x)
+	return
+}
+
+// UEAggregateMaximumBitRate is UEAggregateMaximumBitRate in NGAP-IEs.
+type UEAggregateMaximumBitRate struct {
+	UEAggregateMaximumBitRateDL BitRate
+	UEAggregateMaximumBitRateUL BitRate
+	IEExtensions                *ProtocolExtensionContainer
+}
+
+func (v UEAggregateMaximumBitRate) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.UEAggregateMaximumBitRateDL.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "uEAggregateMaximumBitRateDL")
+		return
+	}
+	if err = v.UEAggregateMaximumBitRateUL.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "uEAggregateMaximumBitRateUL")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *UEAggregateMaximumBitRate) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = UEAggregateMaximumBitRate{}
+	if err = v.UEAggregateMaximumBitRateDL.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "uEAggregateMaximumBitRateDL")
+		return
+	}
+	if err = v.UEAggregateMaximumBitRateUL.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "uEAggregateMaximumBitRateUL")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// UEAssociatedLogicalNGConnectionItem is UE-associatedLogicalNG-connectionItem in NGAP-IEs.
+type UEAssociatedLogicalNGConnectionItem struct {
+	AMFUENGAPID  *AMFUENGAPID
+	RANUENGAPID  *RANUENGAPID
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v UEAssociatedLogicalNGConnectionItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.AMFUENGAPID != nil {
+		optflag |= 1 << 2
+	}
+	if v.RANUENGAPID != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 3, optflag); err != nil {
+		return
+	}
+	if v.AMFUENGAPID != nil {
+		if err = v.AMFUENGAPID.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "aMF-UE-NGAP-ID")
+			return
+		}
+	}
+	if v.RANUENGAPID != nil {
+		if err = v.RANUENGAPID.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "rAN-UE-NGAP-ID")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *UEAssociatedLogicalNGConnectionItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 3)
+	if err != nil {
+		return
+	}
+	*v = UEAssociatedLogicalNGConnectionItem{}
+	if optflag&(1<<2) != 0 {
+		v.AMFUENGAPID = new(AMFUENGAPID)
+		if err = v.AMFUENGAPID.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "aMF-UE-NGAP-ID")
+			return
+		}
+	}
+	if optflag&(1<<1) != 0 {
+		v.RANUENGAPID = new(RANUENGAPID)
+		if err = v.RANUENGAPID.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "rAN-UE-NGAP-ID")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// UEAssociatedLogicalNGConnectionList is UE-associatedLogicalNG-connectionList in NGAP-IEs.
+type UEAssociatedLogicalNGConnectionList []UEAssociatedLogicalNGConnectionItem
+
+func (v UEAssociatedLogicalNGConnectionList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofNGConnectionsToReset, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *UEAssociatedLogicalNGConnectionList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofNGConnectionsToReset, false)
+	if err != nil {
+		return
+	}
+	*v = make(UEAssociatedLogicalNGConnectionList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// UEContextRequest is UEContextRequest in NGAP-IEs.
+type UEContextRequest int
+
+const (
+	UEContextRequestRequested UEContextRequest = iota
+)
+
+func (v UEContextRequest) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 0, true)
+}
+
+func (v *UEContextRequest) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 0, true)
+	*v = UEContextRequest(x)
+	return
+}
+
+// UEIdentityIndexValue is UEIdentityIndexValue in NGAP-IEs.
+type UEIdentityIndexValue struct {
+	IndexLength10    *per.BitString
+	ChoiceExtensions *ProtocolIESingleContainer
+}
+
+func (v UEIdentityIndexValue) MarshalPER(w *per.BitWriter) (err error) {
+	chosen := 0
+	if v.IndexLength10 != nil {
+		chosen++
+	}
+	if v.ChoiceExtensions != nil {
+		chosen++
+	}
+	if chosen > 1 {
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "more than one alternative is chosen"}
+		return
+	}
+	switch {
+	case v.IndexLength10 != nil:
+		if err = w.EncChoice(0, 0, 1, false); err != nil {
+			return
+		}
+		if err = w.EncBitString(v.IndexLength10.Bytes, v.IndexLength10.BitLength, 10, 10, false); err != nil {
+			err = per.WithPath(err, "indexLength10")
+			return
+		}
+	case v.ChoiceExtensions != nil:
+		if err = w.EncChoice(1, 0, 1, false); err != nil {
+			return
+		}
+		if err = v.ChoiceExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	default:
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "no alternative is chosen"}
+	}
+	return
+}
+
+func (v *UEIdentityIndexValue) UnmarshalPER(r *per.BitReader) (err error) {
+	index, err := r.DecChoice(0, 1, false)
+	if err != nil {
+		return
+	}
+	*v = UEIdentityIndexValue{}
+	switch index {
+	case 0:
+		v.IndexLength10 = new(per.BitString)
+		if v.IndexLength10.Bytes, v.IndexLength10.BitLength, err = r.DecBitString(10, 10, false); err != nil {
+			err = per.WithPath(err, "indexLength10")
+			return
+		}
+	case 1:
+		v.ChoiceExtensions = new(ProtocolIESingleContainer)
+		if err = v.ChoiceExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	}
+	return
+}
+
+// UENGAPIDPair is UE-NGAP-ID-pair in NGAP-IEs.
+type UENGAPIDPair struct {
+	AMFUENGAPID  AMFUENGAPID
+	RANUENGAPID  RANUENGAPID
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v UENGAPIDPair) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.AMFUENGAPID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "aMF-UE-NGAP-ID")
+		return
+	}
+	if err = v.RANUENGAPID.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "rAN-UE-NGAP-ID")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *UENGAPIDPair) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = UENGAPIDPair{}
+	if err = v.AMFUENGAPID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "aMF-UE-NGAP-ID")
+		return
+	}
+	if err = v.RANUENGAPID.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "rAN-UE-NGAP-ID")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// UENGAPIDs is UE-NGAP-IDs in NGAP-IEs.
+type UENGAPIDs struct {
+	UENGAPIDPair     *UENGAPIDPair
+	AMFUENGAPID      *AMFUENGAPID
+	ChoiceExtensions *ProtocolIESingleContainer
+}
+
+func (v UENGAPIDs) MarshalPER(w *per.BitWriter) (err error) {
+	chosen := 0
+	if v.UENGAPIDPair != nil {
+		chosen++
+	}
+	if v.AMFUENGAPID != nil {
+		chosen++
+	}
+	if v.ChoiceExtensions != nil {
+		chosen++
+	}
+	if chosen > 1 {
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "more than one alternative is chosen"}
+		return
+	}
+	switch {
+	case v.UENGAPIDPair != nil:
+		if err = w.EncChoice(0, 0, 2, false); err != nil {
+			return
+		}
+		if err = v.UENGAPIDPair.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "uE-NGAP-ID-pair")
+			return
+		}
+	case v.AMFUENGAPID != nil:
+		if err = w.EncChoice(1, 0, 2, false); err != nil {
+			return
+		}
+		if err = v.AMFUENGAPID.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "aMF-UE-NGAP-ID")
+			return
+		}
+	case v.ChoiceExtensions != nil:
+		if err = w.EncChoice(2, 0, 2, false); err != nil {
+			return
+		}
+		if err = v.ChoiceExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	default:
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "no alternative is chosen"}
+	}
+	return
+}
+
+func (v *UENGAPIDs) UnmarshalPER(r *per.BitReader) (err error) {
+	index, err := r.DecChoice(0, 2, false)
+	if err != nil {
+		return
+	}
+	*v = UENGAPIDs{}
+	switch index {
+	case 0:
+		v.UENGAPIDPair = new(UENGAPIDPair)
+		if err = v.UENGAPIDPair.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "uE-NGAP-ID-pair")
+			return
+		}
+	case 1:
+		v.AMFUENGAPID = new(AMFUENGAPID)
+		if err = v.AMFUENGAPID.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "aMF-UE-NGAP-ID")
+			return
+		}
+	case 2:
+		v.ChoiceExtensions = new(ProtocolIESingleContainer)
+		if err = v.ChoiceExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	}
+	return
+}
+
+// UEPagingIdentity is UEPagingIdentity in NGAP-IEs.
+type UEPagingIdentity struct {
+	FiveGSTMSI       *FiveGSTMSI
+	ChoiceExtensions *ProtocolIESingleContainer
+}
+
+func (v UEPagingIdentity) MarshalPER(w *per.BitWriter) (err error) {
+	chosen := 0
+	if v.FiveGSTMSI != nil {
+		chosen++
+	}
+	if v.ChoiceExtensions != nil {
+		chosen++
+	}
+	if chosen > 1 {
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "more than one alternative is chosen"}
+		return
+	}
+	switch {
+	case v.FiveGSTMSI != nil:
+		if err = w.EncChoice(0, 0, 1, false); err != nil {
+			return
+		}
+		if err = v.FiveGSTMSI.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "fiveG-S-TMSI")
+			return
+		}
+	case v.ChoiceExtensions != nil:
+		if err = w.EncChoice(1, 0, 1, false); err != nil {
+			return
+		}
+		if err = v.ChoiceExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	default:
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "no alternative is chosen"}
+	}
+	return
+}
+
+func (v *UEPagingIdentity) UnmarshalPER(r *per.BitReader) (err error) {
+	index, err := r.DecChoice(0, 1, false)
+	if err != nil {
+		return
+	}
+	*v = UEPagingIdentity{}
+	switch index {
+	case 0:
+		v.FiveGSTMSI = new(FiveGSTMSI)
+		if err = v.FiveGSTMSI.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "fiveG-S-TMSI")
+			return
+		}
+	case 1:
+		v.ChoiceExtensions = new(ProtocolIESingleContainer)
+		if err = v.ChoiceExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	}
+	return
+}
+
+// UERadioCapability is UERadioCapability in NGAP-IEs.
+type UERadioCapability []byte
+
+func (v UERadioCapability) MarshalPER(w *per.BitWriter) error {
+	return w.EncOctetString(v, 0, per.NoUpperBound, false)
+}
+
+func (v *UERadioCapability) UnmarshalPER(r *per.BitReader) (err error) {
+	*v, err = r.DecOctetString(0, per.NoUpperBound, false)
+	return
+}
+
+// UERadioCapabilityForPaging is UERadioCapabilityForPaging in NGAP-IEs.
+type UERadioCapabilityForPaging struct {
+	UERadioCapabilityForPagingOfNR    *UERadioCapabilityForPagingOfNR
+	UERadioCapabilityForPagingOfEUTRA *UERadioCapabilityForPagingOfEUTRA
+	IEExtensions                      *ProtocolExtensionContainer
+}
+
+func (v UERadioCapabilityForPaging) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.UERadioCapabilityForPagingOfNR != nil {
+		optflag |= 1 << 2
+	}
+	if v.UERadioCapabilityForPagingOfEUTRA != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 3, optflag); err != nil {
+		return
+	}
+	if v.UERadioCapabilityForPagingOfNR != nil {
+		if err = v.UERadioCapabilityForPagingOfNR.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "uERadioCapabilityForPagingOfNR")
+			return
+		}
+	}
+	if v.UERadioCapabilityForPagingOfEUTRA != nil {
+		if err = v.UERadioCapabilityForPagingOfEUTRA.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "uERadioCapabilityForPagingOfEUTRA")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *UERadioCapabilityForPaging) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 3)
+	if err != nil {
+		return
+	}
+	*v = UERadioCapabilityForPaging{}
+	if optflag&(1<<2) != 0 {
+		v.UERadioCapabilityForPagingOfNR = new(UERadioCapabilityForPagingOfNR)
+		if err = v.UERadioCapabilityForPagingOfNR.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "uERadioCapabilityForPagingOfNR")
+			return
+		}
+	}
+	if optflag&(1<<1) != 0 {
+		v.UERadioCapabilityForPagingOfEUTRA = new(UERadioCapabilityForPagingOfEUTRA)
+		if err = v.UERadioCapabilityForPagingOfEUTRA.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "uERadioCapabilityForPagingOfEUTRA")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// UERadioCapabilityForPagingOfEUTRA is UERadioCapabilityForPagingOfEUTRA in NGAP-IEs.
+type UERadioCapabilityForPagingOfEUTRA []byte
+
+func (v UERadioCapabilityForPagingOfEUTRA) MarshalPER(w *per.BitWriter) error {
+	return w.EncOctetString(v, 0, per.NoUpperBound, false)
+}
+
+func (v *UERadioCapabilityForPagingOfEUTRA) UnmarshalPER(r *per.BitReader) (err error) {
+	*v, err = r.DecOctetString(0, per.NoUpperBound, false)
+	return
+}
+
+// UERadioCapabilityForPagingOfNR is UERadioCapabilityForPagingOfNR in NGAP-IEs.
+type UERadioCapabilityForPagingOfNR []byte
+
+func (v UERadioCapabilityForPagingOfNR) MarshalPER(w *per.BitWriter) error {
+	return w.EncOctetString(v, 0, per.NoUpperBound, false)
+}
+
+func (v *UERadioCapabilityForPagingOfNR) UnmarshalPER(r *per.BitReader) (err error) {
+	*v, err = r.DecOctetString(0, per.NoUpperBound, false)
+	return
+}
+
+// UERetentionInformation is UERetentionInformation in NGAP-IEs.
+type UERetentionInformation int
+
+const (
+	UERetentionInformationUesRetained UERetentionInformation = iota
+)
+
+func (v UERetentionInformation) MarshalPER(w *per.BitWriter) error {
+	return w.EncEnumerated(int(v), 0, 0, true)
+}
+
+func (v *UERetentionInformation) UnmarshalPER(r *per.BitReader) (err error) {
+	x, err := r.DecEnumerated(0, 0, true)
+	*v = UERetentionInformation(x)
+	return
+}
+
+// UESecurityCapabilities is UESecurityCapabilities in NGAP-IEs.
+type UESecurityCapabilities struct {
+	NRencryptionAlgorithms             NRencryptionAlgorithms
+	NRintegrityProtectionAlgorithms    NRintegrityProtectionAlgorithms
+	EUTRAencryptionAlgorithms          EUTRAencryptionAlgorithms
+	EUTRAintegrityProtectionAlgorithms EUTRAintegrityProtectionAlgorithms
+	IEExtensions                       *ProtocolExtensionContainer
+}
+
+func (v UESecurityCapabilities) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.NRencryptionAlgorithms.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "nRencryptionAlgorithms")
+		return
+	}
+	if err = v.NRintegrityProtectionAlgorithms.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "nRintegrityProtectionAlgorithms")
+		return
+	}
+	if err = v.EUTRAencryptionAlgorithms.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "eUTRAencryptionAlgorithms")
+		return
+	}
+	if err = v.EUTRAintegrityProtectionAlgorithms.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "eUTRAintegrityProtectionAlgorithms")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *UESecurityCapabilities) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = UESecurityCapabilities{}
+	if err = v.NRencryptionAlgorithms.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "nRencryptionAlgorithms")
+		return
+	}
+	if err = v.NRintegrityProtectionAlgorithms.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "nRintegrityProtectionAlgorithms")
+		return
+	}
+	if err = v.EUTRAencryptionAlgorithms.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "eUTRAencryptionAlgorithms")
+		return
+	}
+	if err = v.EUTRAintegrityProtectionAlgorithms.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "eUTRAintegrityProtectionAlgorithms")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// ULNGUUPTNLModifyItem is UL-NGU-UP-TNLModifyItem in NGAP-IEs.
+type ULNGUUPTNLModifyItem struct {
+	ULNGUUPTNLInformation UPTransportLayerInformation
+	DLNGUUPTNLInformation UPTransportLayerInformation
+	IEExtensions          *ProtocolExtensionContainer
+}
+
+func (v ULNGUUPTNLModifyItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.ULNGUUPTNLInformation.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "uL-NGU-UP-TNLInformation")
+		return
+	}
+	if err = v.DLNGUUPTNLInformation.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "dL-NGU-UP-TNLInformation")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *ULNGUUPTNLModifyItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = ULNGUUPTNLModifyItem{}
+	if err = v.ULNGUUPTNLInformation.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "uL-NGU-UP-TNLInformation")
+		return
+	}
+	if err = v.DLNGUUPTNLInformation.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "dL-NGU-UP-TNLInformation")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// ULNGUUPTNLModifyList is UL-NGU-UP-TNLModifyList in NGAP-IEs.
+type ULNGUUPTNLModifyList []ULNGUUPTNLModifyItem
+
+func (v ULNGUUPTNLModifyList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofMultiConnectivity, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *ULNGUUPTNLModifyList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofMultiConnectivity, false)
+	if err != nil {
+		return
+	}
+	*v = make(ULNGUUPTNLModifyList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// UPTransportLayerInformation is UPTransportLayerInformation in NGAP-IEs.
+type UPTransportLayerInformation struct {
+	GTPTunnel        *GTPTunnel
+	ChoiceExtensions *ProtocolIESingleContainer
+}
+
+func (v UPTransportLayerInformation) MarshalPER(w *per.BitWriter) (err error) {
+	chosen := 0
+	if v.GTPTunnel != nil {
+		chosen++
+	}
+	if v.ChoiceExtensions != nil {
+		chosen++
+	}
+	if chosen > 1 {
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "more than one alternative is chosen"}
+		return
+	}
+	switch {
+	case v.GTPTunnel != nil:
+		if err = w.EncChoice(0, 0, 1, false); err != nil {
+			return
+		}
+		if err = v.GTPTunnel.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "gTPTunnel")
+			return
+		}
+	case v.ChoiceExtensions != nil:
+		if err = w.EncChoice(1, 0, 1, false); err != nil {
+			return
+		}
+		if err = v.ChoiceExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	default:
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "no alternative is chosen"}
+	}
+	return
+}
+
+func (v *UPTransportLayerInformation) UnmarshalPER(r *per.BitReader) (err error) {
+	index, err := r.DecChoice(0, 1, false)
+	if err != nil {
+		return
+	}
+	*v = UPTransportLayerInformation{}
+	switch index {
+	case 0:
+		v.GTPTunnel = new(GTPTunnel)
+		if err = v.GTPTunnel.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "gTPTunnel")
+			return
+		}
+	case 1:
+		v.ChoiceExtensions = new(ProtocolIESingleContainer)
+		if err = v.ChoiceExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	}
+	return
+}
+
+// UPTransportLayerInformationItem is UPTransportLayerInformationItem in NGAP-IEs.
+type UPTransportLayerInformationItem struct {
+	NGUUPTNLInformation UPTransportLayerInformation
+	IEExtensions        *ProtocolExtensionContainer
+}
+
+func (v UPTransportLayerInformationItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.NGUUPTNLInformation.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "nGU-UP-TNLInformation")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *UPTransportLayerInformationItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = UPTransportLayerInformationItem{}
+	if err = v.NGUUPTNLInformation.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "nGU-UP-TNLInformation")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// UPTransportLayerInformationList is UPTransportLayerInformationList in NGAP-IEs.
+type UPTransportLayerInformationList []UPTransportLayerInformationItem
+
+func (v UPTransportLayerInformationList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofMultiConnectivityMinusOne, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *UPTransportLayerInformationList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofMultiConnectivityMinusOne, false)
+	if err != nil {
+		return
+	}
+	*v = make(UPTransportLayerInformationList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// UPTransportLayerInformationPairItem is UPTransportLayerInformationPairItem in NGAP-IEs.
+type UPTransportLayerInformationPairItem struct {
+	ULNGUUPTNLInformation UPTransportLayerInformation
+	DLNGUUPTNLInformation UPTransportLayerInformation
+	IEExtensions          *ProtocolExtensionContainer
+}
+
+func (v UPTransportLayerInformationPairItem) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.ULNGUUPTNLInformation.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "uL-NGU-UP-TNLInformation")
+		return
+	}
+	if err = v.DLNGUUPTNLInformation.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "dL-NGU-UP-TNLInformation")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *UPTransportLayerInformationPairItem) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = UPTransportLayerInformationPairItem{}
+	if err = v.ULNGUUPTNLInformation.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "uL-NGU-UP-TNLInformation")
+		return
+	}
+	if err = v.DLNGUUPTNLInformation.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "dL-NGU-UP-TNLInformation")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// UPTransportLayerInformationPairList is UPTransportLayerInformationPairList in NGAP-IEs.
+type UPTransportLayerInformationPairList []UPTransportLayerInformationPairItem
+
+func (v UPTransportLayerInformationPairList) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequenceOf(len(v), 1, maxnoofMultiConnectivityMinusOne, false); err != nil {
+		return
+	}
+	for i := range v {
+		if err = v[i].MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+func (v *UPTransportLayerInformationPairList) UnmarshalPER(r *per.BitReader) (err error) {
+	n, err := r.DecSequenceOf(1, maxnoofMultiConnectivityMinusOne, false)
+	if err != nil {
+		return
+	}
+	*v = make(UPTransportLayerInformationPairList, n)
+	for i := range *v {
+		if err = (*v)[i].UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("[%d]", i))
+			return
+		}
+	}
+	return
+}
+
+// UserLocationInformation is UserLocationInformation in NGAP-IEs.
+type UserLocationInformation struct {
+	UserLocationInformationEUTRA *UserLocationInformationEUTRA
+	UserLocationInformationNR    *UserLocationInformationNR
+	UserLocationInformationN3IWF *UserLocationInformationN3IWF
+	ChoiceExtensions             *ProtocolIESingleContainer
+}
+
+func (v UserLocationInformation) MarshalPER(w *per.BitWriter) (err error) {
+	chosen := 0
+	if v.UserLocationInformationEUTRA != nil {
+		chosen++
+	}
+	if v.UserLocationInformationNR != nil {
+		chosen++
+	}
+	if v.UserLocationInformationN3IWF != nil {
+		chosen++
+	}
+	if v.ChoiceExtensions != nil {
+		chosen++
+	}
+	if chosen > 1 {
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "more than one alternative is chosen"}
+		return
+	}
+	switch {
+	case v.UserLocationInformationEUTRA != nil:
+		if err = w.EncChoice(0, 0, 3, false); err != nil {
+			return
+		}
+		if err = v.UserLocationInformationEUTRA.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "userLocationInformationEUTRA")
+			return
+		}
+	case v.UserLocationInformationNR != nil:
+		if err = w.EncChoice(1, 0, 3, false); err != nil {
+			return
+		}
+		if err = v.UserLocationInformationNR.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "userLocationInformationNR")
+			return
+		}
+	case v.UserLocationInformationN3IWF != nil:
+		if err = w.EncChoice(2, 0, 3, false); err != nil {
+			return
+		}
+		if err = v.UserLocationInformationN3IWF.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "userLocationInformationN3IWF")
+			return
+		}
+	case v.ChoiceExtensions != nil:
+		if err = w.EncChoice(3, 0, 3, false); err != nil {
+			return
+		}
+		if err = v.ChoiceExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	default:
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "no alternative is chosen"}
+	}
+	return
+}
+
+func (v *UserLocationInformation) UnmarshalPER(r *per.BitReader) (err error) {
+	index, err := r.DecChoice(0, 3, false)
+	if err != nil {
+		return
+	}
+	*v = UserLocationInformation{}
+	switch index {
+	case 0:
+		v.UserLocationInformationEUTRA = new(UserLocationInformationEUTRA)
+		if err = v.UserLocationInformationEUTRA.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "userLocationInformationEUTRA")
+			return
+		}
+	case 1:
+		v.UserLocationInformationNR = new(UserLocationInformationNR)
+		if err = v.UserLocationInformationNR.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "userLocationInformationNR")
+			return
+		}
+	case 2:
+		v.UserLocationInformationN3IWF = new(UserLocationInformationN3IWF)
+		if err = v.UserLocationInformationN3IWF.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "userLocationInformationN3IWF")
+			return
+		}
+	case 3:
+		v.ChoiceExtensions = new(ProtocolIESingleContainer)
+		if err = v.ChoiceExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "choice-Extensions")
+			return
+		}
+	}
+	return
+}
+
+// UserLocationInformationEUTRA is UserLocationInformationEUTRA in NGAP-IEs.
+type UserLocationInformationEUTRA struct {
+	EUTRACGI     EUTRACGI
+	TAI          TAI
+	TimeStamp    *TimeStamp
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v UserLocationInformationEUTRA) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.TimeStamp != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 2, optflag); err != nil {
+		return
+	}
+	if err = v.EUTRACGI.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "eUTRA-CGI")
+		return
+	}
+	if err = v.TAI.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "tAI")
+		return
+	}
+	if v.TimeStamp != nil {
+		if err = v.TimeStamp.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "timeStamp")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *UserLocationInformationEUTRA) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 2)
+	if err != nil {
+		return
+	}
+	*v = UserLocationInformationEUTRA{}
+	if err = v.EUTRACGI.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "eUTRA-CGI")
+		return
+	}
+	if err = v.TAI.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "tAI")
+		return
+	}
+	if optflag&(1<<1) != 0 {
+		v.TimeStamp = new(TimeStamp)
+		if err = v.TimeStamp.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "timeStamp")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// UserLocationInformationN3IWF is UserLocationInformationN3IWF in NGAP-IEs.
+type UserLocationInformationN3IWF struct {
+	IPAddress    TransportLayerAddress
+	PortNumber   PortNumber
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v UserLocationInformationN3IWF) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 1, optflag); err != nil {
+		return
+	}
+	if err = v.IPAddress.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "iPAddress")
+		return
+	}
+	if err = v.PortNumber.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "portNumber")
+		return
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *UserLocationInformationN3IWF) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 1)
+	if err != nil {
+		return
+	}
+	*v = UserLocationInformationN3IWF{}
+	if err = v.IPAddress.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "iPAddress")
+		return
+	}
+	if err = v.PortNumber.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "portNumber")
+		return
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// UserLocationInformationNR is UserLocationInformationNR in NGAP-IEs.
+type UserLocationInformationNR struct {
+	NRCGI        NRCGI
+	TAI          TAI
+	TimeStamp    *TimeStamp
+	IEExtensions *ProtocolExtensionContainer
+}
+
+func (v UserLocationInformationNR) MarshalPER(w *per.BitWriter) (err error) {
+	optflag := uint(0)
+	if v.TimeStamp != nil {
+		optflag |= 1 << 1
+	}
+	if v.IEExtensions != nil {
+		optflag |= 1 << 0
+	}
+	if err = w.EncSequence(true, 2, optflag); err != nil {
+		return
+	}
+	if err = v.NRCGI.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "nR-CGI")
+		return
+	}
+	if err = v.TAI.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "tAI")
+		return
+	}
+	if v.TimeStamp != nil {
+		if err = v.TimeStamp.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "timeStamp")
+			return
+		}
+	}
+	if v.IEExtensions != nil {
+		if err = v.IEExtensions.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	return
+}
+
+func (v *UserLocationInformationNR) UnmarshalPER(r *per.BitReader) (err error) {
+	ext, optflag, err := r.DecSequence(true, 2)
+	if err != nil {
+		return
+	}
+	*v = UserLocationInformationNR{}
+	if err = v.NRCGI.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "nR-CGI")
+		return
+	}
+	if err = v.TAI.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "tAI")
+		return
+	}
+	if optflag&(1<<1) != 0 {
+		v.TimeStamp = new(TimeStamp)
+		if err = v.TimeStamp.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "timeStamp")
+			return
+		}
+	}
+	if optflag&(1<<0) != 0 {
+		v.IEExtensions = new(ProtocolExtensionContainer)
+		if err = v.IEExtensions.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "iE-Extensions")
+			return
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// NGSetupRequest is NGSetupRequest in NGAP-PDU-Contents.
+type NGSetupRequest struct {
+	GlobalRANNodeID        GlobalRANNodeID
+	RANNodeName            *RANNodeName
+	SupportedTAList        SupportedTAList
+	DefaultPagingDRX       PagingDRX
+	UERetentionInformation *UERetentionInformation
+}
+
+func (v NGSetupRequest) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "NGSetupRequest")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 3
+	if v.RANNodeName != nil {
+		n++
+	}
+	if v.UERetentionInformation != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idGlobalRANNodeID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.GlobalRANNodeID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.GlobalRANNodeID", i))
+		return
+	}
+	i++
+	if v.RANNodeName != nil {
+		if err = ProtocolIEID(idRANNodeName).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.RANNodeName.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RANNodeName", i))
+			return
+		}
+		i++
+	}
+	if err = ProtocolIEID(idSupportedTAList).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.SupportedTAList.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.SupportedTAList", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idDefaultPagingDRX).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.DefaultPagingDRX.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PagingDRX", i))
+		return
+	}
+	i++
+	if v.UERetentionInformation != nil {
+		if err = ProtocolIEID(idUERetentionInformation).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.UERetentionInformation.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UERetentionInformation", i))
+			return
+		}
+		i++
+	}
+	return
+}
+
+func (v *NGSetupRequest) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "NGSetupRequest")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = NGSetupRequest{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasGlobalRANNodeID, hasSupportedTAList, hasDefaultPagingDRX bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idGlobalRANNodeID:
+			if err = r.DecOpenType(v.GlobalRANNodeID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.GlobalRANNodeID", i))
+				return
+			}
+			hasGlobalRANNodeID = true
+		case idRANNodeName:
+			v.RANNodeName = new(RANNodeName)
+			if err = r.DecOpenType(v.RANNodeName.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RANNodeName", i))
+				return
+			}
+		case idSupportedTAList:
+			if err = r.DecOpenType(v.SupportedTAList.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.SupportedTAList", i))
+				return
+			}
+			hasSupportedTAList = true
+		case idDefaultPagingDRX:
+			if err = r.DecOpenType(v.DefaultPagingDRX.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PagingDRX", i))
+				return
+			}
+			hasDefaultPagingDRX = true
+		case idUERetentionInformation:
+			v.UERetentionInformation = new(UERetentionInformation)
+			if err = r.DecOpenType(v.UERetentionInformation.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UERetentionInformation", i))
+				return
+			}
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasGlobalRANNodeID == false {
+		err = &per.MissingIEError{IE: "GlobalRANNodeID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasSupportedTAList == false {
+		err = &per.MissingIEError{IE: "SupportedTAList"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasDefaultPagingDRX == false {
+		err = &per.MissingIEError{IE: "DefaultPagingDRX"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// NGSetupResponse is NGSetupResponse in NGAP-PDU-Contents.
+type NGSetupResponse struct {
+	AMFName                AMFName
+	ServedGUAMIList        ServedGUAMIList
+	RelativeAMFCapacity    RelativeAMFCapacity
+	PLMNSupportList        PLMNSupportList
+	CriticalityDiagnostics *CriticalityDiagnostics
+	UERetentionInformation *UERetentionInformation
+}
+
+func (v NGSetupResponse) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "NGSetupResponse")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 4
+	if v.CriticalityDiagnostics != nil {
+		n++
+	}
+	if v.UERetentionInformation != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idAMFName).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.AMFName.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMFName", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idServedGUAMIList).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.ServedGUAMIList.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.ServedGUAMIList", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idRelativeAMFCapacity).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.RelativeAMFCapacity.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RelativeAMFCapacity", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idPLMNSupportList).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.PLMNSupportList.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PLMNSupportList", i))
+		return
+	}
+	i++
+	if v.CriticalityDiagnostics != nil {
+		if err = ProtocolIEID(idCriticalityDiagnostics).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.CriticalityDiagnostics.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+			return
+		}
+		i++
+	}
+	if v.UERetentionInformation != nil {
+		if err = ProtocolIEID(idUERetentionInformation).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.UERetentionInformation.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UERetentionInformation", i))
+			return
+		}
+		i++
+	}
+	return
+}
+
+func (v *NGSetupResponse) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "NGSetupResponse")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = NGSetupResponse{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasAMFName, hasServedGUAMIList, hasRelativeAMFCapacity, hasPLMNSupportList bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idAMFName:
+			if err = r.DecOpenType(v.AMFName.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMFName", i))
+				return
+			}
+			hasAMFName = true
+		case idServedGUAMIList:
+			if err = r.DecOpenType(v.ServedGUAMIList.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.ServedGUAMIList", i))
+				return
+			}
+			hasServedGUAMIList = true
+		case idRelativeAMFCapacity:
+			if err = r.DecOpenType(v.RelativeAMFCapacity.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RelativeAMFCapacity", i))
+				return
+			}
+			hasRelativeAMFCapacity = true
+		case idPLMNSupportList:
+			if err = r.DecOpenType(v.PLMNSupportList.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PLMNSupportList", i))
+				return
+			}
+			hasPLMNSupportList = true
+		case idCriticalityDiagnostics:
+			v.CriticalityDiagnostics = new(CriticalityDiagnostics)
+			if err = r.DecOpenType(v.CriticalityDiagnostics.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+				return
+			}
+		case idUERetentionInformation:
+			v.UERetentionInformation = new(UERetentionInformation)
+			if err = r.DecOpenType(v.UERetentionInformation.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UERetentionInformation", i))
+				return
+			}
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasAMFName == false {
+		err = &per.MissingIEError{IE: "AMFName"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasServedGUAMIList == false {
+		err = &per.MissingIEError{IE: "ServedGUAMIList"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasRelativeAMFCapacity == false {
+		err = &per.MissingIEError{IE: "RelativeAMFCapacity"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasPLMNSupportList == false {
+		err = &per.MissingIEError{IE: "PLMNSupportList"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// NGSetupFailure is NGSetupFailure in NGAP-PDU-Contents.
+type NGSetupFailure struct {
+	Cause                  Cause
+	TimeToWait             *TimeToWait
+	CriticalityDiagnostics *CriticalityDiagnostics
+}
+
+func (v NGSetupFailure) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "NGSetupFailure")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 1
+	if v.TimeToWait != nil {
+		n++
+	}
+	if v.CriticalityDiagnostics != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idCause).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.Cause.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.Cause", i))
+		return
+	}
+	i++
+	if v.TimeToWait != nil {
+		if err = ProtocolIEID(idTimeToWait).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.TimeToWait.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.TimeToWait", i))
+			return
+		}
+		i++
+	}
+	if v.CriticalityDiagnostics != nil {
+		if err = ProtocolIEID(idCriticalityDiagnostics).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.CriticalityDiagnostics.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+			return
+		}
+		i++
+	}
+	return
+}
+
+func (v *NGSetupFailure) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "NGSetupFailure")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = NGSetupFailure{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasCause bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idCause:
+			if err = r.DecOpenType(v.Cause.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.Cause", i))
+				return
+			}
+			hasCause = true
+		case idTimeToWait:
+			v.TimeToWait = new(TimeToWait)
+			if err = r.DecOpenType(v.TimeToWait.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.TimeToWait", i))
+				return
+			}
+		case idCriticalityDiagnostics:
+			v.CriticalityDiagnostics = new(CriticalityDiagnostics)
+			if err = r.DecOpenType(v.CriticalityDiagnostics.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+				return
+			}
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasCause == false {
+		err = &per.MissingIEError{IE: "Cause"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// InitialUEMessage is InitialUEMessage in NGAP-PDU-Contents.
+type InitialUEMessage struct {
+	RANUENGAPID             RANUENGAPID
+	NASPDU                  NASPDU
+	UserLocationInformation UserLocationInformation
+	RRCEstablishmentCause   RRCEstablishmentCause
+	FiveGSTMSI              *FiveGSTMSI
+	AMFSetID                *AMFSetID
+	UEContextRequest        *UEContextRequest
+	AllowedNSSAI            *AllowedNSSAI
+}
+
+func (v InitialUEMessage) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "InitialUEMessage")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 4
+	if v.FiveGSTMSI != nil {
+		n++
+	}
+	if v.AMFSetID != nil {
+		n++
+	}
+	if v.UEContextRequest != nil {
+		n++
+	}
+	if v.AllowedNSSAI != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idRANUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.RANUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idNASPDU).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.NASPDU.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.NAS-PDU", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idUserLocationInformation).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.UserLocationInformation.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UserLocationInformation", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idRRCEstablishmentCause).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.RRCEstablishmentCause.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RRCEstablishmentCause", i))
+		return
+	}
+	i++
+	if v.FiveGSTMSI != nil {
+		if err = ProtocolIEID(idFiveGSTMSI).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.FiveGSTMSI.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.FiveG-S-TMSI", i))
+			return
+		}
+		i++
+	}
+	if v.AMFSetID != nil {
+		if err = ProtocolIEID(idAMFSetID).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.AMFSetID.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMFSetID", i))
+			return
+		}
+		i++
+	}
+	if v.UEContextRequest != nil {
+		if err = ProtocolIEID(idUEContextRequest).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.UEContextRequest.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UEContextRequest", i))
+			return
+		}
+		i++
+	}
+	if v.AllowedNSSAI != nil {
+		if err = ProtocolIEID(idAllowedNSSAI).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.AllowedNSSAI.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AllowedNSSAI", i))
+			return
+		}
+		i++
+	}
+	return
+}
+
+func (v *InitialUEMessage) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "InitialUEMessage")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = InitialUEMessage{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasRANUENGAPID, hasNASPDU, hasUserLocationInformation, hasRRCEstablishmentCause bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idRANUENGAPID:
+			if err = r.DecOpenType(v.RANUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+				return
+			}
+			hasRANUENGAPID = true
+		case idNASPDU:
+			if err = r.DecOpenType(v.NASPDU.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.NAS-PDU", i))
+				return
+			}
+			hasNASPDU = true
+		case idUserLocationInformation:
+			if err = r.DecOpenType(v.UserLocationInformation.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UserLocationInformation", i))
+				return
+			}
+			hasUserLocationInformation = true
+		case idRRCEstablishmentCause:
+			if err = r.DecOpenType(v.RRCEstablishmentCause.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RRCEstablishmentCause", i))
+				return
+			}
+			hasRRCEstablishmentCause = true
+		case idFiveGSTMSI:
+			v.FiveGSTMSI = new(FiveGSTMSI)
+			if err = r.DecOpenType(v.FiveGSTMSI.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.FiveG-S-TMSI", i))
+				return
+			}
+		case idAMFSetID:
+			v.AMFSetID = new(AMFSetID)
+			if err = r.DecOpenType(v.AMFSetID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMFSetID", i))
+				return
+			}
+		case idUEContextRequest:
+			v.UEContextRequest = new(UEContextRequest)
+			if err = r.DecOpenType(v.UEContextRequest.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UEContextRequest", i))
+				return
+			}
+		case idAllowedNSSAI:
+			v.AllowedNSSAI = new(AllowedNSSAI)
+			if err = r.DecOpenType(v.AllowedNSSAI.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AllowedNSSAI", i))
+				return
+			}
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasRANUENGAPID == false {
+		err = &per.MissingIEError{IE: "RANUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasNASPDU == false {
+		err = &per.MissingIEError{IE: "NASPDU"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasUserLocationInformation == false {
+		err = &per.MissingIEError{IE: "UserLocationInformation"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasRRCEstablishmentCause == false {
+		err = &per.MissingIEError{IE: "RRCEstablishmentCause"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// DownlinkNASTransport is DownlinkNASTransport in NGAP-PDU-Contents.
+type DownlinkNASTransport struct {
+	AMFUENGAPID               AMFUENGAPID
+	RANUENGAPID               RANUENGAPID
+	OldAMF                    *AMFName
+	RANPagingPriority         *RANPagingPriority
+	NASPDU                    NASPDU
+	MobilityRestrictionList   *MobilityRestrictionList
+	IndexToRFSP               *IndexToRFSP
+	UEAggregateMaximumBitRate *UEAggregateMaximumBitRate
+	AllowedNSSAI              *AllowedNSSAI
+}
+
+func (v DownlinkNASTransport) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "DownlinkNASTransport")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 3
+	if v.OldAMF != nil {
+		n++
+	}
+	if v.RANPagingPriority != nil {
+		n++
+	}
+	if v.MobilityRestrictionList != nil {
+		n++
+	}
+	if v.IndexToRFSP != nil {
+		n++
+	}
+	if v.UEAggregateMaximumBitRate != nil {
+		n++
+	}
+	if v.AllowedNSSAI != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idAMFUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.AMFUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idRANUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.RANUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if v.OldAMF != nil {
+		if err = ProtocolIEID(idOldAMF).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.OldAMF.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMFName", i))
+			return
+		}
+		i++
+	}
+	if v.RANPagingPriority != nil {
+		if err = ProtocolIEID(idRANPagingPriority).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.RANPagingPriority.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RANPagingPriority", i))
+			return
+		}
+		i++
+	}
+	if err = ProtocolIEID(idNASPDU).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.NASPDU.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.NAS-PDU", i))
+		return
+	}
+	i++
+	if v.MobilityRestrictionList != nil {
+		if err = ProtocolIEID(idMobilityRestrictionList).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.MobilityRestrictionList.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.MobilityRestrictionList", i))
+			return
+		}
+		i++
+	}
+	if v.IndexToRFSP != nil {
+		if err = ProtocolIEID(idIndexToRFSP).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.IndexToRFSP.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.IndexToRFSP", i))
+			return
+		}
+		i++
+	}
+	if v.UEAggregateMaximumBitRate != nil {
+		if err = ProtocolIEID(idUEAggregateMaximumBitRate).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.UEAggregateMaximumBitRate.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UEAggregateMaximumBitRate", i))
+			return
+		}
+		i++
+	}
+	if v.AllowedNSSAI != nil {
+		if err = ProtocolIEID(idAllowedNSSAI).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.AllowedNSSAI.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AllowedNSSAI", i))
+			return
+		}
+		i++
+	}
+	return
+}
+
+func (v *DownlinkNASTransport) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "DownlinkNASTransport")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = DownlinkNASTransport{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasAMFUENGAPID, hasRANUENGAPID, hasNASPDU bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idAMFUENGAPID:
+			if err = r.DecOpenType(v.AMFUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+				return
+			}
+			hasAMFUENGAPID = true
+		case idRANUENGAPID:
+			if err = r.DecOpenType(v.RANUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+				return
+			}
+			hasRANUENGAPID = true
+		case idOldAMF:
+			v.OldAMF = new(AMFName)
+			if err = r.DecOpenType(v.OldAMF.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMFName", i))
+				return
+			}
+		case idRANPagingPriority:
+			v.RANPagingPriority = new(RANPagingPriority)
+			if err = r.DecOpenType(v.RANPagingPriority.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RANPagingPriority", i))
+				return
+			}
+		case idNASPDU:
+			if err = r.DecOpenType(v.NASPDU.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.NAS-PDU", i))
+				return
+			}
+			hasNASPDU = true
+		case idMobilityRestrictionList:
+			v.MobilityRestrictionList = new(MobilityRestrictionList)
+			if err = r.DecOpenType(v.MobilityRestrictionList.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.MobilityRestrictionList", i))
+				return
+			}
+		case idIndexToRFSP:
+			v.IndexToRFSP = new(IndexToRFSP)
+			if err = r.DecOpenType(v.IndexToRFSP.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.IndexToRFSP", i))
+				return
+			}
+		case idUEAggregateMaximumBitRate:
+			v.UEAggregateMaximumBitRate = new(UEAggregateMaximumBitRate)
+			if err = r.DecOpenType(v.UEAggregateMaximumBitRate.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UEAggregateMaximumBitRate", i))
+				return
+			}
+		case idAllowedNSSAI:
+			v.AllowedNSSAI = new(AllowedNSSAI)
+			if err = r.DecOpenType(v.AllowedNSSAI.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AllowedNSSAI", i))
+				return
+			}
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasAMFUENGAPID == false {
+		err = &per.MissingIEError{IE: "AMFUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasRANUENGAPID == false {
+		err = &per.MissingIEError{IE: "RANUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasNASPDU == false {
+		err = &per.MissingIEError{IE: "NASPDU"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// UplinkNASTransport is UplinkNASTransport in NGAP-PDU-Contents.
+type UplinkNASTransport struct {
+	AMFUENGAPID             AMFUENGAPID
+	RANUENGAPID             RANUENGAPID
+	NASPDU                  NASPDU
+	UserLocationInformation UserLocationInformation
+}
+
+func (v UplinkNASTransport) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "UplinkNASTransport")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 4
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idAMFUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.AMFUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idRANUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.RANUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idNASPDU).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.NASPDU.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.NAS-PDU", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idUserLocationInformation).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.UserLocationInformation.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UserLocationInformation", i))
+		return
+	}
+	i++
+	return
+}
+
+func (v *UplinkNASTransport) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "UplinkNASTransport")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = UplinkNASTransport{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasAMFUENGAPID, hasRANUENGAPID, hasNASPDU, hasUserLocationInformation bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idAMFUENGAPID:
+			if err = r.DecOpenType(v.AMFUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+				return
+			}
+			hasAMFUENGAPID = true
+		case idRANUENGAPID:
+			if err = r.DecOpenType(v.RANUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+				return
+			}
+			hasRANUENGAPID = true
+		case idNASPDU:
+			if err = r.DecOpenType(v.NASPDU.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.NAS-PDU", i))
+				return
+			}
+			hasNASPDU = true
+		case idUserLocationInformation:
+			if err = r.DecOpenType(v.UserLocationInformation.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UserLocationInformation", i))
+				return
+			}
+			hasUserLocationInformation = true
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasAMFUENGAPID == false {
+		err = &per.MissingIEError{IE: "AMFUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasRANUENGAPID == false {
+		err = &per.MissingIEError{IE: "RANUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasNASPDU == false {
+		err = &per.MissingIEError{IE: "NASPDU"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasUserLocationInformation == false {
+		err = &per.MissingIEError{IE: "UserLocationInformation"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// InitialContextSetupRequest is InitialContextSetupRequest in NGAP-PDU-Contents.
+type InitialContextSetupRequest struct {
+	AMFUENGAPID                        AMFUENGAPID
+	RANUENGAPID                        RANUENGAPID
+	OldAMF                             *AMFName
+	UEAggregateMaximumBitRate          *UEAggregateMaximumBitRate
+	CoreNetworkAssistanceInformation   *CoreNetworkAssistanceInformation
+	GUAMI                              GUAMI
+	PDUSessionResourceSetupListCxtReq  *PDUSessionResourceSetupListCxtReq
+	AllowedNSSAI                       AllowedNSSAI
+	UESecurityCapabilities             UESecurityCapabilities
+	SecurityKey                        SecurityKey
+	TraceActivation                    *TraceActivation
+	MobilityRestrictionList            *MobilityRestrictionList
+	UERadioCapability                  *UERadioCapability
+	IndexToRFSP                        *IndexToRFSP
+	MaskedIMEISV                       *MaskedIMEISV
+	NASPDU                             *NASPDU
+	EmergencyFallbackIndicator         *EmergencyFallbackIndicator
+	RRCInactiveTransitionReportRequest *RRCInactiveTransitionReportRequest
+	UERadioCapabilityForPaging         *UERadioCapabilityForPaging
+	RedirectionVoiceFallback           *RedirectionVoiceFallback
+}
+
+func (v InitialContextSetupRequest) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "InitialContextSetupRequest")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 6
+	if v.OldAMF != nil {
+		n++
+	}
+	if v.UEAggregateMaximumBitRate != nil {
+		n++
+	}
+	if v.CoreNetworkAssistanceInformation != nil {
+		n++
+	}
+	if v.PDUSessionResourceSetupListCxtReq != nil {
+		n++
+	}
+	if v.TraceActivation != nil {
+		n++
+	}
+	if v.MobilityRestrictionList != nil {
+		n++
+	}
+	if v.UERadioCapability != nil {
+		n++
+	}
+	if v.IndexToRFSP != nil {
+		n++
+	}
+	if v.MaskedIMEISV != nil {
+		n++
+	}
+	if v.NASPDU != nil {
+		n++
+	}
+	if v.EmergencyFallbackIndicator != nil {
+		n++
+	}
+	if v.RRCInactiveTransitionReportRequest != nil {
+		n++
+	}
+	if v.UERadioCapabilityForPaging != nil {
+		n++
+	}
+	if v.RedirectionVoiceFallback != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idAMFUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.AMFUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idRANUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.RANUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if v.OldAMF != nil {
+		if err = ProtocolIEID(idOldAMF).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.OldAMF.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMFName", i))
+			return
+		}
+		i++
+	}
+	if v.UEAggregateMaximumBitRate != nil {
+		if err = ProtocolIEID(idUEAggregateMaximumBitRate).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.UEAggregateMaximumBitRate.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UEAggregateMaximumBitRate", i))
+			return
+		}
+		i++
+	}
+	if v.CoreNetworkAssistanceInformation != nil {
+		if err = ProtocolIEID(idCoreNetworkAssistanceInformation).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.CoreNetworkAssistanceInformation.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CoreNetworkAssistanceInformation", i))
+			return
+		}
+		i++
+	}
+	if err = ProtocolIEID(idGUAMI).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.GUAMI.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.GUAMI", i))
+		return
+	}
+	i++
+	if v.PDUSessionResourceSetupListCxtReq != nil {
+		if err = ProtocolIEID(idPDUSessionResourceSetupListCxtReq).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.PDUSessionResourceSetupListCxtReq.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceSetupListCxtReq", i))
+			return
+		}
+		i++
+	}
+	if err = ProtocolIEID(idAllowedNSSAI).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.AllowedNSSAI.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AllowedNSSAI", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idUESecurityCapabilities).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.UESecurityCapabilities.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UESecurityCapabilities", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idSecurityKey).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.SecurityKey.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.SecurityKey", i))
+		return
+	}
+	i++
+	if v.TraceActivation != nil {
+		if err = ProtocolIEID(idTraceActivation).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.TraceActivation.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.TraceActivation", i))
+			return
+		}
+		i++
+	}
+	if v.MobilityRestrictionList != nil {
+		if err = ProtocolIEID(idMobilityRestrictionList).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.MobilityRestrictionList.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.MobilityRestrictionList", i))
+			return
+		}
+		i++
+	}
+	if v.UERadioCapability != nil {
+		if err = ProtocolIEID(idUERadioCapability).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.UERadioCapability.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UERadioCapability", i))
+			return
+		}
+		i++
+	}
+	if v.IndexToRFSP != nil {
+		if err = ProtocolIEID(idIndexToRFSP).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.IndexToRFSP.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.IndexToRFSP", i))
+			return
+		}
+		i++
+	}
+	if v.MaskedIMEISV != nil {
+		if err = ProtocolIEID(idMaskedIMEISV).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.MaskedIMEISV.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.MaskedIMEISV", i))
+			return
+		}
+		i++
+	}
+	if v.NASPDU != nil {
+		if err = ProtocolIEID(idNASPDU).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.NASPDU.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.NAS-PDU", i))
+			return
+		}
+		i++
+	}
+	if v.EmergencyFallbackIndicator != nil {
+		if err = ProtocolIEID(idEmergencyFallbackIndicator).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.EmergencyFallbackIndicator.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.EmergencyFallbackIndicator", i))
+			return
+		}
+		i++
+	}
+	if v.RRCInactiveTransitionReportRequest != nil {
+		if err = ProtocolIEID(idRRCInactiveTransitionReportRequest).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.RRCInactiveTransitionReportRequest.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RRCInactiveTransitionReportRequest", i))
+			return
+		}
+		i++
+	}
+	if v.UERadioCapabilityForPaging != nil {
+		if err = ProtocolIEID(idUERadioCapabilityForPaging).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.UERadioCapabilityForPaging.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UERadioCapabilityForPaging", i))
+			return
+		}
+		i++
+	}
+	if v.RedirectionVoiceFallback != nil {
+		if err = ProtocolIEID(idRedirectionVoiceFallback).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.RedirectionVoiceFallback.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RedirectionVoiceFallback", i))
+			return
+		}
+		i++
+	}
+	return
+}
+
+func (v *InitialContextSetupRequest) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "InitialContextSetupRequest")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = InitialContextSetupRequest{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasAMFUENGAPID, hasRANUENGAPID, hasGUAMI, hasAllowedNSSAI, hasUESecurityCapabilities, hasSecurityKey bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idAMFUENGAPID:
+			if err = r.DecOpenType(v.AMFUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+				return
+			}
+			hasAMFUENGAPID = true
+		case idRANUENGAPID:
+			if err = r.DecOpenType(v.RANUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+				return
+			}
+			hasRANUENGAPID = true
+		case idOldAMF:
+			v.OldAMF = new(AMFName)
+			if err = r.DecOpenType(v.OldAMF.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMFName", i))
+				return
+			}
+		case idUEAggregateMaximumBitRate:
+			v.UEAggregateMaximumBitRate = new(UEAggregateMaximumBitRate)
+			if err = r.DecOpenType(v.UEAggregateMaximumBitRate.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UEAggregateMaximumBitRate", i))
+				return
+			}
+		case idCoreNetworkAssistanceInformation:
+			v.CoreNetworkAssistanceInformation = new(CoreNetworkAssistanceInformation)
+			if err = r.DecOpenType(v.CoreNetworkAssistanceInformation.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CoreNetworkAssistanceInformation", i))
+				return
+			}
+		case idGUAMI:
+			if err = r.DecOpenType(v.GUAMI.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.GUAMI", i))
+				return
+			}
+			hasGUAMI = true
+		case idPDUSessionResourceSetupListCxtReq:
+			v.PDUSessionResourceSetupListCxtReq = new(PDUSessionResourceSetupListCxtReq)
+			if err = r.DecOpenType(v.PDUSessionResourceSetupListCxtReq.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceSetupListCxtReq", i))
+				return
+			}
+		case idAllowedNSSAI:
+			if err = r.DecOpenType(v.AllowedNSSAI.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AllowedNSSAI", i))
+				return
+			}
+			hasAllowedNSSAI = true
+		case idUESecurityCapabilities:
+			if err = r.DecOpenType(v.UESecurityCapabilities.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UESecurityCapabilities", i))
+				return
+			}
+			hasUESecurityCapabilities = true
+		case idSecurityKey:
+			if err = r.DecOpenType(v.SecurityKey.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.SecurityKey", i))
+				return
+			}
+			hasSecurityKey = true
+		case idTraceActivation:
+			v.TraceActivation = new(TraceActivation)
+			if err = r.DecOpenType(v.TraceActivation.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.TraceActivation", i))
+				return
+			}
+		case idMobilityRestrictionList:
+			v.MobilityRestrictionList = new(MobilityRestrictionList)
+			if err = r.DecOpenType(v.MobilityRestrictionList.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.MobilityRestrictionList", i))
+				return
+			}
+		case idUERadioCapability:
+			v.UERadioCapability = new(UERadioCapability)
+			if err = r.DecOpenType(v.UERadioCapability.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UERadioCapability", i))
+				return
+			}
+		case idIndexToRFSP:
+			v.IndexToRFSP = new(IndexToRFSP)
+			if err = r.DecOpenType(v.IndexToRFSP.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.IndexToRFSP", i))
+				return
+			}
+		case idMaskedIMEISV:
+			v.MaskedIMEISV = new(MaskedIMEISV)
+			if err = r.DecOpenType(v.MaskedIMEISV.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.MaskedIMEISV", i))
+				return
+			}
+		case idNASPDU:
+			v.NASPDU = new(NASPDU)
+			if err = r.DecOpenType(v.NASPDU.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.NAS-PDU", i))
+				return
+			}
+		case idEmergencyFallbackIndicator:
+			v.EmergencyFallbackIndicator = new(EmergencyFallbackIndicator)
+			if err = r.DecOpenType(v.EmergencyFallbackIndicator.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.EmergencyFallbackIndicator", i))
+				return
+			}
+		case idRRCInactiveTransitionReportRequest:
+			v.RRCInactiveTransitionReportRequest = new(RRCInactiveTransitionReportRequest)
+			if err = r.DecOpenType(v.RRCInactiveTransitionReportRequest.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RRCInactiveTransitionReportRequest", i))
+				return
+			}
+		case idUERadioCapabilityForPaging:
+			v.UERadioCapabilityForPaging = new(UERadioCapabilityForPaging)
+			if err = r.DecOpenType(v.UERadioCapabilityForPaging.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UERadioCapabilityForPaging", i))
+				return
+			}
+		case idRedirectionVoiceFallback:
+			v.RedirectionVoiceFallback = new(RedirectionVoiceFallback)
+			if err = r.DecOpenType(v.RedirectionVoiceFallback.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RedirectionVoiceFallback", i))
+				return
+			}
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasAMFUENGAPID == false {
+		err = &per.MissingIEError{IE: "AMFUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasRANUENGAPID == false {
+		err = &per.MissingIEError{IE: "RANUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasGUAMI == false {
+		err = &per.MissingIEError{IE: "GUAMI"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasAllowedNSSAI == false {
+		err = &per.MissingIEError{IE: "AllowedNSSAI"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasUESecurityCapabilities == false {
+		err = &per.MissingIEError{IE: "UESecurityCapabilities"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasSecurityKey == false {
+		err = &per.MissingIEError{IE: "SecurityKey"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// InitialContextSetupResponse is InitialContextSetupResponse in NGAP-PDU-Contents.
+type InitialContextSetupResponse struct {
+	AMFUENGAPID                               AMFUENGAPID
+	RANUENGAPID                               RANUENGAPID
+	PDUSessionResourceSetupListCxtRes         *PDUSessionResourceSetupListCxtRes
+	PDUSessionResourceFailedToSetupListCxtRes *PDUSessionResourceFailedToSetupListCxtRes
+	CriticalityDiagnostics                    *CriticalityDiagnostics
+}
+
+func (v InitialContextSetupResponse) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "InitialContextSetupResponse")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 2
+	if v.PDUSessionResourceSetupListCxtRes != nil {
+		n++
+	}
+	if v.PDUSessionResourceFailedToSetupListCxtRes != nil {
+		n++
+	}
+	if v.CriticalityDiagnostics != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idAMFUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.AMFUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idRANUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.RANUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if v.PDUSessionResourceSetupListCxtRes != nil {
+		if err = ProtocolIEID(idPDUSessionResourceSetupListCxtRes).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.PDUSessionResourceSetupListCxtRes.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceSetupListCxtRes", i))
+			return
+		}
+		i++
+	}
+	if v.PDUSessionResourceFailedToSetupListCxtRes != nil {
+		if err = ProtocolIEID(idPDUSessionResourceFailedToSetupListCxtRes).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.PDUSessionResourceFailedToSetupListCxtRes.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceFailedToSetupListCxtRes", i))
+			return
+		}
+		i++
+	}
+	if v.CriticalityDiagnostics != nil {
+		if err = ProtocolIEID(idCriticalityDiagnostics).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.CriticalityDiagnostics.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+			return
+		}
+		i++
+	}
+	return
+}
+
+func (v *InitialContextSetupResponse) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "InitialContextSetupResponse")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = InitialContextSetupResponse{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasAMFUENGAPID, hasRANUENGAPID bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idAMFUENGAPID:
+			if err = r.DecOpenType(v.AMFUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+				return
+			}
+			hasAMFUENGAPID = true
+		case idRANUENGAPID:
+			if err = r.DecOpenType(v.RANUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+				return
+			}
+			hasRANUENGAPID = true
+		case idPDUSessionResourceSetupListCxtRes:
+			v.PDUSessionResourceSetupListCxtRes = new(PDUSessionResourceSetupListCxtRes)
+			if err = r.DecOpenType(v.PDUSessionResourceSetupListCxtRes.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceSetupListCxtRes", i))
+				return
+			}
+		case idPDUSessionResourceFailedToSetupListCxtRes:
+			v.PDUSessionResourceFailedToSetupListCxtRes = new(PDUSessionResourceFailedToSetupListCxtRes)
+			if err = r.DecOpenType(v.PDUSessionResourceFailedToSetupListCxtRes.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceFailedToSetupListCxtRes", i))
+				return
+			}
+		case idCriticalityDiagnostics:
+			v.CriticalityDiagnostics = new(CriticalityDiagnostics)
+			if err = r.DecOpenType(v.CriticalityDiagnostics.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+				return
+			}
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasAMFUENGAPID == false {
+		err = &per.MissingIEError{IE: "AMFUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasRANUENGAPID == false {
+		err = &per.MissingIEError{IE: "RANUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// InitialContextSetupFailure is InitialContextSetupFailure in NGAP-PDU-Contents.
+type InitialContextSetupFailure struct {
+	AMFUENGAPID                                AMFUENGAPID
+	RANUENGAPID                                RANUENGAPID
+	PDUSessionResourceFailedToSetupListCxtFail *PDUSessionResourceFailedToSetupListCxtFail
+	Cause                                      Cause
+	CriticalityDiagnostics                     *CriticalityDiagnostics
+}
+
+func (v InitialContextSetupFailure) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "InitialContextSetupFailure")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 3
+	if v.PDUSessionResourceFailedToSetupListCxtFail != nil {
+		n++
+	}
+	if v.CriticalityDiagnostics != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idAMFUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.AMFUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idRANUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.RANUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if v.PDUSessionResourceFailedToSetupListCxtFail != nil {
+		if err = ProtocolIEID(idPDUSessionResourceFailedToSetupListCxtFail).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.PDUSessionResourceFailedToSetupListCxtFail.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceFailedToSetupListCxtFail", i))
+			return
+		}
+		i++
+	}
+	if err = ProtocolIEID(idCause).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.Cause.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.Cause", i))
+		return
+	}
+	i++
+	if v.CriticalityDiagnostics != nil {
+		if err = ProtocolIEID(idCriticalityDiagnostics).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.CriticalityDiagnostics.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+			return
+		}
+		i++
+	}
+	return
+}
+
+func (v *InitialContextSetupFailure) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "InitialContextSetupFailure")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = InitialContextSetupFailure{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasAMFUENGAPID, hasRANUENGAPID, hasCause bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idAMFUENGAPID:
+			if err = r.DecOpenType(v.AMFUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+				return
+			}
+			hasAMFUENGAPID = true
+		case idRANUENGAPID:
+			if err = r.DecOpenType(v.RANUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+				return
+			}
+			hasRANUENGAPID = true
+		case idPDUSessionResourceFailedToSetupListCxtFail:
+			v.PDUSessionResourceFailedToSetupListCxtFail = new(PDUSessionResourceFailedToSetupListCxtFail)
+			if err = r.DecOpenType(v.PDUSessionResourceFailedToSetupListCxtFail.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceFailedToSetupListCxtFail", i))
+				return
+			}
+		case idCause:
+			if err = r.DecOpenType(v.Cause.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.Cause", i))
+				return
+			}
+			hasCause = true
+		case idCriticalityDiagnostics:
+			v.CriticalityDiagnostics = new(CriticalityDiagnostics)
+			if err = r.DecOpenType(v.CriticalityDiagnostics.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+				return
+			}
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasAMFUENGAPID == false {
+		err = &per.MissingIEError{IE: "AMFUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasRANUENGAPID == false {
+		err = &per.MissingIEError{IE: "RANUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasCause == false {
+		err = &per.MissingIEError{IE: "Cause"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceSetupRequest is PDUSessionResourceSetupRequest in NGAP-PDU-Contents.
+type PDUSessionResourceSetupRequest struct {
+	AMFUENGAPID                      AMFUENGAPID
+	RANUENGAPID                      RANUENGAPID
+	RANPagingPriority                *RANPagingPriority
+	NASPDU                           *NASPDU
+	PDUSessionResourceSetupListSUReq PDUSessionResourceSetupListSUReq
+	UEAggregateMaximumBitRate        *UEAggregateMaximumBitRate
+}
+
+func (v PDUSessionResourceSetupRequest) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "PDUSessionResourceSetupRequest")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 3
+	if v.RANPagingPriority != nil {
+		n++
+	}
+	if v.NASPDU != nil {
+		n++
+	}
+	if v.UEAggregateMaximumBitRate != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idAMFUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.AMFUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idRANUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.RANUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if v.RANPagingPriority != nil {
+		if err = ProtocolIEID(idRANPagingPriority).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.RANPagingPriority.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RANPagingPriority", i))
+			return
+		}
+		i++
+	}
+	if v.NASPDU != nil {
+		if err = ProtocolIEID(idNASPDU).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.NASPDU.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.NAS-PDU", i))
+			return
+		}
+		i++
+	}
+	if err = ProtocolIEID(idPDUSessionResourceSetupListSUReq).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.PDUSessionResourceSetupListSUReq.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceSetupListSUReq", i))
+		return
+	}
+	i++
+	if v.UEAggregateMaximumBitRate != nil {
+		if err = ProtocolIEID(idUEAggregateMaximumBitRate).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.UEAggregateMaximumBitRate.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UEAggregateMaximumBitRate", i))
+			return
+		}
+		i++
+	}
+	return
+}
+
+func (v *PDUSessionResourceSetupRequest) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "PDUSessionResourceSetupRequest")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceSetupRequest{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasAMFUENGAPID, hasRANUENGAPID, hasPDUSessionResourceSetupListSUReq bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idAMFUENGAPID:
+			if err = r.DecOpenType(v.AMFUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+				return
+			}
+			hasAMFUENGAPID = true
+		case idRANUENGAPID:
+			if err = r.DecOpenType(v.RANUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+				return
+			}
+			hasRANUENGAPID = true
+		case idRANPagingPriority:
+			v.RANPagingPriority = new(RANPagingPriority)
+			if err = r.DecOpenType(v.RANPagingPriority.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RANPagingPriority", i))
+				return
+			}
+		case idNASPDU:
+			v.NASPDU = new(NASPDU)
+			if err = r.DecOpenType(v.NASPDU.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.NAS-PDU", i))
+				return
+			}
+		case idPDUSessionResourceSetupListSUReq:
+			if err = r.DecOpenType(v.PDUSessionResourceSetupListSUReq.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceSetupListSUReq", i))
+				return
+			}
+			hasPDUSessionResourceSetupListSUReq = true
+		case idUEAggregateMaximumBitRate:
+			v.UEAggregateMaximumBitRate = new(UEAggregateMaximumBitRate)
+			if err = r.DecOpenType(v.UEAggregateMaximumBitRate.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UEAggregateMaximumBitRate", i))
+				return
+			}
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasAMFUENGAPID == false {
+		err = &per.MissingIEError{IE: "AMFUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasRANUENGAPID == false {
+		err = &per.MissingIEError{IE: "RANUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasPDUSessionResourceSetupListSUReq == false {
+		err = &per.MissingIEError{IE: "PDUSessionResourceSetupListSUReq"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceSetupResponse is PDUSessionResourceSetupResponse in NGAP-PDU-Contents.
+type PDUSessionResourceSetupResponse struct {
+	AMFUENGAPID                              AMFUENGAPID
+	RANUENGAPID                              RANUENGAPID
+	PDUSessionResourceSetupListSURes         *PDUSessionResourceSetupListSURes
+	PDUSessionResourceFailedToSetupListSURes *PDUSessionResourceFailedToSetupListSURes
+	CriticalityDiagnostics                   *CriticalityDiagnostics
+}
+
+func (v PDUSessionResourceSetupResponse) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "PDUSessionResourceSetupResponse")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 2
+	if v.PDUSessionResourceSetupListSURes != nil {
+		n++
+	}
+	if v.PDUSessionResourceFailedToSetupListSURes != nil {
+		n++
+	}
+	if v.CriticalityDiagnostics != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idAMFUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.AMFUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idRANUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.RANUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if v.PDUSessionResourceSetupListSURes != nil {
+		if err = ProtocolIEID(idPDUSessionResourceSetupListSURes).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.PDUSessionResourceSetupListSURes.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceSetupListSURes", i))
+			return
+		}
+		i++
+	}
+	if v.PDUSessionResourceFailedToSetupListSURes != nil {
+		if err = ProtocolIEID(idPDUSessionResourceFailedToSetupListSURes).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.PDUSessionResourceFailedToSetupListSURes.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceFailedToSetupListSURes", i))
+			return
+		}
+		i++
+	}
+	if v.CriticalityDiagnostics != nil {
+		if err = ProtocolIEID(idCriticalityDiagnostics).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.CriticalityDiagnostics.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+			return
+		}
+		i++
+	}
+	return
+}
+
+func (v *PDUSessionResourceSetupResponse) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "PDUSessionResourceSetupResponse")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceSetupResponse{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasAMFUENGAPID, hasRANUENGAPID bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idAMFUENGAPID:
+			if err = r.DecOpenType(v.AMFUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+				return
+			}
+			hasAMFUENGAPID = true
+		case idRANUENGAPID:
+			if err = r.DecOpenType(v.RANUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+				return
+			}
+			hasRANUENGAPID = true
+		case idPDUSessionResourceSetupListSURes:
+			v.PDUSessionResourceSetupListSURes = new(PDUSessionResourceSetupListSURes)
+			if err = r.DecOpenType(v.PDUSessionResourceSetupListSURes.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceSetupListSURes", i))
+				return
+			}
+		case idPDUSessionResourceFailedToSetupListSURes:
+			v.PDUSessionResourceFailedToSetupListSURes = new(PDUSessionResourceFailedToSetupListSURes)
+			if err = r.DecOpenType(v.PDUSessionResourceFailedToSetupListSURes.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceFailedToSetupListSURes", i))
+				return
+			}
+		case idCriticalityDiagnostics:
+			v.CriticalityDiagnostics = new(CriticalityDiagnostics)
+			if err = r.DecOpenType(v.CriticalityDiagnostics.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+				return
+			}
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasAMFUENGAPID == false {
+		err = &per.MissingIEError{IE: "AMFUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasRANUENGAPID == false {
+		err = &per.MissingIEError{IE: "RANUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceReleaseCommand is PDUSessionResourceReleaseCommand in NGAP-PDU-Contents.
+type PDUSessionResourceReleaseCommand struct {
+	AMFUENGAPID                           AMFUENGAPID
+	RANUENGAPID                           RANUENGAPID
+	RANPagingPriority                     *RANPagingPriority
+	NASPDU                                *NASPDU
+	PDUSessionResourceToReleaseListRelCmd PDUSessionResourceToReleaseListRelCmd
+}
+
+func (v PDUSessionResourceReleaseCommand) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "PDUSessionResourceReleaseCommand")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 3
+	if v.RANPagingPriority != nil {
+		n++
+	}
+	if v.NASPDU != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idAMFUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.AMFUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idRANUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.RANUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if v.RANPagingPriority != nil {
+		if err = ProtocolIEID(idRANPagingPriority).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.RANPagingPriority.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RANPagingPriority", i))
+			return
+		}
+		i++
+	}
+	if v.NASPDU != nil {
+		if err = ProtocolIEID(idNASPDU).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.NASPDU.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.NAS-PDU", i))
+			return
+		}
+		i++
+	}
+	if err = ProtocolIEID(idPDUSessionResourceToReleaseListRelCmd).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.PDUSessionResourceToReleaseListRelCmd.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceToReleaseListRelCmd", i))
+		return
+	}
+	i++
+	return
+}
+
+func (v *PDUSessionResourceReleaseCommand) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "PDUSessionResourceReleaseCommand")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceReleaseCommand{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasAMFUENGAPID, hasRANUENGAPID, hasPDUSessionResourceToReleaseListRelCmd bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idAMFUENGAPID:
+			if err = r.DecOpenType(v.AMFUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+				return
+			}
+			hasAMFUENGAPID = true
+		case idRANUENGAPID:
+			if err = r.DecOpenType(v.RANUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+				return
+			}
+			hasRANUENGAPID = true
+		case idRANPagingPriority:
+			v.RANPagingPriority = new(RANPagingPriority)
+			if err = r.DecOpenType(v.RANPagingPriority.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RANPagingPriority", i))
+				return
+			}
+		case idNASPDU:
+			v.NASPDU = new(NASPDU)
+			if err = r.DecOpenType(v.NASPDU.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.NAS-PDU", i))
+				return
+			}
+		case idPDUSessionResourceToReleaseListRelCmd:
+			if err = r.DecOpenType(v.PDUSessionResourceToReleaseListRelCmd.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceToReleaseListRelCmd", i))
+				return
+			}
+			hasPDUSessionResourceToReleaseListRelCmd = true
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasAMFUENGAPID == false {
+		err = &per.MissingIEError{IE: "AMFUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasRANUENGAPID == false {
+		err = &per.MissingIEError{IE: "RANUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasPDUSessionResourceToReleaseListRelCmd == false {
+		err = &per.MissingIEError{IE: "PDUSessionResourceToReleaseListRelCmd"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceReleaseResponse is PDUSessionResourceReleaseResponse in NGAP-PDU-Contents.
+type PDUSessionResourceReleaseResponse struct {
+	AMFUENGAPID                          AMFUENGAPID
+	RANUENGAPID                          RANUENGAPID
+	PDUSessionResourceReleasedListRelRes PDUSessionResourceReleasedListRelRes
+	UserLocationInformation              *UserLocationInformation
+	CriticalityDiagnostics               *CriticalityDiagnostics
+}
+
+func (v PDUSessionResourceReleaseResponse) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "PDUSessionResourceReleaseResponse")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 3
+	if v.UserLocationInformation != nil {
+		n++
+	}
+	if v.CriticalityDiagnostics != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idAMFUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.AMFUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idRANUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.RANUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idPDUSessionResourceReleasedListRelRes).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.PDUSessionResourceReleasedListRelRes.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceReleasedListRelRes", i))
+		return
+	}
+	i++
+	if v.UserLocationInformation != nil {
+		if err = ProtocolIEID(idUserLocationInformation).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.UserLocationInformation.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UserLocationInformation", i))
+			return
+		}
+		i++
+	}
+	if v.CriticalityDiagnostics != nil {
+		if err = ProtocolIEID(idCriticalityDiagnostics).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.CriticalityDiagnostics.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+			return
+		}
+		i++
+	}
+	return
+}
+
+func (v *PDUSessionResourceReleaseResponse) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "PDUSessionResourceReleaseResponse")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceReleaseResponse{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasAMFUENGAPID, hasRANUENGAPID, hasPDUSessionResourceReleasedListRelRes bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idAMFUENGAPID:
+			if err = r.DecOpenType(v.AMFUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+				return
+			}
+			hasAMFUENGAPID = true
+		case idRANUENGAPID:
+			if err = r.DecOpenType(v.RANUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+				return
+			}
+			hasRANUENGAPID = true
+		case idPDUSessionResourceReleasedListRelRes:
+			if err = r.DecOpenType(v.PDUSessionResourceReleasedListRelRes.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceReleasedListRelRes", i))
+				return
+			}
+			hasPDUSessionResourceReleasedListRelRes = true
+		case idUserLocationInformation:
+			v.UserLocationInformation = new(UserLocationInformation)
+			if err = r.DecOpenType(v.UserLocationInformation.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UserLocationInformation", i))
+				return
+			}
+		case idCriticalityDiagnostics:
+			v.CriticalityDiagnostics = new(CriticalityDiagnostics)
+			if err = r.DecOpenType(v.CriticalityDiagnostics.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+				return
+			}
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasAMFUENGAPID == false {
+		err = &per.MissingIEError{IE: "AMFUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasRANUENGAPID == false {
+		err = &per.MissingIEError{IE: "RANUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasPDUSessionResourceReleasedListRelRes == false {
+		err = &per.MissingIEError{IE: "PDUSessionResourceReleasedListRelRes"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceModifyRequest is PDUSessionResourceModifyRequest in NGAP-PDU-Contents.
+type PDUSessionResourceModifyRequest struct {
+	AMFUENGAPID                        AMFUENGAPID
+	RANUENGAPID                        RANUENGAPID
+	RANPagingPriority                  *RANPagingPriority
+	PDUSessionResourceModifyListModReq PDUSessionResourceModifyListModReq
+}
+
+func (v PDUSessionResourceModifyRequest) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "PDUSessionResourceModifyRequest")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 3
+	if v.RANPagingPriority != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idAMFUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.AMFUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idRANUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.RANUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if v.RANPagingPriority != nil {
+		if err = ProtocolIEID(idRANPagingPriority).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.RANPagingPriority.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RANPagingPriority", i))
+			return
+		}
+		i++
+	}
+	if err = ProtocolIEID(idPDUSessionResourceModifyListModReq).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.PDUSessionResourceModifyListModReq.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceModifyListModReq", i))
+		return
+	}
+	i++
+	return
+}
+
+func (v *PDUSessionResourceModifyRequest) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "PDUSessionResourceModifyRequest")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceModifyRequest{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasAMFUENGAPID, hasRANUENGAPID, hasPDUSessionResourceModifyListModReq bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idAMFUENGAPID:
+			if err = r.DecOpenType(v.AMFUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+				return
+			}
+			hasAMFUENGAPID = true
+		case idRANUENGAPID:
+			if err = r.DecOpenType(v.RANUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+				return
+			}
+			hasRANUENGAPID = true
+		case idRANPagingPriority:
+			v.RANPagingPriority = new(RANPagingPriority)
+			if err = r.DecOpenType(v.RANPagingPriority.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RANPagingPriority", i))
+				return
+			}
+		case idPDUSessionResourceModifyListModReq:
+			if err = r.DecOpenType(v.PDUSessionResourceModifyListModReq.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceModifyListModReq", i))
+				return
+			}
+			hasPDUSessionResourceModifyListModReq = true
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasAMFUENGAPID == false {
+		err = &per.MissingIEError{IE: "AMFUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasRANUENGAPID == false {
+		err = &per.MissingIEError{IE: "RANUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasPDUSessionResourceModifyListModReq == false {
+		err = &per.MissingIEError{IE: "PDUSessionResourceModifyListModReq"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceModifyResponse is PDUSessionResourceModifyResponse in NGAP-PDU-Contents.
+type PDUSessionResourceModifyResponse struct {
+	AMFUENGAPID                                AMFUENGAPID
+	RANUENGAPID                                RANUENGAPID
+	PDUSessionResourceModifyListModRes         *PDUSessionResourceModifyListModRes
+	PDUSessionResourceFailedToModifyListModRes *PDUSessionResourceFailedToModifyListModRes
+	UserLocationInformation                    *UserLocationInformation
+	CriticalityDiagnostics                     *CriticalityDiagnostics
+}
+
+func (v PDUSessionResourceModifyResponse) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "PDUSessionResourceModifyResponse")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 2
+	if v.PDUSessionResourceModifyListModRes != nil {
+		n++
+	}
+	if v.PDUSessionResourceFailedToModifyListModRes != nil {
+		n++
+	}
+	if v.UserLocationInformation != nil {
+		n++
+	}
+	if v.CriticalityDiagnostics != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idAMFUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.AMFUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idRANUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.RANUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if v.PDUSessionResourceModifyListModRes != nil {
+		if err = ProtocolIEID(idPDUSessionResourceModifyListModRes).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.PDUSessionResourceModifyListModRes.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceModifyListModRes", i))
+			return
+		}
+		i++
+	}
+	if v.PDUSessionResourceFailedToModifyListModRes != nil {
+		if err = ProtocolIEID(idPDUSessionResourceFailedToModifyListModRes).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.PDUSessionResourceFailedToModifyListModRes.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceFailedToModifyListModRes", i))
+			return
+		}
+		i++
+	}
+	if v.UserLocationInformation != nil {
+		if err = ProtocolIEID(idUserLocationInformation).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.UserLocationInformation.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UserLocationInformation", i))
+			return
+		}
+		i++
+	}
+	if v.CriticalityDiagnostics != nil {
+		if err = ProtocolIEID(idCriticalityDiagnostics).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.CriticalityDiagnostics.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+			return
+		}
+		i++
+	}
+	return
+}
+
+func (v *PDUSessionResourceModifyResponse) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "PDUSessionResourceModifyResponse")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceModifyResponse{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasAMFUENGAPID, hasRANUENGAPID bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idAMFUENGAPID:
+			if err = r.DecOpenType(v.AMFUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+				return
+			}
+			hasAMFUENGAPID = true
+		case idRANUENGAPID:
+			if err = r.DecOpenType(v.RANUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+				return
+			}
+			hasRANUENGAPID = true
+		case idPDUSessionResourceModifyListModRes:
+			v.PDUSessionResourceModifyListModRes = new(PDUSessionResourceModifyListModRes)
+			if err = r.DecOpenType(v.PDUSessionResourceModifyListModRes.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceModifyListModRes", i))
+				return
+			}
+		case idPDUSessionResourceFailedToModifyListModRes:
+			v.PDUSessionResourceFailedToModifyListModRes = new(PDUSessionResourceFailedToModifyListModRes)
+			if err = r.DecOpenType(v.PDUSessionResourceFailedToModifyListModRes.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceFailedToModifyListModRes", i))
+				return
+			}
+		case idUserLocationInformation:
+			v.UserLocationInformation = new(UserLocationInformation)
+			if err = r.DecOpenType(v.UserLocationInformation.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UserLocationInformation", i))
+				return
+			}
+		case idCriticalityDiagnostics:
+			v.CriticalityDiagnostics = new(CriticalityDiagnostics)
+			if err = r.DecOpenType(v.CriticalityDiagnostics.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+				return
+			}
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasAMFUENGAPID == false {
+		err = &per.MissingIEError{IE: "AMFUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasRANUENGAPID == false {
+		err = &per.MissingIEError{IE: "RANUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceNotify is PDUSessionResourceNotify in NGAP-PDU-Contents.
+type PDUSessionResourceNotify struct {
+	AMFUENGAPID                       AMFUENGAPID
+	RANUENGAPID                       RANUENGAPID
+	PDUSessionResourceNotifyList      *PDUSessionResourceNotifyList
+	PDUSessionResourceReleasedListNot *PDUSessionResourceReleasedListNot
+	UserLocationInformation           *UserLocationInformation
+}
+
+func (v PDUSessionResourceNotify) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "PDUSessionResourceNotify")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 2
+	if v.PDUSessionResourceNotifyList != nil {
+		n++
+	}
+	if v.PDUSessionResourceReleasedListNot != nil {
+		n++
+	}
+	if v.UserLocationInformation != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idAMFUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.AMFUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idRANUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.RANUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if v.PDUSessionResourceNotifyList != nil {
+		if err = ProtocolIEID(idPDUSessionResourceNotifyList).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.PDUSessionResourceNotifyList.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceNotifyList", i))
+			return
+		}
+		i++
+	}
+	if v.PDUSessionResourceReleasedListNot != nil {
+		if err = ProtocolIEID(idPDUSessionResourceReleasedListNot).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.PDUSessionResourceReleasedListNot.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceReleasedListNot", i))
+			return
+		}
+		i++
+	}
+	if v.UserLocationInformation != nil {
+		if err = ProtocolIEID(idUserLocationInformation).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.UserLocationInformation.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UserLocationInformation", i))
+			return
+		}
+		i++
+	}
+	return
+}
+
+func (v *PDUSessionResourceNotify) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "PDUSessionResourceNotify")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceNotify{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasAMFUENGAPID, hasRANUENGAPID bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idAMFUENGAPID:
+			if err = r.DecOpenType(v.AMFUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+				return
+			}
+			hasAMFUENGAPID = true
+		case idRANUENGAPID:
+			if err = r.DecOpenType(v.RANUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+				return
+			}
+			hasRANUENGAPID = true
+		case idPDUSessionResourceNotifyList:
+			v.PDUSessionResourceNotifyList = new(PDUSessionResourceNotifyList)
+			if err = r.DecOpenType(v.PDUSessionResourceNotifyList.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceNotifyList", i))
+				return
+			}
+		case idPDUSessionResourceReleasedListNot:
+			v.PDUSessionResourceReleasedListNot = new(PDUSessionResourceReleasedListNot)
+			if err = r.DecOpenType(v.PDUSessionResourceReleasedListNot.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceReleasedListNot", i))
+				return
+			}
+		case idUserLocationInformation:
+			v.UserLocationInformation = new(UserLocationInformation)
+			if err = r.DecOpenType(v.UserLocationInformation.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UserLocationInformation", i))
+				return
+			}
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasAMFUENGAPID == false {
+		err = &per.MissingIEError{IE: "AMFUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasRANUENGAPID == false {
+		err = &per.MissingIEError{IE: "RANUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceModifyIndication is PDUSessionResourceModifyIndication in NGAP-PDU-Contents.
+type PDUSessionResourceModifyIndication struct {
+	AMFUENGAPID                        AMFUENGAPID
+	RANUENGAPID                        RANUENGAPID
+	PDUSessionResourceModifyListModInd PDUSessionResourceModifyListModInd
+}
+
+func (v PDUSessionResourceModifyIndication) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "PDUSessionResourceModifyIndication")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 3
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idAMFUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.AMFUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idRANUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.RANUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idPDUSessionResourceModifyListModInd).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.PDUSessionResourceModifyListModInd.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceModifyListModInd", i))
+		return
+	}
+	i++
+	return
+}
+
+func (v *PDUSessionResourceModifyIndication) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "PDUSessionResourceModifyIndication")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceModifyIndication{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasAMFUENGAPID, hasRANUENGAPID, hasPDUSessionResourceModifyListModInd bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idAMFUENGAPID:
+			if err = r.DecOpenType(v.AMFUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+				return
+			}
+			hasAMFUENGAPID = true
+		case idRANUENGAPID:
+			if err = r.DecOpenType(v.RANUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+				return
+			}
+			hasRANUENGAPID = true
+		case idPDUSessionResourceModifyListModInd:
+			if err = r.DecOpenType(v.PDUSessionResourceModifyListModInd.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceModifyListModInd", i))
+				return
+			}
+			hasPDUSessionResourceModifyListModInd = true
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasAMFUENGAPID == false {
+		err = &per.MissingIEError{IE: "AMFUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasRANUENGAPID == false {
+		err = &per.MissingIEError{IE: "RANUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasPDUSessionResourceModifyListModInd == false {
+		err = &per.MissingIEError{IE: "PDUSessionResourceModifyListModInd"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// PDUSessionResourceModifyConfirm is PDUSessionResourceModifyConfirm in NGAP-PDU-Contents.
+type PDUSessionResourceModifyConfirm struct {
+	AMFUENGAPID                                AMFUENGAPID
+	RANUENGAPID                                RANUENGAPID
+	PDUSessionResourceModifyListModCfm         PDUSessionResourceModifyListModCfm
+	PDUSessionResourceFailedToModifyListModCfm *PDUSessionResourceFailedToModifyListModCfm
+	CriticalityDiagnostics                     *CriticalityDiagnostics
+}
+
+func (v PDUSessionResourceModifyConfirm) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "PDUSessionResourceModifyConfirm")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 3
+	if v.PDUSessionResourceFailedToModifyListModCfm != nil {
+		n++
+	}
+	if v.CriticalityDiagnostics != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idAMFUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.AMFUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idRANUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.RANUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idPDUSessionResourceModifyListModCfm).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.PDUSessionResourceModifyListModCfm.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceModifyListModCfm", i))
+		return
+	}
+	i++
+	if v.PDUSessionResourceFailedToModifyListModCfm != nil {
+		if err = ProtocolIEID(idPDUSessionResourceFailedToModifyListModCfm).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.PDUSessionResourceFailedToModifyListModCfm.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceFailedToModifyListModCfm", i))
+			return
+		}
+		i++
+	}
+	if v.CriticalityDiagnostics != nil {
+		if err = ProtocolIEID(idCriticalityDiagnostics).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.CriticalityDiagnostics.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+			return
+		}
+		i++
+	}
+	return
+}
+
+func (v *PDUSessionResourceModifyConfirm) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "PDUSessionResourceModifyConfirm")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = PDUSessionResourceModifyConfirm{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasAMFUENGAPID, hasRANUENGAPID, hasPDUSessionResourceModifyListModCfm bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idAMFUENGAPID:
+			if err = r.DecOpenType(v.AMFUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+				return
+			}
+			hasAMFUENGAPID = true
+		case idRANUENGAPID:
+			if err = r.DecOpenType(v.RANUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+				return
+			}
+			hasRANUENGAPID = true
+		case idPDUSessionResourceModifyListModCfm:
+			if err = r.DecOpenType(v.PDUSessionResourceModifyListModCfm.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceModifyListModCfm", i))
+				return
+			}
+			hasPDUSessionResourceModifyListModCfm = true
+		case idPDUSessionResourceFailedToModifyListModCfm:
+			v.PDUSessionResourceFailedToModifyListModCfm = new(PDUSessionResourceFailedToModifyListModCfm)
+			if err = r.DecOpenType(v.PDUSessionResourceFailedToModifyListModCfm.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceFailedToModifyListModCfm", i))
+				return
+			}
+		case idCriticalityDiagnostics:
+			v.CriticalityDiagnostics = new(CriticalityDiagnostics)
+			if err = r.DecOpenType(v.CriticalityDiagnostics.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+				return
+			}
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasAMFUENGAPID == false {
+		err = &per.MissingIEError{IE: "AMFUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasRANUENGAPID == false {
+		err = &per.MissingIEError{IE: "RANUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasPDUSessionResourceModifyListModCfm == false {
+		err = &per.MissingIEError{IE: "PDUSessionResourceModifyListModCfm"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// UEContextReleaseRequest is UEContextReleaseRequest in NGAP-PDU-Contents.
+type UEContextReleaseRequest struct {
+	AMFUENGAPID                     AMFUENGAPID
+	RANUENGAPID                     RANUENGAPID
+	PDUSessionResourceListCxtRelReq *PDUSessionResourceListCxtRelReq
+	Cause                           Cause
+}
+
+func (v UEContextReleaseRequest) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "UEContextReleaseRequest")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 3
+	if v.PDUSessionResourceListCxtRelReq != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idAMFUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.AMFUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idRANUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.RANUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if v.PDUSessionResourceListCxtRelReq != nil {
+		if err = ProtocolIEID(idPDUSessionResourceListCxtRelReq).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.PDUSessionResourceListCxtRelReq.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceListCxtRelReq", i))
+			return
+		}
+		i++
+	}
+	if err = ProtocolIEID(idCause).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.Cause.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.Cause", i))
+		return
+	}
+	i++
+	return
+}
+
+func (v *UEContextReleaseRequest) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "UEContextReleaseRequest")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = UEContextReleaseRequest{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasAMFUENGAPID, hasRANUENGAPID, hasCause bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idAMFUENGAPID:
+			if err = r.DecOpenType(v.AMFUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+				return
+			}
+			hasAMFUENGAPID = true
+		case idRANUENGAPID:
+			if err = r.DecOpenType(v.RANUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+				return
+			}
+			hasRANUENGAPID = true
+		case idPDUSessionResourceListCxtRelReq:
+			v.PDUSessionResourceListCxtRelReq = new(PDUSessionResourceListCxtRelReq)
+			if err = r.DecOpenType(v.PDUSessionResourceListCxtRelReq.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceListCxtRelReq", i))
+				return
+			}
+		case idCause:
+			if err = r.DecOpenType(v.Cause.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.Cause", i))
+				return
+			}
+			hasCause = true
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasAMFUENGAPID == false {
+		err = &per.MissingIEError{IE: "AMFUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasRANUENGAPID == false {
+		err = &per.MissingIEError{IE: "RANUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasCause == false {
+		err = &per.MissingIEError{IE: "Cause"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// UEContextReleaseCommand is UEContextReleaseCommand in NGAP-PDU-Contents.
+type UEContextReleaseCommand struct {
+	UENGAPIDs UENGAPIDs
+	Cause     Cause
+}
+
+func (v UEContextReleaseCommand) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "UEContextReleaseCommand")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 2
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idUENGAPIDs).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.UENGAPIDs.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UE-NGAP-IDs", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idCause).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.Cause.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.Cause", i))
+		return
+	}
+	i++
+	return
+}
+
+func (v *UEContextReleaseCommand) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "UEContextReleaseCommand")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = UEContextReleaseCommand{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasUENGAPIDs, hasCause bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idUENGAPIDs:
+			if err = r.DecOpenType(v.UENGAPIDs.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UE-NGAP-IDs", i))
+				return
+			}
+			hasUENGAPIDs = true
+		case idCause:
+			if err = r.DecOpenType(v.Cause.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.Cause", i))
+				return
+			}
+			hasCause = true
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasUENGAPIDs == false {
+		err = &per.MissingIEError{IE: "UENGAPIDs"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasCause == false {
+		err = &per.MissingIEError{IE: "Cause"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// UEContextReleaseComplete is UEContextReleaseComplete in NGAP-PDU-Contents.
+type UEContextReleaseComplete struct {
+	AMFUENGAPID                                AMFUENGAPID
+	RANUENGAPID                                RANUENGAPID
+	UserLocationInformation                    *UserLocationInformation
+	InfoOnRecommendedCellsAndRANNodesForPaging *InfoOnRecommendedCellsAndRANNodesForPaging
+	PDUSessionResourceListCxtRelCpl            *PDUSessionResourceListCxtRelCpl
+	CriticalityDiagnostics                     *CriticalityDiagnostics
+}
+
+func (v UEContextReleaseComplete) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "UEContextReleaseComplete")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 2
+	if v.UserLocationInformation != nil {
+		n++
+	}
+	if v.InfoOnRecommendedCellsAndRANNodesForPaging != nil {
+		n++
+	}
+	if v.PDUSessionResourceListCxtRelCpl != nil {
+		n++
+	}
+	if v.CriticalityDiagnostics != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idAMFUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.AMFUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idRANUENGAPID).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.RANUENGAPID.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+		return
+	}
+	i++
+	if v.UserLocationInformation != nil {
+		if err = ProtocolIEID(idUserLocationInformation).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.UserLocationInformation.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UserLocationInformation", i))
+			return
+		}
+		i++
+	}
+	if v.InfoOnRecommendedCellsAndRANNodesForPaging != nil {
+		if err = ProtocolIEID(idInfoOnRecommendedCellsAndRANNodesForPaging).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.InfoOnRecommendedCellsAndRANNodesForPaging.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.InfoOnRecommendedCellsAndRANNodesForPaging", i))
+			return
+		}
+		i++
+	}
+	if v.PDUSessionResourceListCxtRelCpl != nil {
+		if err = ProtocolIEID(idPDUSessionResourceListCxtRelCpl).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityReject.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.PDUSessionResourceListCxtRelCpl.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceListCxtRelCpl", i))
+			return
+		}
+		i++
+	}
+	if v.CriticalityDiagnostics != nil {
+		if err = ProtocolIEID(idCriticalityDiagnostics).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.CriticalityDiagnostics.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+			return
+		}
+		i++
+	}
+	return
+}
+
+func (v *UEContextReleaseComplete) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "UEContextReleaseComplete")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = UEContextReleaseComplete{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasAMFUENGAPID, hasRANUENGAPID bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idAMFUENGAPID:
+			if err = r.DecOpenType(v.AMFUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+				return
+			}
+			hasAMFUENGAPID = true
+		case idRANUENGAPID:
+			if err = r.DecOpenType(v.RANUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+				return
+			}
+			hasRANUENGAPID = true
+		case idUserLocationInformation:
+			v.UserLocationInformation = new(UserLocationInformation)
+			if err = r.DecOpenType(v.UserLocationInformation.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UserLocationInformation", i))
+				return
+			}
+		case idInfoOnRecommendedCellsAndRANNodesForPaging:
+			v.InfoOnRecommendedCellsAndRANNodesForPaging = new(InfoOnRecommendedCellsAndRANNodesForPaging)
+			if err = r.DecOpenType(v.InfoOnRecommendedCellsAndRANNodesForPaging.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.InfoOnRecommendedCellsAndRANNodesForPaging", i))
+				return
+			}
+		case idPDUSessionResourceListCxtRelCpl:
+			v.PDUSessionResourceListCxtRelCpl = new(PDUSessionResourceListCxtRelCpl)
+			if err = r.DecOpenType(v.PDUSessionResourceListCxtRelCpl.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PDUSessionResourceListCxtRelCpl", i))
+				return
+			}
+		case idCriticalityDiagnostics:
+			v.CriticalityDiagnostics = new(CriticalityDiagnostics)
+			if err = r.DecOpenType(v.CriticalityDiagnostics.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+				return
+			}
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasAMFUENGAPID == false {
+		err = &per.MissingIEError{IE: "AMFUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasRANUENGAPID == false {
+		err = &per.MissingIEError{IE: "RANUENGAPID"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// Paging is Paging in NGAP-PDU-Contents.
+type Paging struct {
+	UEPagingIdentity           UEPagingIdentity
+	PagingDRX                  *PagingDRX
+	TAIListForPaging           TAIListForPaging
+	PagingPriority             *PagingPriority
+	UERadioCapabilityForPaging *UERadioCapabilityForPaging
+	PagingOrigin               *PagingOrigin
+	AssistanceDataForPaging    *AssistanceDataForPaging
+}
+
+func (v Paging) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "Paging")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 2
+	if v.PagingDRX != nil {
+		n++
+	}
+	if v.PagingPriority != nil {
+		n++
+	}
+	if v.UERadioCapabilityForPaging != nil {
+		n++
+	}
+	if v.PagingOrigin != nil {
+		n++
+	}
+	if v.AssistanceDataForPaging != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idUEPagingIdentity).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.UEPagingIdentity.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UEPagingIdentity", i))
+		return
+	}
+	i++
+	if v.PagingDRX != nil {
+		if err = ProtocolIEID(idPagingDRX).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.PagingDRX.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PagingDRX", i))
+			return
+		}
+		i++
+	}
+	if err = ProtocolIEID(idTAIListForPaging).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.TAIListForPaging.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.TAIListForPaging", i))
+		return
+	}
+	i++
+	if v.PagingPriority != nil {
+		if err = ProtocolIEID(idPagingPriority).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.PagingPriority.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PagingPriority", i))
+			return
+		}
+		i++
+	}
+	if v.UERadioCapabilityForPaging != nil {
+		if err = ProtocolIEID(idUERadioCapabilityForPaging).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.UERadioCapabilityForPaging.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UERadioCapabilityForPaging", i))
+			return
+		}
+		i++
+	}
+	if v.PagingOrigin != nil {
+		if err = ProtocolIEID(idPagingOrigin).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.PagingOrigin.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PagingOrigin", i))
+			return
+		}
+		i++
+	}
+	if v.AssistanceDataForPaging != nil {
+		if err = ProtocolIEID(idAssistanceDataForPaging).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.AssistanceDataForPaging.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AssistanceDataForPaging", i))
+			return
+		}
+		i++
+	}
+	return
+}
+
+func (v *Paging) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "Paging")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = Paging{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasUEPagingIdentity, hasTAIListForPaging bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idUEPagingIdentity:
+			if err = r.DecOpenType(v.UEPagingIdentity.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UEPagingIdentity", i))
+				return
+			}
+			hasUEPagingIdentity = true
+		case idPagingDRX:
+			v.PagingDRX = new(PagingDRX)
+			if err = r.DecOpenType(v.PagingDRX.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PagingDRX", i))
+				return
+			}
+		case idTAIListForPaging:
+			if err = r.DecOpenType(v.TAIListForPaging.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.TAIListForPaging", i))
+				return
+			}
+			hasTAIListForPaging = true
+		case idPagingPriority:
+			v.PagingPriority = new(PagingPriority)
+			if err = r.DecOpenType(v.PagingPriority.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PagingPriority", i))
+				return
+			}
+		case idUERadioCapabilityForPaging:
+			v.UERadioCapabilityForPaging = new(UERadioCapabilityForPaging)
+			if err = r.DecOpenType(v.UERadioCapabilityForPaging.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UERadioCapabilityForPaging", i))
+				return
+			}
+		case idPagingOrigin:
+			v.PagingOrigin = new(PagingOrigin)
+			if err = r.DecOpenType(v.PagingOrigin.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.PagingOrigin", i))
+				return
+			}
+		case idAssistanceDataForPaging:
+			v.AssistanceDataForPaging = new(AssistanceDataForPaging)
+			if err = r.DecOpenType(v.AssistanceDataForPaging.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AssistanceDataForPaging", i))
+				return
+			}
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasUEPagingIdentity == false {
+		err = &per.MissingIEError{IE: "UEPagingIdentity"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasTAIListForPaging == false {
+		err = &per.MissingIEError{IE: "TAIListForPaging"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// NGReset is NGReset in NGAP-PDU-Contents.
+type NGReset struct {
+	Cause     Cause
+	ResetType ResetType
+}
+
+func (v NGReset) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "NGReset")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 2
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if err = ProtocolIEID(idCause).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityIgnore.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.Cause.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.Cause", i))
+		return
+	}
+	i++
+	if err = ProtocolIEID(idResetType).MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+		return
+	}
+	if err = CriticalityReject.MarshalPER(w); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+		return
+	}
+	if err = w.EncOpenType(v.ResetType.MarshalPER); err != nil {
+		err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.ResetType", i))
+		return
+	}
+	i++
+	return
+}
+
+func (v *NGReset) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "NGReset")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = NGReset{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	var hasCause, hasResetType bool
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idCause:
+			if err = r.DecOpenType(v.Cause.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.Cause", i))
+				return
+			}
+			hasCause = true
+		case idResetType:
+			if err = r.DecOpenType(v.ResetType.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.ResetType", i))
+				return
+			}
+			hasResetType = true
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if hasCause == false {
+		err = &per.MissingIEError{IE: "Cause"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if hasResetType == false {
+		err = &per.MissingIEError{IE: "ResetType"}
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// NGResetAcknowledge is NGResetAcknowledge in NGAP-PDU-Contents.
+type NGResetAcknowledge struct {
+	UEAssociatedLogicalNGConnectionList *UEAssociatedLogicalNGConnectionList
+	CriticalityDiagnostics              *CriticalityDiagnostics
+}
+
+func (v NGResetAcknowledge) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "NGResetAcknowledge")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 0
+	if v.UEAssociatedLogicalNGConnectionList != nil {
+		n++
+	}
+	if v.CriticalityDiagnostics != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if v.UEAssociatedLogicalNGConnectionList != nil {
+		if err = ProtocolIEID(idUEAssociatedLogicalNGConnectionList).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.UEAssociatedLogicalNGConnectionList.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UE-associatedLogicalNG-connectionList", i))
+			return
+		}
+		i++
+	}
+	if v.CriticalityDiagnostics != nil {
+		if err = ProtocolIEID(idCriticalityDiagnostics).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.CriticalityDiagnostics.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+			return
+		}
+		i++
+	}
+	return
+}
+
+func (v *NGResetAcknowledge) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "NGResetAcknowledge")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = NGResetAcknowledge{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idUEAssociatedLogicalNGConnectionList:
+			v.UEAssociatedLogicalNGConnectionList = new(UEAssociatedLogicalNGConnectionList)
+			if err = r.DecOpenType(v.UEAssociatedLogicalNGConnectionList.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.UE-associatedLogicalNG-connectionList", i))
+				return
+			}
+		case idCriticalityDiagnostics:
+			v.CriticalityDiagnostics = new(CriticalityDiagnostics)
+			if err = r.DecOpenType(v.CriticalityDiagnostics.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+				return
+			}
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// ErrorIndication is ErrorIndication in NGAP-PDU-Contents.
+type ErrorIndication struct {
+	AMFUENGAPID            *AMFUENGAPID
+	RANUENGAPID            *RANUENGAPID
+	Cause                  *Cause
+	CriticalityDiagnostics *CriticalityDiagnostics
+}
+
+func (v ErrorIndication) MarshalPER(w *per.BitWriter) (err error) {
+	defer func() {
+		err = per.WithPath(err, "ErrorIndication")
+	}()
+	if err = w.EncSequence(true, 0, 0); err != nil {
+		return
+	}
+	n := 0
+	if v.AMFUENGAPID != nil {
+		n++
+	}
+	if v.RANUENGAPID != nil {
+		n++
+	}
+	if v.Cause != nil {
+		n++
+	}
+	if v.CriticalityDiagnostics != nil {
+		n++
+	}
+	if err = w.EncSequenceOf(n, 0, maxProtocolIEs, false); err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	i := 0
+	if v.AMFUENGAPID != nil {
+		if err = ProtocolIEID(idAMFUENGAPID).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.AMFUENGAPID.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+			return
+		}
+		i++
+	}
+	if v.RANUENGAPID != nil {
+		if err = ProtocolIEID(idRANUENGAPID).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.RANUENGAPID.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+			return
+		}
+		i++
+	}
+	if v.Cause != nil {
+		if err = ProtocolIEID(idCause).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.Cause.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.Cause", i))
+			return
+		}
+		i++
+	}
+	if v.CriticalityDiagnostics != nil {
+		if err = ProtocolIEID(idCriticalityDiagnostics).MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = CriticalityIgnore.MarshalPER(w); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		if err = w.EncOpenType(v.CriticalityDiagnostics.MarshalPER); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+			return
+		}
+		i++
+	}
+	return
+}
+
+func (v *ErrorIndication) UnmarshalPER(r *per.BitReader) (err error) {
+	defer func() {
+		err = per.WithPath(err, "ErrorIndication")
+	}()
+	ext, _, err := r.DecSequence(true, 0)
+	if err != nil {
+		return
+	}
+	*v = ErrorIndication{}
+	n, err := r.DecSequenceOf(0, maxProtocolIEs, false)
+	if err != nil {
+		err = per.WithPath(err, "protocolIEs")
+		return
+	}
+	for i := 0; i < n; i++ {
+		var id ProtocolIEID
+		var criticality Criticality
+		if err = id.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].id", i))
+			return
+		}
+		if err = criticality.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].criticality", i))
+			return
+		}
+		switch id {
+		case idAMFUENGAPID:
+			v.AMFUENGAPID = new(AMFUENGAPID)
+			if err = r.DecOpenType(v.AMFUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.AMF-UE-NGAP-ID", i))
+				return
+			}
+		case idRANUENGAPID:
+			v.RANUENGAPID = new(RANUENGAPID)
+			if err = r.DecOpenType(v.RANUENGAPID.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.RAN-UE-NGAP-ID", i))
+				return
+			}
+		case idCause:
+			v.Cause = new(Cause)
+			if err = r.DecOpenType(v.Cause.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.Cause", i))
+				return
+			}
+		case idCriticalityDiagnostics:
+			v.CriticalityDiagnostics = new(CriticalityDiagnostics)
+			if err = r.DecOpenType(v.CriticalityDiagnostics.UnmarshalPER); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value.CriticalityDiagnostics", i))
+				return
+			}
+		default:
+			if err = r.DecOpenType(nil); err != nil {
+				err = per.WithPath(err, fmt.Sprintf("protocolIEs[%d].value", i))
+				return
+			}
+		}
+	}
+	if ext == true {
+		_, err = r.DecExtensionAdditions()
+	}
+	return
+}
+
+// NGAPPDU is NGAP-PDU in NGAP-PDU-Descriptions.
+type NGAPPDU struct {
+	InitiatingMessage   *InitiatingMessage
+	SuccessfulOutcome   *SuccessfulOutcome
+	UnsuccessfulOutcome *UnsuccessfulOutcome
+}
+
+func (v NGAPPDU) MarshalPER(w *per.BitWriter) (err error) {
+	chosen := 0
+	if v.InitiatingMessage != nil {
+		chosen++
+	}
+	if v.SuccessfulOutcome != nil {
+		chosen++
+	}
+	if v.UnsuccessfulOutcome != nil {
+		chosen++
+	}
+	if chosen > 1 {
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "more than one alternative is chosen"}
+		return
+	}
+	switch {
+	case v.InitiatingMessage != nil:
+		if err = w.EncChoice(0, 0, 2, true); err != nil {
+			return
+		}
+		if err = v.InitiatingMessage.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "initiatingMessage")
+			return
+		}
+	case v.SuccessfulOutcome != nil:
+		if err = w.EncChoice(1, 0, 2, true); err != nil {
+			return
+		}
+		if err = v.SuccessfulOutcome.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "successfulOutcome")
+			return
+		}
+	case v.UnsuccessfulOutcome != nil:
+		if err = w.EncChoice(2, 0, 2, true); err != nil {
+			return
+		}
+		if err = v.UnsuccessfulOutcome.MarshalPER(w); err != nil {
+			err = per.WithPath(err, "unsuccessfulOutcome")
+			return
+		}
+	default:
+		err = &per.ConstraintError{Offset: w.Len(),
+			Constraint: "no alternative is chosen"}
+	}
+	return
+}
+
+func (v *NGAPPDU) UnmarshalPER(r *per.BitReader) (err error) {
+	index, err := r.DecChoice(0, 2, true)
+	if err != nil {
+		return
+	}
+	*v = NGAPPDU{}
+	switch index {
+	case 0:
+		v.InitiatingMessage = new(InitiatingMessage)
+		if err = v.InitiatingMessage.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "initiatingMessage")
+			return
+		}
+	case 1:
+		v.SuccessfulOutcome = new(SuccessfulOutcome)
+		if err = v.SuccessfulOutcome.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "successfulOutcome")
+			return
+		}
+	case 2:
+		v.UnsuccessfulOutcome = new(UnsuccessfulOutcome)
+		if err = v.UnsuccessfulOutcome.UnmarshalPER(r); err != nil {
+			err = per.WithPath(err, "unsuccessfulOutcome")
+			return
+		}
+	default:
+		err = r.DecOpenType(nil)
+	}
+	return
+}
+
+// InitiatingMessage is InitiatingMessage in NGAP-PDU-Descriptions.
+type InitiatingMessage struct {
+	ProcedureCode ProcedureCode
+	Criticality   Criticality
+	Value         []byte
+}
+
+func (v InitiatingMessage) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequence(false, 0, 0); err != nil {
+		return
+	}
+	if err = v.ProcedureCode.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "procedureCode")
+		return
+	}
+	if err = v.Criticality.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "criticality")
+		return
+	}
+	if err = w.EncOpenType(func(w *per.BitWriter) error {
+		w.WriteOctets(v.Value)
+		return nil
+	}); err != nil {
+		err = per.WithPath(err, "value")
+		return
+	}
+	return
+}
+
+func (v *InitiatingMessage) UnmarshalPER(r *per.BitReader) (err error) {
+	_, _, err = r.DecSequence(false, 0)
+	if err != nil {
+		return
+	}
+	*v = InitiatingMessage{}
+	if err = v.ProcedureCode.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "procedureCode")
+		return
+	}
+	if err = v.Criticality.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "criticality")
+		return
+	}
+	if err = r.DecOpenType(func(r *per.BitReader) (err error) {
+		v.Value, err = r.ReadOctets(r.Len() / 8)
+		return
+	}); err != nil {
+		err = per.WithPath(err, "value")
+		return
+	}
+	return
+}
+
+// SuccessfulOutcome is SuccessfulOutcome in NGAP-PDU-Descriptions.
+type SuccessfulOutcome struct {
+	ProcedureCode ProcedureCode
+	Criticality   Criticality
+	Value         []byte
+}
+
+func (v SuccessfulOutcome) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequence(false, 0, 0); err != nil {
+		return
+	}
+	if err = v.ProcedureCode.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "procedureCode")
+		return
+	}
+	if err = v.Criticality.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "criticality")
+		return
+	}
+	if err = w.EncOpenType(func(w *per.BitWriter) error {
+		w.WriteOctets(v.Value)
+		return nil
+	}); err != nil {
+		err = per.WithPath(err, "value")
+		return
+	}
+	return
+}
+
+func (v *SuccessfulOutcome) UnmarshalPER(r *per.BitReader) (err error) {
+	_, _, err = r.DecSequence(false, 0)
+	if err != nil {
+		return
+	}
+	*v = SuccessfulOutcome{}
+	if err = v.ProcedureCode.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "procedureCode")
+		return
+	}
+	if err = v.Criticality.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "criticality")
+		return
+	}
+	if err = r.DecOpenType(func(r *per.BitReader) (err error) {
+		v.Value, err = r.ReadOctets(r.Len() / 8)
+		return
+	}); err != nil {
+		err = per.WithPath(err, "value")
+		return
+	}
+	return
+}
+
+// UnsuccessfulOutcome is UnsuccessfulOutcome in NGAP-PDU-Descriptions.
+type UnsuccessfulOutcome struct {
+	ProcedureCode ProcedureCode
+	Criticality   Criticality
+	Value         []byte
+}
+
+func (v UnsuccessfulOutcome) MarshalPER(w *per.BitWriter) (err error) {
+	if err = w.EncSequence(false, 0, 0); err != nil {
+		return
+	}
+	if err = v.ProcedureCode.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "procedureCode")
+		return
+	}
+	if err = v.Criticality.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "criticality")
+		return
+	}
+	if err = w.EncOpenType(func(w *per.BitWriter) error {
+		w.WriteOctets(v.Value)
+		return nil
+	}); err != nil {
+		err = per.WithPath(err, "value")
+		return
+	}
+	return
+}
+
+func (v *UnsuccessfulOutcome) UnmarshalPER(r *per.BitReader) (err error) {
+	_, _, err = r.DecSequence(false, 0)
+	if err != nil {
+		return
+	}
+	*v = UnsuccessfulOutcome{}
+	if err = v.ProcedureCode.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "procedureCode")
+		return
+	}
+	if err = v.Criticality.UnmarshalPER(r); err != nil {
+		err = per.WithPath(err, "criticality")
+		return
+	}
+	if err = r.DecOpenType(func(r *per.BitReader) (err error) {
+		v.Value, err = r.ReadOctets(r.Len() / 8)
+		return
+	}); err != nil {
+		err = per.WithPath(err, "value")
+		return
+	}
+	return
+}
@@ -1,39 +1,51 @@
-packag ngap
+package ngap
 
 import (
 	"../encoding/per"
 	"fmt"
+	"net"
+	"time"
 )
 
-const (
-	reject = iota
-	ignore
-	notify
-)
+// The procedure codes, the IE ids and the types of NGAP are generated from
+// the ASN.1 modules in asn1 directory.
+//go:generate go run ../cmd/asn1gen -package ngap -o asn1_gen.go asn1
 
-const (
-	initiatingMessage = iota
-	sucessfulOutcome
-	unsuccessfulOutcome
-)
+// PLMN is the PLMN identity given by the decimal digits of MCC and MNC,
+// e.g. MCC "123" and MNC "45". MNC has 2 or 3 digits.
+type PLMN struct {
+	MCC string
+	MNC string
+}
 
-// Elementary Procedures constants
-const (
-	procCodeInitialUEMessage = 15
-	procCodeNGSetup          = 21
-)
+// Slice is the S-NSSAI supported by the gNB. SD is absent if it is nil.
+type Slice struct {
+	SST uint8
+	SD  []uint8 // 3 octets
+}
 
-const (
-	idDefaultPagingDRX = 21
-	idGlobalRANNodeID  = 27
-    idSupportedTAList  = 102
-)
+// BroadcastPLMN is the PLMN broadcast in the TA, and the slices supported
+// in the PLMN.
+type BroadcastPLMN struct {
+	PLMN   PLMN
+	Slices []Slice
+}
 
-const (
-	globalGNB = iota
-	globalNgGNB
-	globalN3IWF
-)
+// TA is the tracking area supported by the gNB.
+type TA struct {
+	TAC            []uint8 // 3 octets
+	BroadcastPLMNs []BroadcastPLMN
+}
+
+// GNBConfig is the configuration of the gNB sent to AMF by NG Setup.
+type GNBConfig struct {
+	PLMN        PLMN
+	GNBID       uint32
+	GNBIDLength int    // in bits, from 22 to 32
+	Name        string // RANNodeName is omitted if it is empty
+	TAs         []TA
+	PagingDRX   int // default paging DRX in radio frames, e.g. 128
+}
 
 /*
 NGAP-PDU ::= CHOICE {
@@ -42,8 +54,6 @@ NGAP-PDU ::= CHOICE {
     unsuccessfulOutcome         UnsuccessfulOutcome,
     ...
 }
-ProcedureCode ::= INTEGER (0..255)
-Criticality   ::= ENUMERATED { reject, ignore, notify }
 
 InitiatingMessage ::= SEQUENCE {
     procedureCode   NGAP-ELEMENTARY-PROCEDURE.&procedureCode        ({NGAP-ELEMENTARY-PROCEDURES}),
@@ -51,33 +61,199 @@ InitiatingMessage ::= SEQUENCE {
     value           NGAP-ELEMENTARY-PROCEDURE.&InitiatingMessage    ({NGAP-ELEMENTARY-PROCEDURES}{@procedureCode})
 }
 */
-func encNgapPdu(pduType, procCode, criticality int) (pdu []uint8) {
-	pdu, _, _ = per.EncChoice(pduType, 0, 2, true)
-	v, _, _ := per.EncInteger(procCode, 0, 255, false)
-	pdu = append(pdu, v...)
-	v, _, _ = per.EncEnumerated(criticality, 0, 2, false)
-	pdu = append(pdu, v...)
+// encPDU returns NGAP-PDU of the message of type t whose value is v.
+func encPDU(t MessageType, procCode ProcedureCode, criticality Criticality,
+	v per.Marshaler) (pdu []byte, err error) {
 
+	w := per.NewBitWriter()
+	if err = v.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "NGAP-PDU."+t.String()+".value")
+		return
+	}
+	var msg NGAPPDU
+	switch t {
+	case InitiatingMessageType:
+		msg.InitiatingMessage = &InitiatingMessage{
+			ProcedureCode: procCode,
+			Criticality:   criticality,
+			Value:         w.Bytes(),
+		}
+	case SuccessfulOutcomeType:
+		msg.SuccessfulOutcome = &SuccessfulOutcome{
+			ProcedureCode: procCode,
+			Criticality:   criticality,
+			Value:         w.Bytes(),
+		}
+	case UnsuccessfulOutcomeType:
+		msg.UnsuccessfulOutcome = &UnsuccessfulOutcome{
+			ProcedureCode: procCode,
+			Criticality:   criticality,
+			Value:         w.Bytes(),
+		}
+	}
+	w = per.NewBitWriter()
+	if err = msg.MarshalPER(w); err != nil {
+		err = per.WithPath(err, "NGAP-PDU")
+		return
+	}
+	pdu = w.Bytes()
 	return
 }
 
-/*
-NGSetupRequest ::= SEQUENCE {
-    protocolIEs     ProtocolIE-Container        { {NGSetupRequestIEs} },
-    ...
+// MessageType is the type of the message in NGAP-PDU.
+type MessageType int
+
+const (
+	InitiatingMessageType MessageType = iota
+	SuccessfulOutcomeType
+	UnsuccessfulOutcomeType
+)
+
+var messageTypeNames = []string{
+	"initiatingMessage",
+	"successfulOutcome",
+	"unsuccessfulOutcome",
 }
-ProtocolIE-Container {NGAP-PROTOCOL-IES : IEsSetParam} ::= 
-    SEQUENCE (SIZE (0..maxProtocolIEs)) OF
-    ProtocolIE-Field {{IEsSetParam}}
 
-maxProtocolIEs                          INTEGER ::= 65535
-*/
-func encProtocolIEContainer(num int) (container []uint8) {
-	const maxProtocolIEs = 65535
-	container, _, _ = per.EncSequence(true, 0, 0)
-	v, _, _ := per.EncSequenceOf(num, 0, maxProtocolIEs, false)
-	container = append(container, v...)
+func (t MessageType) String() string {
+	if t < 0 || int(t) >= len(messageTypeNames) {
+		return fmt.Sprintf("MessageType(%d)", int(t))
+	}
+	return messageTypeNames[t]
+}
+
+// PDU is NGAP-PDU decoded by Decode.
+type PDU struct {
+	Type          MessageType
+	ProcedureCode ProcedureCode
+	Criticality   Criticality
+	// Value is the decoded message, e.g. *NGSetupResponse, or UnknownMessage
+	// if the message is unknown to this package.
+	Value interface{}
+}
 
+// UnknownMessage is the encoded value of the message whose procedure is
+// unknown to this package.
+type UnknownMessage []byte
+
+// procedures is the messages of the elementary procedures known to this
+// package, indexed by MessageType. nil means the message does not exist in
+// the procedure.
+var procedures = map[ProcedureCode][3]func() per.Unmarshaler{
+	procCodeDownlinkNASTransport: {
+		func() per.Unmarshaler { return &DownlinkNASTransport{} }, nil, nil,
+	},
+	procCodeErrorIndication: {
+		func() per.Unmarshaler { return &ErrorIndication{} }, nil, nil,
+	},
+	procCodeInitialContextSetup: {
+		func() per.Unmarshaler { return &InitialContextSetupRequest{} },
+		func() per.Unmarshaler { return &InitialContextSetupResponse{} },
+		func() per.Unmarshaler { return &InitialContextSetupFailure{} },
+	},
+	procCodeInitialUEMessage: {
+		func() per.Unmarshaler { return &InitialUEMessage{} }, nil, nil,
+	},
+	procCodeNGSetup: {
+		func() per.Unmarshaler { return &NGSetupRequest{} },
+		func() per.Unmarshaler { return &NGSetupResponse{} },
+		func() per.Unmarshaler { return &NGSetupFailure{} },
+	},
+	procCodeNGReset: {
+		func() per.Unmarshaler { return &NGReset{} },
+		func() per.Unmarshaler { return &NGResetAcknowledge{} },
+		nil,
+	},
+	procCodePaging: {
+		func() per.Unmarshaler { return &Paging{} }, nil, nil,
+	},
+	procCodePDUSessionResourceModify: {
+		func() per.Unmarshaler { return &PDUSessionResourceModifyRequest{} },
+		func() per.Unmarshaler { return &PDUSessionResourceModifyResponse{} },
+		nil,
+	},
+	procCodePDUSessionResourceModifyIndication: {
+		func() per.Unmarshaler { return &PDUSessionResourceModifyIndication{} },
+		func() per.Unmarshaler { return &PDUSessionResourceModifyConfirm{} },
+		nil,
+	},
+	procCodePDUSessionResourceNotify: {
+		func() per.Unmarshaler { return &PDUSessionResourceNotify{} }, nil, nil,
+	},
+	procCodePDUSessionResourceRelease: {
+		func() per.Unmarshaler { return &PDUSessionResourceReleaseCommand{} },
+		func() per.Unmarshaler { return &PDUSessionResourceReleaseResponse{} },
+		nil,
+	},
+	procCodePDUSessionResourceSetup: {
+		func() per.Unmarshaler { return &PDUSessionResourceSetupRequest{} },
+		func() per.Unmarshaler { return &PDUSessionResourceSetupResponse{} },
+		nil,
+	},
+	procCodeUEContextRelease: {
+		func() per.Unmarshaler { return &UEContextReleaseCommand{} },
+		func() per.Unmarshaler { return &UEContextReleaseComplete{} },
+		nil,
+	},
+	procCodeUEContextReleaseRequest: {
+		func() per.Unmarshaler { return &UEContextReleaseRequest{} }, nil, nil,
+	},
+	procCodeUplinkNASTransport: {
+		func() per.Unmarshaler { return &UplinkNASTransport{} }, nil, nil,
+	},
+}
+
+// Decode decodes NGAP-PDU in b. The message of the procedure unknown to
+// this package is returned as UnknownMessage instead of an error.
+func Decode(b []byte) (pdu *PDU, err error) {
+	p, value, err := decodeHeader(b)
+	if err != nil {
+		return
+	}
+	newMessage := procedures[p.ProcedureCode][p.Type]
+	if newMessage == nil {
+		p.Value = UnknownMessage(value)
+		pdu = p
+		return
+	}
+	m := newMessage()
+	if err = m.UnmarshalPER(per.NewBitReader(value)); err != nil {
+		err = per.WithPath(err, "NGAP-PDU."+p.Type.String()+".value")
+		return
+	}
+	p.Value = m
+	pdu = p
+	return
+}
+
+// decodeHeader decodes NGAP-PDU in b except its value, and returns the
+// encoded value.
+func decodeHeader(b []byte) (p *PDU, value []byte, err error) {
+	v := &NGAPPDU{}
+	if err = v.UnmarshalPER(per.NewBitReader(b)); err != nil {
+		err = per.WithPath(err, "NGAP-PDU")
+		return
+	}
+	p = &PDU{}
+	switch {
+	case v.InitiatingMessage != nil:
+		m := v.InitiatingMessage
+		p.Type, p.ProcedureCode, p.Criticality, value =
+			InitiatingMessageType, m.ProcedureCode, m.Criticality, m.Value
+	case v.SuccessfulOutcome != nil:
+		m := v.SuccessfulOutcome
+		p.Type, p.ProcedureCode, p.Criticality, value =
+			SuccessfulOutcomeType, m.ProcedureCode, m.Criticality, m.Value
+	case v.UnsuccessfulOutcome != nil:
+		m := v.UnsuccessfulOutcome
+		p.Type, p.ProcedureCode, p.Criticality, value =
+			UnsuccessfulOutcomeType, m.ProcedureCode, m.Criticality, m.Value
+	default:
+		// the alternative after the extension marker.
+		err = &per.ExtensionError{Reason: "unknown message type"}
+		err = per.WithPath(err, "NGAP-PDU")
+		p = nil
+	}
 	return
 }
 
@@ -92,53 +268,50 @@ NGSetupRequestIEs NGAP-PROTOCOL-IES ::= {
     ...
 }
 */
-func MakeNGSetupRequest() {
-	pdu := encNgapPdu(initiatingMessage, procCodeNGSetup, reject)
-	fmt.Printf("result: pdu = %02x\n", pdu)
-
-	v := encProtocolIEContainer(3)
-	fmt.Printf("result: ie container = %02x\n", v)
-
-	v, _ = encGlobalRANNodeID()
-	fmt.Printf("result: global RAN Node ID = %02x\n", v)
-
-	v, _ = encSupportedTAList()
-	fmt.Printf("result: Supported TA List = %02x\n", v)
-}
-
-/*
-BroadcastPLMNList ::= SEQUENCE (SIZE(1..maxnoofBPLMNs)) OF BroadcastPLMNItem
-    maxnoofBPLMNs                       INTEGER ::= 12
- */
-func encBroadcastPLMNList() (v []uint8) {
-	const maxnoofBPLMNs = 12
-	v, _, _ = per.EncSequenceOf(1, 1, maxnoofBPLMNs, false)
-	v = append(v, encBroadcastPLMNItem()...)
+// MakeNGSetupRequest returns NGAP-PDU of NG Setup Request for the gNB
+// configured by c.
+func MakeNGSetupRequest(c *GNBConfig) (pdu []byte, err error) {
+	v, err := c.ngSetupRequest()
+	if err != nil {
+		return
+	}
+	pdu, err = encPDU(InitiatingMessageType, procCodeNGSetup,
+		CriticalityReject, v)
 	return
 }
 
-/*
-BroadcastPLMNItem ::= SEQUENCE {
-    pLMNIdentity            PLMNIdentity,
-    tAISliceSupportList     SliceSupportList,
-    iE-Extensions           ProtocolExtensionContainer { {BroadcastPLMNItem-ExtIEs} } OPTIONAL,
-    ...
-}
- */
-func encBroadcastPLMNItem() (v []uint8) {
-	v, _, _ = per.EncSequence(true, 1, 0)
-	v = append(v, encPLMNIdentity(123, 45)...)
-	v = append(v, encSliceSupportList()...)
+func (c *GNBConfig) ngSetupRequest() (v *NGSetupRequest, err error) {
+	const t, msg = InitiatingMessageType, "NGSetupRequest"
+	v = &NGSetupRequest{}
+	i := 0
+	if v.GlobalRANNodeID, err = c.globalRANNodeID(); err != nil {
+		err = ieError(err, t, msg, i, "GlobalRANNodeID")
+		return
+	}
+	i++
+	if c.Name != "" {
+		name := RANNodeName(c.Name)
+		v.RANNodeName = &name
+		i++
+	}
+	if v.SupportedTAList, err = c.supportedTAList(); err != nil {
+		err = ieError(err, t, msg, i, "SupportedTAList")
+		return
+	}
+	i++
+	if v.DefaultPagingDRX, err = pagingDRX(c.PagingDRX); err != nil {
+		err = ieError(err, t, msg, i, "PagingDRX")
+		return
+	}
 	return
 }
 
-func encProtocolIE(id, criticality int) (v []uint8, err error) {
-
-	v1, _, _ := per.EncInteger(id, 0, 65535, false)
-	v2, _, _ := per.EncEnumerated(criticality, 0, 2, false)
-	v = append(v1, v2...)
-
-	return
+// ieError returns err of the i-th IE of type ie in the message msg with
+// the path from NGAP-PDU, like the errors returned by encPDU. i counts
+// only the IEs present in the message.
+func ieError(err error, t MessageType, msg string, i int, ie string) error {
+	return per.WithPath(err, fmt.Sprintf("NGAP-PDU.%v.value.%s."+
+		"protocolIEs[%d].value.%s", t, msg, i, ie))
 }
 
 // 9.3.1.5 Global RAN Node ID
@@ -150,211 +323,1832 @@ func encProtocolIE(id, criticality int) (v []uint8, err error) {
        globalN3IWF-ID      GlobalN3IWF-ID,
        choice-Extensions   ProtocolIE-SingleContainer { {GlobalRANNodeID-ExtIEs} }
    }
- */
-func encGlobalRANNodeID() (v []uint8, err error) {
-
-	v, err = encProtocolIE(idGlobalRANNodeID, reject)
-
+*/
+func (c *GNBConfig) globalRANNodeID() (v GlobalRANNodeID, err error) {
+	plmn, err := c.PLMN.identity()
+	if err != nil {
+		err = per.WithPath(err, "globalGNB-ID.pLMNIdentity")
+		return
+	}
+	id, err := gnbID(c.GNBID, c.GNBIDLength)
+	if err != nil {
+		err = per.WithPath(err, "globalGNB-ID.gNB-ID")
+		return
+	}
 	// NG-ENB and N3IWF are not implemented yet...
-	pv, plen, _ := per.EncChoice(globalGNB, 0, 2, false)
-	pv2, plen2, v2 := encGlobalGNBId()
-	pv, plen = per.MergeBitField(pv, plen, pv2, plen2)
-	pv = append(pv, v2...)
-
-	v3, _, _ := per.EncLengthDeterminant(len(pv), 0)
-	v = append(v, v3...)
-	v = append(v, pv...)
-
+	v.GlobalGNBID = &GlobalGNBID{PLMNIdentity: plmn, GNBID: id}
 	return
 }
 
-
 // 9.3.1.6 Global gNB ID
-/*
-   GlobalGNB-ID ::= SEQUENCE {
-       pLMNIdentity        PLMNIdentity,
-       gNB-ID              GNB-ID,
-       iE-Extensions       ProtocolExtensionContainer { {GlobalGNB-ID-ExtIEs} } OPTIONAL,
-       ...
-   }
- */
-func encGlobalGNBId() (pv []uint8, plen int, v []uint8) {
-	//temp value: MCC = 123, MNC = 45
-	pv, plen, _ = per.EncSequence(true, 1, 0)
-	v = append(v, encPLMNIdentity(123, 45)...)
-
-	pv2, _ := encGNBId()
-	v = append(v, pv2...)
-	return
-}
-
 /*
    GNB-ID ::= CHOICE {
        gNB-ID                  BIT STRING (SIZE(22..32)),
        choice-Extensions       ProtocolIE-SingleContainer { {GNB-ID-ExtIEs} }
    }
- */
-func encGNBId() (pv []uint8, plen int) {
-	//GNB-ID = 1
-	pv, plen, _ = per.EncChoice(0, 0, 1, false)
-	pv2, plen2, _ := per.EncBitString([]uint8{0x00, 0x00, 0x01},
-		22, 22, 32, false)
-	pv, plen = per.MergeBitField(pv, plen, pv2, plen2)
+*/
+func gnbID(id uint32, bitlen int) (v GNBID, err error) {
+	if bitlen < 22 || bitlen > 32 {
+		err = per.WithPath(&per.RangeError{Value: bitlen, Min: 22, Max: 32},
+			"gNB-ID")
+		return
+	}
+	if uint64(id)>>uint(bitlen) != 0 {
+		err = per.WithPath(&per.ConstraintError{Constraint: fmt.Sprintf(
+			"gNB ID=%d does not fit in %d bits", id, bitlen)}, "gNB-ID")
+		return
+	}
+	b := bitString(uint64(id), bitlen)
+	v.GNBID = &b
+	return
+}
+
+// bitString returns BIT STRING of bitlen bits whose value is v.
+func bitString(v uint64, bitlen int) per.BitString {
+	n := (bitlen + 7) / 8
+	b := make([]uint8, n, n)
+	for i := range b {
+		b[i] = uint8(v >> uint(8*(n-1-i)))
+	}
+	return per.BitString{Bytes: b, BitLength: bitlen}
+}
+
+// bitStringValue returns the value of BIT STRING b of 64 bits or less.
+func bitStringValue(b per.BitString) (v uint64) {
+	for _, x := range b.Bytes {
+		v = v<<8 | uint64(x)
+	}
 	return
 }
 
 // 9.3.1.90 PagingDRX
 /*
-func encPagingDRX(drx string) (val []uint8) {
-	n := 0
+PagingDRX ::= ENUMERATED {
+    v32,
+    v64,
+    v128,
+    v256,
+    ...
+}
+*/
+func pagingDRX(drx int) (v PagingDRX, err error) {
 	switch drx {
-	case "v32":
-		n = 0
-	case "v64":
-		n = 1
-	case "v128":
-		n = 2
-	case "v256":
-		n = 3
+	case 32:
+		v = PagingDRXV32
+	case 64:
+		v = PagingDRXV64
+	case 128:
+		v = PagingDRXV128
+	case 256:
+		v = PagingDRXV256
 	default:
-		fmt.Printf("encPagingDRX: no such DRX value(%s)", drx)
-		return
+		err = &per.ConstraintError{
+			Constraint: fmt.Sprintf("no such paging DRX value(%d)", drx)}
+	}
+	return
+}
+
+// Frames returns the paging DRX cycle in radio frames, or 0 if v is
+// unknown.
+func (v PagingDRX) Frames() (n int) {
+	switch v {
+	case PagingDRXV32:
+		n = 32
+	case PagingDRXV64:
+		n = 64
+	case PagingDRXV128:
+		n = 128
+	case PagingDRXV256:
+		n = 256
 	}
-	val = per.EncEnumerated(n)
 	return
 }
-*/
 
 // 9.3.3.5 PLMN Identity
 /*
-PLMNIdentity ::= OCTET STRING (SIZE(3)) 
- */
-func encPLMNIdentity(mcc, mnc int) (v []uint8) {
-
-	v = make([]uint8, 3, 3)
-	v[0] = uint8(mcc % 1000 / 100)
-	v[0] |= uint8(mcc%100/10) << 4
-
-	v[1] = uint8(mcc % 10)
-	v[1] |= 0xf0 // filler digit
-
-	v[2] = uint8(mnc % 100 / 10)
-	v[2] |= uint8(mnc%10) << 4
-
-	_, _, v, _ = per.EncOctetString(v, 3, 3, false)
+PLMNIdentity ::= OCTET STRING (SIZE(3))
+*/
+func (p PLMN) identity() (v PLMNIdentity, err error) {
+	digits := func(name, s string, n ...int) (d []uint8, err error) {
+		for _, c := range s {
+			if c < '0' || c > '9' {
+				err = &per.ConstraintError{Constraint: fmt.Sprintf(
+					"%s: invalid digit %q in %q", name, c, s)}
+				return
+			}
+			d = append(d, uint8(c-'0'))
+		}
+		for _, l := range n {
+			if len(d) == l {
+				return
+			}
+		}
+		err = &per.ConstraintError{Constraint: fmt.Sprintf(
+			"%s: invalid number of digits in %q", name, s)}
+		return
+	}
+	mcc, err := digits("MCC", p.MCC, 3)
+	if err != nil {
+		return
+	}
+	mnc, err := digits("MNC", p.MNC, 2, 3)
+	if err != nil {
+		return
+	}
+	if len(mnc) == 2 {
+		mnc = append(mnc, 0x0f) // filler digit
+	}
 
+	v = make(PLMNIdentity, 3, 3)
+	v[0] = mcc[1]<<4 | mcc[0]
+	v[1] = mnc[2]<<4 | mcc[2]
+	v[2] = mnc[1]<<4 | mnc[0]
 	return
 }
 
+// Supported TA List
 /*
-SliceSupportList ::= SEQUENCE (SIZE(1..maxnoofSliceItems)) OF SliceSupportItem
-    maxnoofSliceItems                   INTEGER ::= 1024
- */
-func encSliceSupportList() (v []uint8) {
-	v, _, _ = per.EncSequenceOf(1, 1, 1024, false)
-	v = append(v, encSliceSupportItem()...)
+SupportedTAList ::= SEQUENCE (SIZE(1..maxnoofTACs)) OF SupportedTAItem
+
+SupportedTAItem ::= SEQUENCE {
+    tAC                     TAC,
+    broadcastPLMNList       BroadcastPLMNList,
+    iE-Extensions           ProtocolExtensionContainer { {SupportedTAItem-ExtIEs} } OPTIONAL,
+    ...
+}
+
+BroadcastPLMNItem ::= SEQUENCE {
+    pLMNIdentity            PLMNIdentity,
+    tAISliceSupportList     SliceSupportList,
+    iE-Extensions           ProtocolExtensionContainer { {BroadcastPLMNItem-ExtIEs} } OPTIONAL,
+    ...
+}
+*/
+func (c *GNBConfig) supportedTAList() (v SupportedTAList, err error) {
+	for i, ta := range c.TAs {
+		item := SupportedTAItem{TAC: TAC(ta.TAC)}
+		for j, bp := range ta.BroadcastPLMNs {
+			var plmn PLMNIdentity
+			if plmn, err = bp.PLMN.identity(); err != nil {
+				err = per.WithPath(err, fmt.Sprintf(
+					"[%d].broadcastPLMNList[%d].pLMNIdentity", i, j))
+				return
+			}
+			item.BroadcastPLMNList = append(item.BroadcastPLMNList,
+				BroadcastPLMNItem{
+					PLMNIdentity:        plmn,
+					TAISliceSupportList: sliceSupportList(bp.Slices),
+				})
+		}
+		v = append(v, item)
+	}
 	return
 }
 
+// 9.3.1.24 S-NSSAI
 /*
 SliceSupportItem ::= SEQUENCE {
     s-NSSAI             S-NSSAI,
     iE-Extensions       ProtocolExtensionContainer { {SliceSupportItem-ExtIEs} }    OPTIONAL,
     ...
 }
- */
-func encSliceSupportItem() (v []uint8) {
-	/*
-	ex.1
-	    .    .   .          .    .   .    .   .    .   .
-	00 000 00000001	00 010 00000010 00000000 00000011 00001000
-	0000 0000 0000 1xxx
-	                000 1000 0000 10xx xxxx 00000000 00000011 11101000
-	0x00 0x08 0x80 0x80 0x00 0x03 0xe1
-
-	ex.2
-	    .    .   .    .        .        .        .
-	00 010 00000001 xxx 00000000 00000000 01111011
-	0001 0000 0000 1xxx 00000000 00000000 11101000
-	0x10 0x08 0x80 0x00 0x00 0x7b
-	*/
-	pv, plen, _ := per.EncSequence(true, 1, 0)
-
-	pv2, plen2, v := encSNSSAI([]uint8{1}, []uint8{0, 0, 123})
-	pv, plen = per.MergeBitField(pv, plen, pv2, plen2)
-	v = append(pv, v...)
-	return
-}
 
-// 9.3.1.24 S-NSSAI
-/*
 S-NSSAI ::= SEQUENCE {
     sST           SST,
     sD            SD                                                  OPTIONAL,
     iE-Extensions ProtocolExtensionContainer { { S-NSSAI-ExtIEs} }    OPTIONAL,
     ...
 }
+*/
+func sliceSupportList(slices []Slice) (v SliceSupportList) {
+	for _, s := range slices {
+		item := SliceSupportItem{SNSSAI: SNSSAI{SST: SST{s.SST}}}
+		if s.SD != nil {
+			sd := SD(s.SD)
+			item.SNSSAI.SD = &sd
+		}
+		v = append(v, item)
+	}
+	return
+}
 
-SST ::= OCTET STRING (SIZE(1))
-SD ::= OCTET STRING (SIZE(3))
+// 9.2.6.2 NG SETUP RESPONSE
+/*
+NGSetupResponseIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-AMFName                 CRITICALITY reject  TYPE AMFName                    PRESENCE mandatory  }|
+    { ID id-ServedGUAMIList         CRITICALITY reject  TYPE ServedGUAMIList            PRESENCE mandatory  }|
+    { ID id-RelativeAMFCapacity     CRITICALITY ignore  TYPE RelativeAMFCapacity        PRESENCE mandatory  }|
+    { ID id-PLMNSupportList         CRITICALITY reject  TYPE PLMNSupportList            PRESENCE mandatory  }|
+    { ID id-CriticalityDiagnostics  CRITICALITY ignore  TYPE CriticalityDiagnostics     PRESENCE optional   }|
+    { ID id-UERetentionInformation  CRITICALITY ignore  TYPE UERetentionInformation     PRESENCE optional   },
+    ...
+}
+*/
+// 9.2.6.3 NG SETUP FAILURE
+/*
+NGSetupFailureIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-Cause                   CRITICALITY ignore  TYPE Cause                      PRESENCE mandatory  }|
+    { ID id-TimeToWait              CRITICALITY ignore  TYPE TimeToWait                 PRESENCE optional   }|
+    { ID id-CriticalityDiagnostics  CRITICALITY ignore  TYPE CriticalityDiagnostics     PRESENCE optional   },
+    ...
+}
 */
-func encSNSSAI(sst, sd []uint8) (pv []uint8, plen int, v []uint8) {
-	pv, plen, _ = per.EncSequence(true, 2, 0x02)
+// DecodeNGSetupResult decodes NGAP-PDU sent by AMF in response to NG Setup
+// Request. Either resp or fail is returned.
+func DecodeNGSetupResult(b []byte) (resp *NGSetupResponse,
+	fail *NGSetupFailure, err error) {
+
+	pdu, err := Decode(b)
+	if err != nil {
+		return
+	}
+	if pdu.ProcedureCode != procCodeNGSetup {
+		err = fmt.Errorf("not the response to NG Setup Request")
+		return
+	}
+	switch v := pdu.Value.(type) {
+	case *NGSetupResponse:
+		resp = v
+	case *NGSetupFailure:
+		fail = v
+	default:
+		err = fmt.Errorf("not the response to NG Setup Request")
+	}
+	return
+}
 
-	pv2, plen2, _, _ := per.EncOctetString(sst, 1, 1, false)
+// 9.3.1.56 Time to Wait
+/*
+TimeToWait ::= ENUMERATED {v1s, v2s, v5s, v10s, v20s, v60s, ...}
+*/
+var timeToWait = []time.Duration{
+	1 * time.Second,
+	2 * time.Second,
+	5 * time.Second,
+	10 * time.Second,
+	20 * time.Second,
+	60 * time.Second,
+}
 
-	pv, plen = per.MergeBitField(pv, plen, pv2, plen2)
+// Duration returns the time to wait before the gNB retries NG Setup.
+// It returns 0 for the value unknown to this package.
+func (v TimeToWait) Duration() time.Duration {
+	if v < 0 || int(v) >= len(timeToWait) {
+		return 0
+	}
+	return timeToWait[v]
+}
 
-	_, _, v, _ = per.EncOctetString(sd, 3, 3, false)
-	return
+// UEConnection identifies UE-associated logical NG-connection. The ID is
+// omitted if it is nil.
+type UEConnection struct {
+	AMFUENGAPID *uint64
+	RANUENGAPID *uint32
 }
 
-// Supported TA List
+// 9.2.6.11 NG RESET
 /*
-SupportedTAList ::= SEQUENCE (SIZE(1..maxnoofTACs)) OF SupportedTAItem
- */
-func encSupportedTAList() (v []uint8, err error) {
+NGResetIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-Cause                   CRITICALITY ignore  TYPE Cause                      PRESENCE mandatory  }|
+    { ID id-ResetType               CRITICALITY reject  TYPE ResetType                  PRESENCE mandatory  },
+    ...
+}
+*/
+// MakeNGReset returns NGAP-PDU of NG Reset with the cause. The connections
+// in conns are reset, or all the connections of NG interface are reset if
+// conns is empty.
+func MakeNGReset(cause Cause, conns []UEConnection) (pdu []byte, err error) {
+	v := &NGReset{Cause: cause}
+	if len(conns) > 0 {
+		list := make(UEAssociatedLogicalNGConnectionList, len(conns))
+		for i, c := range conns {
+			if c.AMFUENGAPID != nil {
+				id := AMFUENGAPID(*c.AMFUENGAPID)
+				list[i].AMFUENGAPID = &id
+			}
+			if c.RANUENGAPID != nil {
+				id := RANUENGAPID(*c.RANUENGAPID)
+				list[i].RANUENGAPID = &id
+			}
+		}
+		v.ResetType.PartOfNGInterface = &list
+	} else {
+		all := ResetAllResetAll
+		v.ResetType.NGInterface = &all
+	}
+	pdu, err = encPDU(InitiatingMessageType, procCodeNGReset,
+		CriticalityReject, v)
+	return
+}
 
-	v, err = encProtocolIE(idSupportedTAList, reject)
+// DecodeNGReset decodes NGAP-PDU of NG Reset sent by AMF.
+func DecodeNGReset(b []byte) (v *NGReset, err error) {
+	pdu, err := Decode(b)
+	if err != nil {
+		return
+	}
+	v, ok := pdu.Value.(*NGReset)
+	if ok == false {
+		err = fmt.Errorf("not NG Reset")
+	}
+	return
+}
 
-	// maxnoofTACs INTEGER ::= 256
-	const maxnoofTACs = 256
-	pv, _, _ := per.EncSequenceOf(1, 1, maxnoofTACs, false)
-	v = append(v, pv...)
+// Reset Type
+/*
+ResetType ::= CHOICE {
+    nG-Interface            ResetAll,
+    partOfNG-Interface      UE-associatedLogicalNG-connectionList,
+    choice-Extensions       ProtocolIE-SingleContainer { {ResetType-ExtIEs} }
+}
+*/
+// Connections returns the connections to reset in v. conns is nil if all
+// the connections of NG interface are reset.
+func (v *ResetType) Connections() (conns []UEConnection, err error) {
+	switch {
+	case v.NGInterface != nil:
+	case v.PartOfNGInterface != nil:
+		conns = make([]UEConnection, len(*v.PartOfNGInterface))
+		for i, item := range *v.PartOfNGInterface {
+			if item.AMFUENGAPID != nil {
+				id := uint64(*item.AMFUENGAPID)
+				conns[i].AMFUENGAPID = &id
+			}
+			if item.RANUENGAPID != nil {
+				id := uint32(*item.RANUENGAPID)
+				conns[i].RANUENGAPID = &id
+			}
+		}
+	default:
+		err = fmt.Errorf("no reset type")
+	}
+	return
+}
 
-	v = append(v, encSupportedTAItem()...)
+// 9.2.6.12 NG RESET ACKNOWLEDGE
+/*
+NGResetAcknowledgeIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-UE-associatedLogicalNG-connectionList   CRITICALITY ignore  TYPE UE-associatedLogicalNG-connectionList      PRESENCE optional   }|
+    { ID id-CriticalityDiagnostics                  CRITICALITY ignore  TYPE CriticalityDiagnostics                     PRESENCE optional   },
+    ...
+}
+*/
+// MakeNGResetAcknowledge returns NGAP-PDU of NG Reset Acknowledge to reset.
+// The connections in reset are listed if only a part of NG interface is
+// reset.
+func MakeNGResetAcknowledge(reset *NGReset) (pdu []byte, err error) {
+	v := &NGResetAcknowledge{
+		UEAssociatedLogicalNGConnectionList: reset.ResetType.PartOfNGInterface,
+	}
+	pdu, err = encPDU(SuccessfulOutcomeType, procCodeNGReset,
+		CriticalityReject, v)
+	return
+}
 
+// DecodeNGResetAcknowledge decodes NGAP-PDU of NG Reset Acknowledge sent by
+// AMF.
+func DecodeNGResetAcknowledge(b []byte) (v *NGResetAcknowledge, err error) {
+	pdu, err := Decode(b)
+	if err != nil {
+		return
+	}
+	v, ok := pdu.Value.(*NGResetAcknowledge)
+	if ok == false {
+		err = fmt.Errorf("not NG Reset Acknowledge")
+	}
 	return
 }
 
-// Supported TA Item
+// ErrorReport is the error reported to AMF by Error Indication. The IE is
+// omitted if it is nil, but either Cause or Diagnostics is required.
+type ErrorReport struct {
+	AMFUENGAPID *uint64
+	RANUENGAPID *uint32
+	Cause       *Cause
+	Diagnostics *CriticalityDiagnostics
+}
+
+// 9.2.6.13 ERROR INDICATION
 /*
-SupportedTAItem ::= SEQUENCE {
-    tAC                     TAC,
-    broadcastPLMNList       BroadcastPLMNList,
-    iE-Extensions           ProtocolExtensionContainer { {SupportedTAItem-ExtIEs} } OPTIONAL,
+ErrorIndicationIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-AMF-UE-NGAP-ID          CRITICALITY ignore  TYPE AMF-UE-NGAP-ID             PRESENCE optional   }|
+    { ID id-RAN-UE-NGAP-ID          CRITICALITY ignore  TYPE RAN-UE-NGAP-ID             PRESENCE optional   }|
+    { ID id-Cause                   CRITICALITY ignore  TYPE Cause                      PRESENCE optional   }|
+    { ID id-CriticalityDiagnostics  CRITICALITY ignore  TYPE CriticalityDiagnostics     PRESENCE optional   },
     ...
 }
- */
-func encSupportedTAItem() (v []uint8) {
-	//pv, plen, _ := per.EncSequence(true, 1, 0)
+*/
+// MakeErrorIndication returns NGAP-PDU of Error Indication for r.
+func MakeErrorIndication(r *ErrorReport) (pdu []byte, err error) {
+	if r.Cause == nil && r.Diagnostics == nil {
+		err = fmt.Errorf("neither cause nor criticality diagnostics")
+		return
+	}
+	v := &ErrorIndication{
+		Cause:                  r.Cause,
+		CriticalityDiagnostics: r.Diagnostics,
+	}
+	if r.AMFUENGAPID != nil {
+		id := AMFUENGAPID(*r.AMFUENGAPID)
+		v.AMFUENGAPID = &id
+	}
+	if r.RANUENGAPID != nil {
+		id := RANUENGAPID(*r.RANUENGAPID)
+		v.RANUENGAPID = &id
+	}
+	pdu, err = encPDU(InitiatingMessageType, procCodeErrorIndication,
+		CriticalityIgnore, v)
+	return
+}
+
+// MakeErrorIndicationForPDU returns NGAP-PDU of Error Indication for the
+// NGAP-PDU in b that is failed to be decoded. Cause is transfer syntax
+// error, and Criticality Diagnostics has the procedure of b if it is known.
+func MakeErrorIndicationForPDU(b []byte) (pdu []byte, err error) {
+	cause := CauseProtocolTransferSyntaxError
+	r := &ErrorReport{Cause: &Cause{Protocol: &cause}}
+	if p, _, err := decodeHeader(b); err == nil {
+		msg := TriggeringMessage(p.Type) // same order as MessageType
+		r.Diagnostics = &CriticalityDiagnostics{
+			ProcedureCode:        &p.ProcedureCode,
+			TriggeringMessage:    &msg,
+			ProcedureCriticality: &p.Criticality,
+		}
+	}
+	pdu, err = MakeErrorIndication(r)
+	return
+}
+
+// DecodeErrorIndication decodes NGAP-PDU of Error Indication sent by AMF.
+func DecodeErrorIndication(b []byte) (v *ErrorIndication, err error) {
+	pdu, err := Decode(b)
+	if err != nil {
+		return
+	}
+	v, ok := pdu.Value.(*ErrorIndication)
+	if ok == false {
+		err = fmt.Errorf("not Error Indication")
+	}
+	return
+}
+
+// InitialUE is the information of the UE sent to AMF by Initial UE Message.
+type InitialUE struct {
+	RANUENGAPID           uint32
+	NASPDU                []byte
+	Location              NRLocation
+	RRCEstablishmentCause RRCEstablishmentCause
+	STMSI                 *STMSI  // 5G-S-TMSI is omitted if it is nil
+	AMFSetID              *uint16 // AMF Set ID is omitted if it is nil
+	UEContextRequest      bool
+}
+
+// NRLocation is the location of the UE in NR.
+type NRLocation struct {
+	PLMN   PLMN
+	CellID uint64    // NR Cell Identity, 36 bits
+	TAC    []uint8   // 3 octets
+	Time   time.Time // Time Stamp is omitted if it is zero
+}
 
-	//TAC
-	tac := []uint8{0x00, 0x01, 0x02}
-	v = encTAC(tac)
+// STMSI is 5G-S-TMSI of the UE.
+type STMSI struct {
+	AMFSetID   uint16 // 10 bits
+	AMFPointer uint8  // 6 bits
+	TMSI       uint32
+}
+
+// 9.2.5.1 INITIAL UE MESSAGE
+/*
+InitialUEMessage-IEs NGAP-PROTOCOL-IES ::= {
+    { ID id-RAN-UE-NGAP-ID              CRITICALITY reject  TYPE RAN-UE-NGAP-ID                 PRESENCE mandatory  }|
+    { ID id-NAS-PDU                     CRITICALITY reject  TYPE NAS-PDU                        PRESENCE mandatory  }|
+    { ID id-UserLocationInformation     CRITICALITY reject  TYPE UserLocationInformation        PRESENCE mandatory  }|
+    { ID id-RRCEstablishmentCause       CRITICALITY ignore  TYPE RRCEstablishmentCause          PRESENCE mandatory  }|
+    { ID id-FiveG-S-TMSI                CRITICALITY reject  TYPE FiveG-S-TMSI                   PRESENCE optional   }|
+    { ID id-AMFSetID                    CRITICALITY ignore  TYPE AMFSetID                       PRESENCE optional   }|
+    { ID id-UEContextRequest            CRITICALITY ignore  TYPE UEContextRequest               PRESENCE optional   }|
+    { ID id-AllowedNSSAI                CRITICALITY reject  TYPE AllowedNSSAI                   PRESENCE optional   },
+    ...
+}
+*/
+// MakeInitialUEMessage returns NGAP-PDU of Initial UE Message for the UE.
+func MakeInitialUEMessage(ue *InitialUE) (pdu []byte, err error) {
+	v, err := ue.initialUEMessage()
+	if err != nil {
+		return
+	}
+	pdu, err = encPDU(InitiatingMessageType, procCodeInitialUEMessage,
+		CriticalityIgnore, v)
+	return
+}
 
-	//BroadcasePLMNList
-	v = append(v, encBroadcastPLMNList()...)
+func (ue *InitialUE) initialUEMessage() (v *InitialUEMessage, err error) {
+	const t, msg = InitiatingMessageType, "InitialUEMessage"
+	v = &InitialUEMessage{
+		RANUENGAPID:           RANUENGAPID(ue.RANUENGAPID),
+		NASPDU:                NASPDU(ue.NASPDU),
+		RRCEstablishmentCause: ue.RRCEstablishmentCause,
+	}
+	if v.UserLocationInformation, err =
+		ue.Location.userLocationInformation(); err != nil {
+		err = ieError(err, t, msg, 2, "UserLocationInformation")
+		return
+	}
+	i := 4
+	if ue.STMSI != nil {
+		if v.FiveGSTMSI, err = ue.STMSI.fiveGSTMSI(); err != nil {
+			err = ieError(err, t, msg, i, "FiveG-S-TMSI")
+			return
+		}
+		i++
+	}
+	if ue.AMFSetID != nil {
+		var id AMFSetID
+		if id, err = amfSetID(*ue.AMFSetID); err != nil {
+			err = ieError(err, t, msg, i, "AMFSetID")
+			return
+		}
+		v.AMFSetID = &id
+	}
+	if ue.UEContextRequest == true {
+		req := UEContextRequestRequested
+		v.UEContextRequest = &req
+	}
 	return
 }
 
-// 9.3.3.10 TAC
+// 9.3.1.16 User Location Information
 /*
-TAC ::= OCTET STRING (SIZE(3))
- */
-func encTAC(tac []uint8) (v []uint8) {
-	const tacSize = 3
-	_, _, v, _ = per.EncOctetString(tac, tacSize, tacSize, false)
+  It returns only UserLocationInformationNR for now.
+UserLocationInformationNR ::= SEQUENCE {
+    nR-CGI              NR-CGI,
+    tAI                 TAI,
+    timeStamp           TimeStamp           OPTIONAL,
+    iE-Extensions       ProtocolExtensionContainer { {UserLocationInformationNR-ExtIEs} } OPTIONAL,
+    ...
+}
+*/
+func (l *NRLocation) userLocationInformation() (v UserLocationInformation,
+	err error) {
+
+	cgi, err := nrCGI(l.PLMN, l.CellID)
+	if err != nil {
+		err = per.WithPath(err, "userLocationInformationNR.nR-CGI")
+		return
+	}
+	nr := &UserLocationInformationNR{
+		NRCGI: cgi,
+		TAI:   TAI{PLMNIdentity: cgi.PLMNIdentity, TAC: TAC(l.TAC)},
+	}
+	if l.Time.IsZero() == false {
+		nr.TimeStamp = timeStamp(l.Time)
+	}
+	v.UserLocationInformationNR = nr
 	return
 }
 
+// 9.3.1.7 NR CGI
+/*
+NR-CGI ::= SEQUENCE {
+    pLMNIdentity        PLMNIdentity,
+    nRCellIdentity      NRCellIdentity,
+    iE-Extensions       ProtocolExtensionContainer { {NR-CGI-ExtIEs} } OPTIONAL,
+    ...
+}
+*/
+func nrCGI(plmn PLMN, cellID uint64) (v NRCGI, err error) {
+	if v.PLMNIdentity, err = plmn.identity(); err != nil {
+		err = per.WithPath(err, "pLMNIdentity")
+		return
+	}
+	if cellID>>36 != 0 {
+		err = per.WithPath(&per.ConstraintError{Constraint: fmt.Sprintf(
+			"NR cell ID=%d does not fit in 36 bits", cellID)},
+			"nRCellIdentity")
+		return
+	}
+	v.NRCellIdentity = NRCellIdentity(bitString(cellID, 36))
+	return
+}
+
+// timeStamp returns the seconds part of NTP timestamp (RFC 5905) of t.
+func timeStamp(t time.Time) *TimeStamp {
+	const ntpEpochOffset = 2208988800 // seconds from 1900 to 1970
+	s := uint32(t.Unix() + ntpEpochOffset)
+	return &TimeStamp{uint8(s >> 24), uint8(s >> 16), uint8(s >> 8),
+		uint8(s)}
+}
+
+// 9.3.3.20 5G-S-TMSI
+/*
+FiveG-S-TMSI ::= SEQUENCE {
+    aMFSetID        AMFSetID,
+    aMFPointer      AMFPointer,
+    fiveG-TMSI      FiveG-TMSI,
+    iE-Extensions   ProtocolExtensionContainer { {FiveG-S-TMSI-ExtIEs} } OPTIONAL,
+    ...
+}
+*/
+func (s *STMSI) fiveGSTMSI() (v *FiveGSTMSI, err error) {
+	setID, err := amfSetID(s.AMFSetID)
+	if err != nil {
+		err = per.WithPath(err, "aMFSetID")
+		return
+	}
+	if s.AMFPointer>>6 != 0 {
+		err = per.WithPath(&per.RangeError{Value: int(s.AMFPointer),
+			Min: 0, Max: 63}, "aMFPointer")
+		return
+	}
+	v = &FiveGSTMSI{
+		AMFSetID:   setID,
+		AMFPointer: AMFPointer(bitString(uint64(s.AMFPointer), 6)),
+		FiveGTMSI: FiveGTMSI{uint8(s.TMSI >> 24), uint8(s.TMSI >> 16),
+			uint8(s.TMSI >> 8), uint8(s.TMSI)},
+	}
+	return
+}
+
+func amfSetID(id uint16) (v AMFSetID, err error) {
+	if id>>10 != 0 {
+		err = &per.RangeError{Value: int(id), Min: 0, Max: 1023}
+		return
+	}
+	v = AMFSetID(bitString(uint64(id), 10))
+	return
+}
+
+// UplinkNAS is the NAS PDU of the UE sent to AMF by Uplink NAS Transport.
+type UplinkNAS struct {
+	AMFUENGAPID uint64
+	RANUENGAPID uint32
+	NASPDU      []byte
+	Location    NRLocation
+}
+
+// 9.2.5.3 UPLINK NAS TRANSPORT
+/*
+UplinkNASTransport-IEs NGAP-PROTOCOL-IES ::= {
+    { ID id-AMF-UE-NGAP-ID              CRITICALITY reject  TYPE AMF-UE-NGAP-ID                 PRESENCE mandatory  }|
+    { ID id-RAN-UE-NGAP-ID              CRITICALITY reject  TYPE RAN-UE-NGAP-ID                 PRESENCE mandatory  }|
+    { ID id-NAS-PDU                     CRITICALITY reject  TYPE NAS-PDU                        PRESENCE mandatory  }|
+    { ID id-UserLocationInformation     CRITICALITY ignore  TYPE UserLocationInformation        PRESENCE mandatory  },
+    ...
+}
+*/
+// MakeUplinkNASTransport returns NGAP-PDU of Uplink NAS Transport.
+func MakeUplinkNASTransport(m *UplinkNAS) (pdu []byte, err error) {
+	v := &UplinkNASTransport{
+		AMFUENGAPID: AMFUENGAPID(m.AMFUENGAPID),
+		RANUENGAPID: RANUENGAPID(m.RANUENGAPID),
+		NASPDU:      NASPDU(m.NASPDU),
+	}
+	if v.UserLocationInformation, err =
+		m.Location.userLocationInformation(); err != nil {
+		err = ieError(err, InitiatingMessageType, "UplinkNASTransport", 3,
+			"UserLocationInformation")
+		return
+	}
+	pdu, err = encPDU(InitiatingMessageType, procCodeUplinkNASTransport,
+		CriticalityIgnore, v)
+	return
+}
+
+// 9.2.5.2 DOWNLINK NAS TRANSPORT
+/*
+DownlinkNASTransport-IEs NGAP-PROTOCOL-IES ::= {
+    { ID id-AMF-UE-NGAP-ID              CRITICALITY reject  TYPE AMF-UE-NGAP-ID                 PRESENCE mandatory  }|
+    { ID id-RAN-UE-NGAP-ID              CRITICALITY reject  TYPE RAN-UE-NGAP-ID                 PRESENCE mandatory  }|
+    { ID id-OldAMF                      CRITICALITY reject  TYPE AMFName                        PRESENCE optional   }|
+    { ID id-RANPagingPriority           CRITICALITY ignore  TYPE RANPagingPriority              PRESENCE optional   }|
+    { ID id-NAS-PDU                     CRITICALITY reject  TYPE NAS-PDU                        PRESENCE mandatory  }|
+    { ID id-MobilityRestrictionList     CRITICALITY ignore  TYPE MobilityRestrictionList        PRESENCE optional   }|
+    { ID id-IndexToRFSP                 CRITICALITY ignore  TYPE IndexToRFSP                    PRESENCE optional   }|
+    { ID id-UEAggregateMaximumBitRate   CRITICALITY ignore  TYPE UEAggregateMaximumBitRate      PRESENCE optional   }|
+    { ID id-AllowedNSSAI                CRITICALITY reject  TYPE AllowedNSSAI                   PRESENCE optional   },
+    ...
+}
+*/
+// DecodeDownlinkNASTransport decodes NGAP-PDU of Downlink NAS Transport
+// sent by AMF.
+func DecodeDownlinkNASTransport(b []byte) (v *DownlinkNASTransport,
+	err error) {
+
+	pdu, err := Decode(b)
+	if err != nil {
+		return
+	}
+	v, ok := pdu.Value.(*DownlinkNASTransport)
+	if ok == false {
+		err = fmt.Errorf("not Downlink NAS Transport")
+	}
+	return
+}
+
+// 9.2.4.1 PAGING
+/*
+PagingIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-UEPagingIdentity                CRITICALITY ignore  TYPE UEPagingIdentity               PRESENCE mandatory  }|
+    { ID id-PagingDRX                       CRITICALITY ignore  TYPE PagingDRX                      PRESENCE optional   }|
+    { ID id-TAIListForPaging                CRITICALITY ignore  TYPE TAIListForPaging               PRESENCE mandatory  }|
+    { ID id-PagingPriority                  CRITICALITY ignore  TYPE PagingPriority                 PRESENCE optional   }|
+    { ID id-UERadioCapabilityForPaging      CRITICALITY ignore  TYPE UERadioCapabilityForPaging     PRESENCE optional   }|
+    { ID id-PagingOrigin                    CRITICALITY ignore  TYPE PagingOrigin                   PRESENCE optional   }|
+    { ID id-AssistanceDataForPaging         CRITICALITY ignore  TYPE AssistanceDataForPaging        PRESENCE optional   },
+    ...
+}
+*/
+// DecodePaging decodes NGAP-PDU of Paging sent by AMF.
+func DecodePaging(b []byte) (v *Paging, err error) {
+	pdu, err := Decode(b)
+	if err != nil {
+		return
+	}
+	v, ok := pdu.Value.(*Paging)
+	if ok == false {
+		err = fmt.Errorf("not Paging")
+	}
+	return
+}
+
+// UE Paging Identity
+/*
+UEPagingIdentity ::= CHOICE {
+    fiveG-S-TMSI            FiveG-S-TMSI,
+    choice-Extensions       ProtocolIE-SingleContainer { {UEPagingIdentity-ExtIEs} }
+}
+*/
+// STMSI returns 5G-S-TMSI of the paged UE in v.
+func (v *UEPagingIdentity) STMSI() (s STMSI, err error) {
+	id := v.FiveGSTMSI
+	if id == nil {
+		err = fmt.Errorf("no 5G-S-TMSI")
+		return
+	}
+	if len(id.FiveGTMSI) != 4 {
+		err = fmt.Errorf("invalid 5G-TMSI length=%d", len(id.FiveGTMSI))
+		return
+	}
+	s.AMFSetID = uint16(bitStringValue(per.BitString(id.AMFSetID)))
+	s.AMFPointer = uint8(bitStringValue(per.BitString(id.AMFPointer)))
+	s.TMSI = uint32(id.FiveGTMSI[0])<<24 | uint32(id.FiveGTMSI[1])<<16 |
+		uint32(id.FiveGTMSI[2])<<8 | uint32(id.FiveGTMSI[3])
+	return
+}
+
+// IdleUE is the UE in CM-IDLE that can be woken up by Paging.
+type IdleUE interface {
+	// STMSI returns 5G-S-TMSI assigned to the UE.
+	STMSI() STMSI
+	// ServiceRequest is called when the UE is paged. It should start
+	// Service Request procedure by Initial UE Message with RRC
+	// Establishment Cause mt-Access.
+	ServiceRequest(p *Paging) error
+}
+
+// WakeUp calls ServiceRequest of the UE in ues whose 5G-S-TMSI is UE Paging
+// Identity of p, and returns the UE. ue is nil if no UE in ues is paged.
+func WakeUp(p *Paging, ues []IdleUE) (ue IdleUE, err error) {
+	s, err := p.UEPagingIdentity.STMSI()
+	if err != nil {
+		return
+	}
+	for _, u := range ues {
+		if u.STMSI() == s {
+			ue = u
+			err = u.ServiceRequest(p)
+			return
+		}
+	}
+	return
+}
+
+// PDUSessionResult is the result of the procedure for the PDU session
+// resource sent to AMF. Transfer is the encoded transfer of the list that the
+// result is put in, e.g. PDU Session Resource Setup Response Transfer if the
+// setup succeeded, or PDU Session Resource Setup Unsuccessful Transfer if it
+// failed.
+type PDUSessionResult struct {
+	ID       uint8
+	Transfer []byte
+}
+
+// 9.2.2.1 INITIAL CONTEXT SETUP REQUEST
+/*
+InitialContextSetupRequestIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-AMF-UE-NGAP-ID                      CRITICALITY reject  TYPE AMF-UE-NGAP-ID                         PRESENCE mandatory      }|
+    { ID id-RAN-UE-NGAP-ID                      CRITICALITY reject  TYPE RAN-UE-NGAP-ID                         PRESENCE mandatory      }|
+    { ID id-OldAMF                              CRITICALITY reject  TYPE AMFName                                PRESENCE optional       }|
+    { ID id-UEAggregateMaximumBitRate           CRITICALITY reject  TYPE UEAggregateMaximumBitRate              PRESENCE conditional    }|
+    { ID id-CoreNetworkAssistanceInformation    CRITICALITY ignore  TYPE CoreNetworkAssistanceInformation       PRESENCE optional       }|
+    { ID id-GUAMI                               CRITICALITY reject  TYPE GUAMI                                  PRESENCE mandatory      }|
+    { ID id-PDUSessionResourceSetupListCxtReq   CRITICALITY reject  TYPE PDUSessionResourceSetupListCxtReq      PRESENCE optional       }|
+    { ID id-AllowedNSSAI                        CRITICALITY reject  TYPE AllowedNSSAI                           PRESENCE mandatory      }|
+    { ID id-UESecurityCapabilities              CRITICALITY reject  TYPE UESecurityCapabilities                 PRESENCE mandatory      }|
+    { ID id-SecurityKey                         CRITICALITY reject  TYPE SecurityKey                            PRESENCE mandatory      }|
+    { ID id-TraceActivation                     CRITICALITY ignore  TYPE TraceActivation                        PRESENCE optional       }|
+    { ID id-MobilityRestrictionList             CRITICALITY ignore  TYPE MobilityRestrictionList                PRESENCE optional       }|
+    { ID id-UERadioCapability                   CRITICALITY ignore  TYPE UERadioCapability                      PRESENCE optional       }|
+    { ID id-IndexToRFSP                         CRITICALITY ignore  TYPE IndexToRFSP                            PRESENCE optional       }|
+    { ID id-MaskedIMEISV                        CRITICALITY ignore  TYPE MaskedIMEISV                           PRESENCE optional       }|
+    { ID id-NAS-PDU                             CRITICALITY ignore  TYPE NAS-PDU                                PRESENCE optional       }|
+    { ID id-EmergencyFallbackIndicator          CRITICALITY reject  TYPE EmergencyFallbackIndicator             PRESENCE optional       }|
+    { ID id-RRCInactiveTransitionReportRequest  CRITICALITY ignore  TYPE RRCInactiveTransitionReportRequest     PRESENCE optional       }|
+    { ID id-UERadioCapabilityForPaging          CRITICALITY ignore  TYPE UERadioCapabilityForPaging             PRESENCE optional       }|
+    { ID id-RedirectionVoiceFallback            CRITICALITY ignore  TYPE RedirectionVoiceFallback               PRESENCE optional       },
+    ...
+}
+*/
+// DecodeInitialContextSetupRequest decodes NGAP-PDU of Initial Context
+// Setup Request sent by AMF.
+func DecodeInitialContextSetupRequest(b []byte) (
+	v *InitialContextSetupRequest, err error) {
+
+	pdu, err := Decode(b)
+	if err != nil {
+		return
+	}
+	v, ok := pdu.Value.(*InitialContextSetupRequest)
+	if ok == false {
+		err = fmt.Errorf("not Initial Context Setup Request")
+	}
+	return
+}
+
+// 9.2.2.2 INITIAL CONTEXT SETUP RESPONSE
+/*
+InitialContextSetupResponseIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-AMF-UE-NGAP-ID                              CRITICALITY ignore  TYPE AMF-UE-NGAP-ID                                 PRESENCE mandatory  }|
+    { ID id-RAN-UE-NGAP-ID                              CRITICALITY ignore  TYPE RAN-UE-NGAP-ID                                 PRESENCE mandatory  }|
+    { ID id-PDUSessionResourceSetupListCxtRes           CRITICALITY ignore  TYPE PDUSessionResourceSetupListCxtRes              PRESENCE optional   }|
+    { ID id-PDUSessionResourceFailedToSetupListCxtRes   CRITICALITY ignore  TYPE PDUSessionResourceFailedToSetupListCxtRes      PRESENCE optional   }|
+    { ID id-CriticalityDiagnostics                      CRITICALITY ignore  TYPE CriticalityDiagnostics                         PRESENCE optional   },
+    ...
+}
+*/
+// MakeInitialContextSetupResponse returns NGAP-PDU of Initial Context Setup
+// Response to req. The PDU session resources in setup are set up, and the
+// ones in failed are not.
+func MakeInitialContextSetupResponse(req *InitialContextSetupRequest,
+	setup, failed []PDUSessionResult) (pdu []byte, err error) {
+
+	v := &InitialContextSetupResponse{
+		AMFUENGAPID: req.AMFUENGAPID,
+		RANUENGAPID: req.RANUENGAPID,
+	}
+	if len(setup) > 0 {
+		list := make(PDUSessionResourceSetupListCxtRes, len(setup))
+		for i, s := range setup {
+			list[i].PDUSessionID = PDUSessionID(s.ID)
+			list[i].PDUSessionResourceSetupResponseTransfer = s.Transfer
+		}
+		v.PDUSessionResourceSetupListCxtRes = &list
+	}
+	if len(failed) > 0 {
+		list := make(PDUSessionResourceFailedToSetupListCxtRes, len(failed))
+		for i, s := range failed {
+			list[i].PDUSessionID = PDUSessionID(s.ID)
+			list[i].PDUSessionResourceSetupUnsuccessfulTransfer = s.Transfer
+		}
+		v.PDUSessionResourceFailedToSetupListCxtRes = &list
+	}
+	pdu, err = encPDU(SuccessfulOutcomeType, procCodeInitialContextSetup,
+		CriticalityReject, v)
+	return
+}
+
+// 9.2.2.3 INITIAL CONTEXT SETUP FAILURE
+/*
+InitialContextSetupFailureIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-AMF-UE-NGAP-ID                              CRITICALITY ignore  TYPE AMF-UE-NGAP-ID                                 PRESENCE mandatory  }|
+    { ID id-RAN-UE-NGAP-ID                              CRITICALITY ignore  TYPE RAN-UE-NGAP-ID                                 PRESENCE mandatory  }|
+    { ID id-PDUSessionResourceFailedToSetupListCxtFail  CRITICALITY ignore  TYPE PDUSessionResourceFailedToSetupListCxtFail     PRESENCE optional   }|
+    { ID id-Cause                                       CRITICALITY ignore  TYPE Cause                                          PRESENCE mandatory  }|
+    { ID id-CriticalityDiagnostics                      CRITICALITY ignore  TYPE CriticalityDiagnostics                         PRESENCE optional   },
+    ...
+}
+*/
+// MakeInitialContextSetupFailure returns NGAP-PDU of Initial Context Setup
+// Failure to req with the cause. The PDU session resources in failed are
+// not set up.
+func MakeInitialContextSetupFailure(req *InitialContextSetupRequest,
+	cause Cause, failed []PDUSessionResult) (pdu []byte, err error) {
+
+	v := &InitialContextSetupFailure{
+		AMFUENGAPID: req.AMFUENGAPID,
+		RANUENGAPID: req.RANUENGAPID,
+		Cause:       cause,
+	}
+	if len(failed) > 0 {
+		list := make(PDUSessionResourceFailedToSetupListCxtFail, len(failed))
+		for i, s := range failed {
+			list[i].PDUSessionID = PDUSessionID(s.ID)
+			list[i].PDUSessionResourceSetupUnsuccessfulTransfer = s.Transfer
+		}
+		v.PDUSessionResourceFailedToSetupListCxtFail = &list
+	}
+	pdu, err = encPDU(UnsuccessfulOutcomeType, procCodeInitialContextSetup,
+		CriticalityReject, v)
+	return
+}
+
+// 9.2.2.4 UE CONTEXT RELEASE REQUEST
+/*
+UEContextReleaseRequest-IEs NGAP-PROTOCOL-IES ::= {
+    { ID id-AMF-UE-NGAP-ID                      CRITICALITY reject  TYPE AMF-UE-NGAP-ID                         PRESENCE mandatory  }|
+    { ID id-RAN-UE-NGAP-ID                      CRITICALITY reject  TYPE RAN-UE-NGAP-ID                         PRESENCE mandatory  }|
+    { ID id-PDUSessionResourceListCxtRelReq     CRITICALITY reject  TYPE PDUSessionResourceListCxtRelReq        PRESENCE optional   }|
+    { ID id-Cause                               CRITICALITY ignore  TYPE Cause                                  PRESENCE mandatory  },
+    ...
+}
+*/
+// MakeUEContextReleaseRequest returns NGAP-PDU of UE Context Release Request
+// for the UE identified by amfID and ranID with the cause. pduSessionIDs are
+// the PDU sessions of the UE with active resources, and omitted if empty.
+func MakeUEContextReleaseRequest(amfID uint64, ranID uint32, cause Cause,
+	pduSessionIDs []uint8) (pdu []byte, err error) {
+
+	v := &UEContextReleaseRequest{
+		AMFUENGAPID: AMFUENGAPID(amfID),
+		RANUENGAPID: RANUENGAPID(ranID),
+		Cause:       cause,
+	}
+	if len(pduSessionIDs) > 0 {
+		list := make(PDUSessionResourceListCxtRelReq, len(pduSessionIDs))
+		for i, id := range pduSessionIDs {
+			list[i].PDUSessionID = PDUSessionID(id)
+		}
+		v.PDUSessionResourceListCxtRelReq = &list
+	}
+	pdu, err = encPDU(InitiatingMessageType, procCodeUEContextReleaseRequest,
+		CriticalityIgnore, v)
+	return
+}
+
+// 9.2.2.5 UE CONTEXT RELEASE COMMAND
+/*
+UEContextReleaseCommand-IEs NGAP-PROTOCOL-IES ::= {
+    { ID id-UE-NGAP-IDs                         CRITICALITY reject  TYPE UE-NGAP-IDs                            PRESENCE mandatory  }|
+    { ID id-Cause                               CRITICALITY ignore  TYPE Cause                                  PRESENCE mandatory  },
+    ...
+}
+*/
+// DecodeUEContextReleaseCommand decodes NGAP-PDU of UE Context Release
+// Command sent by AMF.
+func DecodeUEContextReleaseCommand(b []byte) (v *UEContextReleaseCommand,
+	err error) {
+
+	pdu, err := Decode(b)
+	if err != nil {
+		return
+	}
+	v, ok := pdu.Value.(*UEContextReleaseCommand)
+	if ok == false {
+		err = fmt.Errorf("not UE Context Release Command")
+	}
+	return
+}
+
+// UE NGAP IDs
+/*
+UE-NGAP-IDs ::= CHOICE {
+    uE-NGAP-ID-pair         UE-NGAP-ID-pair,
+    aMF-UE-NGAP-ID          AMF-UE-NGAP-ID,
+    choice-Extensions       ProtocolIE-SingleContainer { {UE-NGAP-IDs-ExtIEs} }
+}
+*/
+// IDs returns AMF UE NGAP ID and RAN UE NGAP ID in v. hasRANID is false if
+// v has only AMF UE NGAP ID, and the UE should be found by it.
+func (v *UENGAPIDs) IDs() (amfID uint64, ranID uint32, hasRANID bool,
+	err error) {
+
+	switch {
+	case v.UENGAPIDPair != nil:
+		amfID = uint64(v.UENGAPIDPair.AMFUENGAPID)
+		ranID = uint32(v.UENGAPIDPair.RANUENGAPID)
+		hasRANID = true
+	case v.AMFUENGAPID != nil:
+		amfID = uint64(*v.AMFUENGAPID)
+	default:
+		err = fmt.Errorf("no UE NGAP IDs")
+	}
+	return
+}
+
+// ReleasedUE is the UE whose context is released by UE Context Release
+// Complete.
+type ReleasedUE struct {
+	AMFUENGAPID   uint64
+	RANUENGAPID   uint32
+	Location      *NRLocation // User Location Information is omitted if nil
+	PDUSessionIDs []uint8     // the released PDU sessions with resources
+	Paging        *PagingRecommendation
+}
+
+// PagingRecommendation is the cells and the gNB recommended to AMF for
+// paging the UE.
+type PagingRecommendation struct {
+	Cells []RecommendedCell
+	GNB   *GNBConfig
+}
+
+// RecommendedCell is the NR cell that the UE visited. Stayed is the time the
+// UE stayed in the cell, and omitted if zero.
+type RecommendedCell struct {
+	PLMN   PLMN
+	CellID uint64 // NR Cell Identity, 36 bits
+	Stayed time.Duration
+}
+
+// 9.2.2.6 UE CONTEXT RELEASE COMPLETE
+/*
+UEContextReleaseComplete-IEs NGAP-PROTOCOL-IES ::= {
+    { ID id-AMF-UE-NGAP-ID                              CRITICALITY ignore  TYPE AMF-UE-NGAP-ID                                 PRESENCE mandatory  }|
+    { ID id-RAN-UE-NGAP-ID                              CRITICALITY ignore  TYPE RAN-UE-NGAP-ID                                 PRESENCE mandatory  }|
+    { ID id-UserLocationInformation                     CRITICALITY ignore  TYPE UserLocationInformation                        PRESENCE optional   }|
+    { ID id-InfoOnRecommendedCellsAndRANNodesForPaging  CRITICALITY ignore  TYPE InfoOnRecommendedCellsAndRANNodesForPaging     PRESENCE optional   }|
+    { ID id-PDUSessionResourceListCxtRelCpl             CRITICALITY reject  TYPE PDUSessionResourceListCxtRelCpl                PRESENCE optional   }|
+    { ID id-CriticalityDiagnostics                      CRITICALITY ignore  TYPE CriticalityDiagnostics                         PRESENCE optional   },
+    ...
+}
+*/
+// MakeUEContextReleaseComplete returns NGAP-PDU of UE Context Release
+// Complete for the UE.
+func MakeUEContextReleaseComplete(ue *ReleasedUE) (pdu []byte, err error) {
+	v, err := ue.ueContextReleaseComplete()
+	if err != nil {
+		return
+	}
+	pdu, err = encPDU(SuccessfulOutcomeType, procCodeUEContextRelease,
+		CriticalityReject, v)
+	return
+}
+
+func (ue *ReleasedUE) ueContextReleaseComplete() (
+	v *UEContextReleaseComplete, err error) {
+
+	const t, msg = SuccessfulOutcomeType, "UEContextReleaseComplete"
+	v = &UEContextReleaseComplete{
+		AMFUENGAPID: AMFUENGAPID(ue.AMFUENGAPID),
+		RANUENGAPID: RANUENGAPID(ue.RANUENGAPID),
+	}
+	i := 2
+	if ue.Location != nil {
+		var loc UserLocationInformation
+		if loc, err = ue.Location.userLocationInformation(); err != nil {
+			err = ieError(err, t, msg, i, "UserLocationInformation")
+			return
+		}
+		v.UserLocationInformation = &loc
+		i++
+	}
+	if ue.Paging != nil {
+		if v.InfoOnRecommendedCellsAndRANNodesForPaging, err =
+			ue.Paging.info(); err != nil {
+			err = ieError(err, t, msg, i,
+				"InfoOnRecommendedCellsAndRANNodesForPaging")
+			return
+		}
+	}
+	if len(ue.PDUSessionIDs) > 0 {
+		list := make(PDUSessionResourceListCxtRelCpl, len(ue.PDUSessionIDs))
+		for i, id := range ue.PDUSessionIDs {
+			list[i].PDUSessionID = PDUSessionID(id)
+		}
+		v.PDUSessionResourceListCxtRelCpl = &list
+	}
+	return
+}
+
+// Information on Recommended Cells and RAN Nodes for Paging
+/*
+  It returns only the gNB of p as the recommended RAN node for now.
+InfoOnRecommendedCellsAndRANNodesForPaging ::= SEQUENCE {
+    recommendedCellsForPaging       RecommendedCellsForPaging,
+    recommendRANNodesForPaging      RecommendedRANNodesForPaging,
+    iE-Extensions       ProtocolExtensionContainer { {InfoOnRecommendedCellsAndRANNodesForPaging-ExtIEs} } OPTIONAL,
+    ...
+}
+*/
+func (p *PagingRecommendation) info() (
+	v *InfoOnRecommendedCellsAndRANNodesForPaging, err error) {
+
+	if p.GNB == nil {
+		err = per.WithPath(&per.ConstraintError{
+			Constraint: "no recommended gNB for paging"},
+			"recommendRANNodesForPaging.recommendedRANNodeList")
+		return
+	}
+	node, err := p.GNB.globalRANNodeID()
+	if err != nil {
+		err = per.WithPath(err, "recommendRANNodesForPaging."+
+			"recommendedRANNodeList[0].aMFPagingTarget.globalRANNodeID")
+		return
+	}
+	cells := make(RecommendedCellList, len(p.Cells))
+	for i, c := range p.Cells {
+		var cgi NRCGI
+		if cgi, err = nrCGI(c.PLMN, c.CellID); err != nil {
+			err = per.WithPath(err, fmt.Sprintf("recommendedCellsForPaging."+
+				"recommendedCellList[%d].nGRAN-CGI.nR-CGI", i))
+			return
+		}
+		cells[i].NGRANCGI.NRCGI = &cgi
+		if c.Stayed > 0 {
+			stayed := int(c.Stayed / time.Second)
+			if stayed > 4095 {
+				stayed = 4095
+			}
+			cells[i].TimeStayedInCell = &stayed
+		}
+	}
+	v = &InfoOnRecommendedCellsAndRANNodesForPaging{
+		RecommendedCellsForPaging: RecommendedCellsForPaging{
+			RecommendedCellList: cells,
+		},
+		RecommendRANNodesForPaging: RecommendedRANNodesForPaging{
+			RecommendedRANNodeList: RecommendedRANNodeList{{
+				AMFPagingTarget: AMFPagingTarget{GlobalRANNodeID: &node},
+			}},
+		},
+	}
+	return
+}
+
+// 9.2.1.1 PDU SESSION RESOURCE SETUP REQUEST
+/*
+PDUSessionResourceSetupRequestIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-AMF-UE-NGAP-ID                          CRITICALITY reject  TYPE AMF-UE-NGAP-ID                             PRESENCE mandatory  }|
+    { ID id-RAN-UE-NGAP-ID                          CRITICALITY reject  TYPE RAN-UE-NGAP-ID                             PRESENCE mandatory  }|
+    { ID id-RANPagingPriority                       CRITICALITY ignore  TYPE RANPagingPriority                          PRESENCE optional   }|
+    { ID id-NAS-PDU                                 CRITICALITY reject  TYPE NAS-PDU                                    PRESENCE optional   }|
+    { ID id-PDUSessionResourceSetupListSUReq        CRITICALITY reject  TYPE PDUSessionResourceSetupListSUReq           PRESENCE mandatory  }|
+    { ID id-UEAggregateMaximumBitRate               CRITICALITY ignore  TYPE UEAggregateMaximumBitRate                  PRESENCE optional   },
+    ...
+}
+*/
+// DecodePDUSessionResourceSetupRequest decodes NGAP-PDU of PDU Session
+// Resource Setup Request sent by AMF.
+func DecodePDUSessionResourceSetupRequest(b []byte) (
+	v *PDUSessionResourceSetupRequest, err error) {
+
+	pdu, err := Decode(b)
+	if err != nil {
+		return
+	}
+	v, ok := pdu.Value.(*PDUSessionResourceSetupRequest)
+	if ok == false {
+		err = fmt.Errorf("not PDU Session Resource Setup Request")
+	}
+	return
+}
+
+// 9.2.1.2 PDU SESSION RESOURCE SETUP RESPONSE
+/*
+PDUSessionResourceSetupResponseIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-AMF-UE-NGAP-ID                              CRITICALITY ignore  TYPE AMF-UE-NGAP-ID                                 PRESENCE mandatory  }|
+    { ID id-RAN-UE-NGAP-ID                              CRITICALITY ignore  TYPE RAN-UE-NGAP-ID                                 PRESENCE mandatory  }|
+    { ID id-PDUSessionResourceSetupListSURes            CRITICALITY ignore  TYPE PDUSessionResourceSetupListSURes               PRESENCE optional   }|
+    { ID id-PDUSessionResourceFailedToSetupListSURes    CRITICALITY ignore  TYPE PDUSessionResourceFailedToSetupListSURes       PRESENCE optional   }|
+    { ID id-CriticalityDiagnostics                      CRITICALITY ignore  TYPE CriticalityDiagnostics                         PRESENCE optional   },
+    ...
+}
+*/
+// MakePDUSessionResourceSetupResponse returns NGAP-PDU of PDU Session
+// Resource Setup Response to req. The PDU session resources in setup are set
+// up, and the ones in failed are not.
+func MakePDUSessionResourceSetupResponse(req *PDUSessionResourceSetupRequest,
+	setup, failed []PDUSessionResult) (pdu []byte, err error) {
+
+	v := &PDUSessionResourceSetupResponse{
+		AMFUENGAPID: req.AMFUENGAPID,
+		RANUENGAPID: req.RANUENGAPID,
+	}
+	if len(setup) > 0 {
+		list := make(PDUSessionResourceSetupListSURes, len(setup))
+		for i, s := range setup {
+			list[i].PDUSessionID = PDUSessionID(s.ID)
+			list[i].PDUSessionResourceSetupResponseTransfer = s.Transfer
+		}
+		v.PDUSessionResourceSetupListSURes = &list
+	}
+	if len(failed) > 0 {
+		list := make(PDUSessionResourceFailedToSetupListSURes, len(failed))
+		for i, s := range failed {
+			list[i].PDUSessionID = PDUSessionID(s.ID)
+			list[i].PDUSessionResourceSetupUnsuccessfulTransfer = s.Transfer
+		}
+		v.PDUSessionResourceFailedToSetupListSURes = &list
+	}
+	pdu, err = encPDU(SuccessfulOutcomeType, procCodePDUSessionResourceSetup,
+		CriticalityReject, v)
+	return
+}
+
+// 9.3.4.1 PDU Session Resource Setup Request Transfer
+/*
+PDUSessionResourceSetupRequestTransferIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-PDUSessionAggregateMaximumBitRate       CRITICALITY reject  TYPE PDUSessionAggregateMaximumBitRate      PRESENCE optional   }|
+    { ID id-UL-NGU-UP-TNLInformation                CRITICALITY reject  TYPE UPTransportLayerInformation            PRESENCE mandatory  }|
+    { ID id-AdditionalUL-NGU-UP-TNLInformation      CRITICALITY reject  TYPE UPTransportLayerInformationList        PRESENCE optional   }|
+    { ID id-DataForwardingNotPossible               CRITICALITY reject  TYPE DataForwardingNotPossible              PRESENCE optional   }|
+    { ID id-PDUSessionType                          CRITICALITY reject  TYPE PDUSessionType                         PRESENCE mandatory  }|
+    { ID id-SecurityIndication                      CRITICALITY reject  TYPE SecurityIndication                     PRESENCE optional   }|
+    { ID id-NetworkInstance                         CRITICALITY reject  TYPE NetworkInstance                        PRESENCE optional   }|
+    { ID id-QosFlowSetupRequestList                 CRITICALITY reject  TYPE QosFlowSetupRequestList                PRESENCE mandatory  },
+    ...
+}
+*/
+// DecodePDUSessionResourceSetupRequestTransfer decodes PDU Session Resource
+// Setup Request Transfer in the item of PDU Session Resource Setup Request
+// or Initial Context Setup Request.
+func DecodePDUSessionResourceSetupRequestTransfer(b []byte) (
+	v *PDUSessionResourceSetupRequestTransfer, err error) {
+
+	v = &PDUSessionResourceSetupRequestTransfer{}
+	if err = per.Unmarshal(b, v); err != nil {
+		err = per.WithPath(err, "PDUSessionResourceSetupRequestTransfer")
+		v = nil
+	}
+	return
+}
+
+// 9.3.4.2 PDU Session Resource Setup Response Transfer
+/*
+PDUSessionResourceSetupResponseTransfer ::= SEQUENCE {
+    qosFlowPerTNLInformation                QosFlowPerTNLInformation,
+    additionalQosFlowPerTNLInformation      QosFlowPerTNLInformation                        OPTIONAL,
+    securityResult                          SecurityResult                                  OPTIONAL,
+    qosFlowFailedToSetupList                QosFlowList                                     OPTIONAL,
+    iE-Extensions       ProtocolExtensionContainer { {PDUSessionResourceSetupResponseTransfer-ExtIEs} } OPTIONAL,
+    ...
+}
+*/
+// MakePDUSessionResourceSetupResponseTransfer returns PDU Session Resource
+// Setup Response Transfer with the DL tunnel of the gNB for the QoS flows
+// identified by qfis.
+func MakePDUSessionResourceSetupResponseTransfer(dl Tunnel, qfis []uint8) (
+	b []byte, err error) {
+
+	info, err := dl.qosFlowPerTNLInformation(qfis)
+	if err != nil {
+		return
+	}
+	b, err = per.Marshal(&PDUSessionResourceSetupResponseTransfer{
+		QosFlowPerTNLInformation: info,
+	})
+	err = per.WithPath(err, "PDUSessionResourceSetupResponseTransfer")
+	return
+}
+
+// PDU Session Resource Setup Unsuccessful Transfer
+/*
+PDUSessionResourceSetupUnsuccessfulTransfer ::= SEQUENCE {
+    cause                       Cause,
+    criticalityDiagnostics      CriticalityDiagnostics      OPTIONAL,
+    iE-Extensions       ProtocolExtensionContainer { {PDUSessionResourceSetupUnsuccessfulTransfer-ExtIEs} } OPTIONAL,
+    ...
+}
+*/
+// MakePDUSessionResourceSetupUnsuccessfulTransfer returns PDU Session
+// Resource Setup Unsuccessful Transfer with the cause.
+func MakePDUSessionResourceSetupUnsuccessfulTransfer(cause Cause) (
+	b []byte, err error) {
+
+	b, err = per.Marshal(&PDUSessionResourceSetupUnsuccessfulTransfer{
+		Cause: cause,
+	})
+	err = per.WithPath(err, "PDUSessionResourceSetupUnsuccessfulTransfer")
+	return
+}
+
+// 9.2.1.3 PDU SESSION RESOURCE RELEASE COMMAND
+/*
+PDUSessionResourceReleaseCommandIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-AMF-UE-NGAP-ID                          CRITICALITY reject  TYPE AMF-UE-NGAP-ID                             PRESENCE mandatory  }|
+    { ID id-RAN-UE-NGAP-ID                          CRITICALITY reject  TYPE RAN-UE-NGAP-ID                             PRESENCE mandatory  }|
+    { ID id-RANPagingPriority                       CRITICALITY ignore  TYPE RANPagingPriority                          PRESENCE optional   }|
+    { ID id-NAS-PDU                                 CRITICALITY ignore  TYPE NAS-PDU                                    PRESENCE optional   }|
+    { ID id-PDUSessionResourceToReleaseListRelCmd   CRITICALITY reject  TYPE PDUSessionResourceToReleaseListRelCmd      PRESENCE mandatory  },
+    ...
+}
+*/
+// DecodePDUSessionResourceReleaseCommand decodes NGAP-PDU of PDU Session
+// Resource Release Command sent by AMF.
+func DecodePDUSessionResourceReleaseCommand(b []byte) (
+	v *PDUSessionResourceReleaseCommand, err error) {
+
+	pdu, err := Decode(b)
+	if err != nil {
+		return
+	}
+	v, ok := pdu.Value.(*PDUSessionResourceReleaseCommand)
+	if ok == false {
+		err = fmt.Errorf("not PDU Session Resource Release Command")
+	}
+	return
+}
+
+// 9.2.1.4 PDU SESSION RESOURCE RELEASE RESPONSE
+/*
+PDUSessionResourceReleaseResponseIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-AMF-UE-NGAP-ID                          CRITICALITY ignore  TYPE AMF-UE-NGAP-ID                             PRESENCE mandatory  }|
+    { ID id-RAN-UE-NGAP-ID                          CRITICALITY ignore  TYPE RAN-UE-NGAP-ID                             PRESENCE mandatory  }|
+    { ID id-PDUSessionResourceReleasedListRelRes    CRITICALITY ignore  TYPE PDUSessionResourceReleasedListRelRes       PRESENCE mandatory  }|
+    { ID id-UserLocationInformation                 CRITICALITY ignore  TYPE UserLocationInformation                    PRESENCE optional   }|
+    { ID id-CriticalityDiagnostics                  CRITICALITY ignore  TYPE CriticalityDiagnostics                     PRESENCE optional   },
+    ...
+}
+*/
+// MakePDUSessionResourceReleaseResponse returns NGAP-PDU of PDU Session
+// Resource Release Response to cmd. The Transfer of released is PDU Session
+// Resource Release Response Transfer.
+func MakePDUSessionResourceReleaseResponse(
+	cmd *PDUSessionResourceReleaseCommand, released []PDUSessionResult) (
+	pdu []byte, err error) {
+
+	list := make(PDUSessionResourceReleasedListRelRes, len(released))
+	for i, s := range released {
+		list[i].PDUSessionID = PDUSessionID(s.ID)
+		list[i].PDUSessionResourceReleaseResponseTransfer = s.Transfer
+	}
+	v := &PDUSessionResourceReleaseResponse{
+		AMFUENGAPID:                          cmd.AMFUENGAPID,
+		RANUENGAPID:                          cmd.RANUENGAPID,
+		PDUSessionResourceReleasedListRelRes: list,
+	}
+	pdu, err = encPDU(SuccessfulOutcomeType, procCodePDUSessionResourceRelease,
+		CriticalityReject, v)
+	return
+}
+
+// 9.2.1.5 PDU SESSION RESOURCE MODIFY REQUEST
+/*
+PDUSessionResourceModifyRequestIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-AMF-UE-NGAP-ID                          CRITICALITY reject  TYPE AMF-UE-NGAP-ID                             PRESENCE mandatory  }|
+    { ID id-RAN-UE-NGAP-ID                          CRITICALITY reject  TYPE RAN-UE-NGAP-ID                             PRESENCE mandatory  }|
+    { ID id-RANPagingPriority                       CRITICALITY ignore  TYPE RANPagingPriority                          PRESENCE optional   }|
+    { ID id-PDUSessionResourceModifyListModReq      CRITICALITY reject  TYPE PDUSessionResourceModifyListModReq         PRESENCE mandatory  },
+    ...
+}
+*/
+// DecodePDUSessionResourceModifyRequest decodes NGAP-PDU of PDU Session
+// Resource Modify Request sent by AMF.
+func DecodePDUSessionResourceModifyRequest(b []byte) (
+	v *PDUSessionResourceModifyRequest, err error) {
+
+	pdu, err := Decode(b)
+	if err != nil {
+		return
+	}
+	v, ok := pdu.Value.(*PDUSessionResourceModifyRequest)
+	if ok == false {
+		err = fmt.Errorf("not PDU Session Resource Modify Request")
+	}
+	return
+}
+
+// 9.2.1.6 PDU SESSION RESOURCE MODIFY RESPONSE
+/*
+PDUSessionResourceModifyResponseIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-AMF-UE-NGAP-ID                              CRITICALITY ignore  TYPE AMF-UE-NGAP-ID                                 PRESENCE mandatory  }|
+    { ID id-RAN-UE-NGAP-ID                              CRITICALITY ignore  TYPE RAN-UE-NGAP-ID                                 PRESENCE mandatory  }|
+    { ID id-PDUSessionResourceModifyListModRes          CRITICALITY ignore  TYPE PDUSessionResourceModifyListModRes             PRESENCE optional   }|
+    { ID id-PDUSessionResourceFailedToModifyListModRes  CRITICALITY ignore  TYPE PDUSessionResourceFailedToModifyListModRes     PRESENCE optional   }|
+    { ID id-UserLocationInformation                     CRITICALITY ignore  TYPE UserLocationInformation                        PRESENCE optional   }|
+    { ID id-CriticalityDiagnostics                      CRITICALITY ignore  TYPE CriticalityDiagnostics                         PRESENCE optional   },
+    ...
+}
+*/
+// MakePDUSessionResourceModifyResponse returns NGAP-PDU of PDU Session
+// Resource Modify Response to req. The PDU session resources in modified are
+// modified, and the ones in failed are not.
+func MakePDUSessionResourceModifyResponse(req *PDUSessionResourceModifyRequest,
+	modified, failed []PDUSessionResult) (pdu []byte, err error) {
+
+	v := &PDUSessionResourceModifyResponse{
+		AMFUENGAPID: req.AMFUENGAPID,
+		RANUENGAPID: req.RANUENGAPID,
+	}
+	if len(modified) > 0 {
+		list := make(PDUSessionResourceModifyListModRes, len(modified))
+		for i, s := range modified {
+			list[i].PDUSessionID = PDUSessionID(s.ID)
+			list[i].PDUSessionResourceModifyResponseTransfer = s.Transfer
+		}
+		v.PDUSessionResourceModifyListModRes = &list
+	}
+	if len(failed) > 0 {
+		list := make(PDUSessionResourceFailedToModifyListModRes, len(failed))
+		for i, s := range failed {
+			list[i].PDUSessionID = PDUSessionID(s.ID)
+			list[i].PDUSessionResourceModifyUnsuccessfulTransfer = s.Transfer
+		}
+		v.PDUSessionResourceFailedToModifyListModRes = &list
+	}
+	pdu, err = encPDU(SuccessfulOutcomeType, procCodePDUSessionResourceModify,
+		CriticalityReject, v)
+	return
+}
+
+// 9.2.1.7 PDU SESSION RESOURCE NOTIFY
+/*
+PDUSessionResourceNotifyIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-AMF-UE-NGAP-ID                          CRITICALITY reject  TYPE AMF-UE-NGAP-ID                             PRESENCE mandatory  }|
+    { ID id-RAN-UE-NGAP-ID                          CRITICALITY reject  TYPE RAN-UE-NGAP-ID                             PRESENCE mandatory  }|
+    { ID id-PDUSessionResourceNotifyList            CRITICALITY reject  TYPE PDUSessionResourceNotifyList               PRESENCE optional   }|
+    { ID id-PDUSessionResourceReleasedListNot       CRITICALITY ignore  TYPE PDUSessionResourceReleasedListNot          PRESENCE optional   }|
+    { ID id-UserLocationInformation                 CRITICALITY ignore  TYPE UserLocationInformation                    PRESENCE optional   },
+    ...
+}
+*/
+// MakePDUSessionResourceNotify returns NGAP-PDU of PDU Session Resource
+// Notify for the UE identified by amfID and ranID. The Transfer of notify is
+// PDU Session Resource Notify Transfer, and the one of released is PDU
+// Session Resource Notify Released Transfer.
+func MakePDUSessionResourceNotify(amfID uint64, ranID uint32,
+	notify, released []PDUSessionResult) (pdu []byte, err error) {
+
+	v := &PDUSessionResourceNotify{
+		AMFUENGAPID: AMFUENGAPID(amfID),
+		RANUENGAPID: RANUENGAPID(ranID),
+	}
+	if len(notify) > 0 {
+		list := make(PDUSessionResourceNotifyList, len(notify))
+		for i, s := range notify {
+			list[i].PDUSessionID = PDUSessionID(s.ID)
+			list[i].PDUSessionResourceNotifyTransfer = s.Transfer
+		}
+		v.PDUSessionResourceNotifyList = &list
+	}
+	if len(released) > 0 {
+		list := make(PDUSessionResourceReleasedListNot, len(released))
+		for i, s := range released {
+			list[i].PDUSessionID = PDUSessionID(s.ID)
+			list[i].PDUSessionResourceNotifyReleasedTransfer = s.Transfer
+		}
+		v.PDUSessionResourceReleasedListNot = &list
+	}
+	pdu, err = encPDU(InitiatingMessageType, procCodePDUSessionResourceNotify,
+		CriticalityIgnore, v)
+	return
+}
+
+// 9.2.1.8 PDU SESSION RESOURCE MODIFY INDICATION
+/*
+PDUSessionResourceModifyIndicationIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-AMF-UE-NGAP-ID                          CRITICALITY reject  TYPE AMF-UE-NGAP-ID                             PRESENCE mandatory  }|
+    { ID id-RAN-UE-NGAP-ID                          CRITICALITY reject  TYPE RAN-UE-NGAP-ID                             PRESENCE mandatory  }|
+    { ID id-PDUSessionResourceModifyListModInd      CRITICALITY reject  TYPE PDUSessionResourceModifyListModInd         PRESENCE mandatory  },
+    ...
+}
+*/
+// MakePDUSessionResourceModifyIndication returns NGAP-PDU of PDU Session
+// Resource Modify Indication for the UE identified by amfID and ranID. The
+// Transfer of modify is PDU Session Resource Modify Indication Transfer.
+func MakePDUSessionResourceModifyIndication(amfID uint64, ranID uint32,
+	modify []PDUSessionResult) (pdu []byte, err error) {
+
+	list := make(PDUSessionResourceModifyListModInd, len(modify))
+	for i, s := range modify {
+		list[i].PDUSessionID = PDUSessionID(s.ID)
+		list[i].PDUSessionResourceModifyIndicationTransfer = s.Transfer
+	}
+	v := &PDUSessionResourceModifyIndication{
+		AMFUENGAPID:                        AMFUENGAPID(amfID),
+		RANUENGAPID:                        RANUENGAPID(ranID),
+		PDUSessionResourceModifyListModInd: list,
+	}
+	pdu, err = encPDU(InitiatingMessageType,
+		procCodePDUSessionResourceModifyIndication, CriticalityReject, v)
+	return
+}
+
+// 9.2.1.9 PDU SESSION RESOURCE MODIFY CONFIRM
+/*
+PDUSessionResourceModifyConfirmIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-AMF-UE-NGAP-ID                              CRITICALITY ignore  TYPE AMF-UE-NGAP-ID                                 PRESENCE mandatory  }|
+    { ID id-RAN-UE-NGAP-ID                              CRITICALITY ignore  TYPE RAN-UE-NGAP-ID                                 PRESENCE mandatory  }|
+    { ID id-PDUSessionResourceModifyListModCfm          CRITICALITY ignore  TYPE PDUSessionResourceModifyListModCfm             PRESENCE mandatory  }|
+    { ID id-PDUSessionResourceFailedToModifyListModCfm  CRITICALITY ignore  TYPE PDUSessionResourceFailedToModifyListModCfm     PRESENCE optional   }|
+    { ID id-CriticalityDiagnostics                      CRITICALITY ignore  TYPE CriticalityDiagnostics                         PRESENCE optional   },
+    ...
+}
+*/
+// DecodePDUSessionResourceModifyConfirm decodes NGAP-PDU of PDU Session
+// Resource Modify Confirm sent by AMF.
+func DecodePDUSessionResourceModifyConfirm(b []byte) (
+	v *PDUSessionResourceModifyConfirm, err error) {
+
+	pdu, err := Decode(b)
+	if err != nil {
+		return
+	}
+	v, ok := pdu.Value.(*PDUSessionResourceModifyConfirm)
+	if ok == false {
+		err = fmt.Errorf("not PDU Session Resource Modify Confirm")
+	}
+	return
+}
+
+// 9.3.4.3 PDU Session Resource Modify Request Transfer
+/*
+PDUSessionResourceModifyRequestTransferIEs NGAP-PROTOCOL-IES ::= {
+    { ID id-PDUSessionAggregateMaximumBitRate       CRITICALITY reject  TYPE PDUSessionAggregateMaximumBitRate      PRESENCE optional   }|
+    { ID id-UL-NGU-UP-TNLModifyList                 CRITICALITY reject  TYPE UL-NGU-UP-TNLModifyList                PRESENCE optional   }|
+    { ID id-NetworkInstance                         CRITICALITY reject  TYPE NetworkInstance                        PRESENCE optional   }|
+    { ID id-QosFlowAddOrModifyRequestList           CRITICALITY reject  TYPE QosFlowAddOrModifyRequestList          PRESENCE optional   }|
+    { ID id-QosFlowToReleaseList                    CRITICALITY reject  TYPE QosFlowList                            PRESENCE optional   }|
+    { ID id-AdditionalUL-NGU-UP-TNLInformation      CRITICALITY reject  TYPE UPTransportLayerInformationList        PRESENCE optional   },
+    ...
+}
+*/
+// DecodePDUSessionResourceModifyRequestTransfer decodes PDU Session Resource
+// Modify Request Transfer in the item of PDU Session Resource Modify Request.
+func DecodePDUSessionResourceModifyRequestTransfer(b []byte) (
+	v *PDUSessionResourceModifyRequestTransfer, err error) {
+
+	v = &PDUSessionResourceModifyRequestTransfer{}
+	if err = per.Unmarshal(b, v); err != nil {
+		err = per.WithPath(err, "PDUSessionResourceModifyRequestTransfer")
+		v = nil
+	}
+	return
+}
+
+// 9.3.4.4 PDU Session Resource Modify Response Transfer
+/*
+PDUSessionResourceModifyResponseTransfer ::= SEQUENCE {
+    dL-NGU-UP-TNLInformation                UPTransportLayerInformation                     OPTIONAL,
+    uL-NGU-UP-TNLInformation                UPTransportLayerInformation                     OPTIONAL,
+    qosFlowAddOrModifyResponseList          QosFlowAddOrModifyResponseList                  OPTIONAL,
+    additionalDLQosFlowPerTNLInformation    QosFlowPerTNLInformationList                    OPTIONAL,
+    qosFlowFailedToAddOrModifyList          QosFlowList                                     OPTIONAL,
+    iE-Extensions       ProtocolExtensionContainer { {PDUSessionResourceModifyResponseTransfer-ExtIEs} } OPTIONAL,
+    ...
+}
+*/
+// MakePDUSessionResourceModifyResponseTransfer returns PDU Session Resource
+// Modify Response Transfer for the QoS flows identified by qfis that are
+// added or modified.
+func MakePDUSessionResourceModifyResponseTransfer(qfis []uint8) (
+	b []byte, err error) {
+
+	v := &PDUSessionResourceModifyResponseTransfer{}
+	if len(qfis) > 0 {
+		list := make(QosFlowAddOrModifyResponseList, len(qfis))
+		for i, qfi := range qfis {
+			list[i].QosFlowIdentifier = QosFlowIdentifier(qfi)
+		}
+		v.QosFlowAddOrModifyResponseList = &list
+	}
+	b, err = per.Marshal(v)
+	err = per.WithPath(err, "PDUSessionResourceModifyResponseTransfer")
+	return
+}
+
+// PDU Session Resource Modify Indication Transfer
+/*
+PDUSessionResourceModifyIndicationTransfer ::= SEQUENCE {
+    dLQosFlowPerTNLInformation              QosFlowPerTNLInformation,
+    additionalDLQosFlowPerTNLInformation    QosFlowPerTNLInformationList        OPTIONAL,
+    iE-Extensions       ProtocolExtensionContainer { {PDUSessionResourceModifyIndicationTransfer-ExtIEs} } OPTIONAL,
+    ...
+}
+*/
+// MakePDUSessionResourceModifyIndicationTransfer returns PDU Session
+// Resource Modify Indication Transfer with the new DL tunnel of the gNB for
+// the QoS flows identified by qfis.
+func MakePDUSessionResourceModifyIndicationTransfer(dl Tunnel,
+	qfis []uint8) (b []byte, err error) {
+
+	info, err := dl.qosFlowPerTNLInformation(qfis)
+	if err != nil {
+		return
+	}
+	b, err = per.Marshal(&PDUSessionResourceModifyIndicationTransfer{
+		DLQosFlowPerTNLInformation: info,
+	})
+	err = per.WithPath(err, "PDUSessionResourceModifyIndicationTransfer")
+	return
+}
+
+// PDU Session Resource Modify Confirm Transfer
+/*
+PDUSessionResourceModifyConfirmTransfer ::= SEQUENCE {
+    qosFlowModifyConfirmList            QosFlowModifyConfirmList,
+    uLNGU-UP-TNLInformation             UPTransportLayerInformation,
+    additionalNG-UUPTNLInformation      UPTransportLayerInformationPairList     OPTIONAL,
+    qosFlowFailedToModifyList           QosFlowList                             OPTIONAL,
+    iE-Extensions       ProtocolExtensionContainer { {PDUSessionResourceModifyConfirmTransfer-ExtIEs} } OPTIONAL,
+    ...
+}
+*/
+// DecodePDUSessionResourceModifyConfirmTransfer decodes PDU Session Resource
+// Modify Confirm Transfer in the item of PDU Session Resource Modify Confirm.
+func DecodePDUSessionResourceModifyConfirmTransfer(b []byte) (
+	v *PDUSessionResourceModifyConfirmTransfer, err error) {
+
+	v = &PDUSessionResourceModifyConfirmTransfer{}
+	if err = per.Unmarshal(b, v); err != nil {
+		err = per.WithPath(err, "PDUSessionResourceModifyConfirmTransfer")
+		v = nil
+	}
+	return
+}
+
+// PDU Session Resource Modify Unsuccessful Transfer
+/*
+PDUSessionResourceModifyUnsuccessfulTransfer ::= SEQUENCE {
+    cause                       Cause,
+    criticalityDiagnostics      CriticalityDiagnostics      OPTIONAL,
+    iE-Extensions       ProtocolExtensionContainer { {PDUSessionResourceModifyUnsuccessfulTransfer-ExtIEs} } OPTIONAL,
+    ...
+}
+*/
+// MakePDUSessionResourceModifyUnsuccessfulTransfer returns PDU Session
+// Resource Modify Unsuccessful Transfer with the cause.
+func MakePDUSessionResourceModifyUnsuccessfulTransfer(cause Cause) (
+	b []byte, err error) {
+
+	b, err = per.Marshal(&PDUSessionResourceModifyUnsuccessfulTransfer{
+		Cause: cause,
+	})
+	err = per.WithPath(err, "PDUSessionResourceModifyUnsuccessfulTransfer")
+	return
+}
+
+// PDU Session Resource Notify Transfer
+/*
+PDUSessionResourceNotifyTransfer ::= SEQUENCE {
+    qosFlowNotifyList       QosFlowNotifyList       OPTIONAL,
+    qosFlowReleasedList     QosFlowList             OPTIONAL,
+    iE-Extensions       ProtocolExtensionContainer { {PDUSessionResourceNotifyTransfer-ExtIEs} } OPTIONAL,
+    ...
+}
+*/
+// MakePDUSessionResourceNotifyTransfer returns PDU Session Resource Notify
+// Transfer with the QoS flows in notify whose notification control is
+// fulfilled or not, and the ones in released that are released. Empty lists
+// are omitted.
+func MakePDUSessionResourceNotifyTransfer(notify QosFlowNotifyList,
+	released QosFlowList) (b []byte, err error) {
+
+	v := &PDUSessionResourceNotifyTransfer{}
+	if len(notify) > 0 {
+		v.QosFlowNotifyList = &notify
+	}
+	if len(released) > 0 {
+		v.QosFlowReleasedList = &released
+	}
+	b, err = per.Marshal(v)
+	err = per.WithPath(err, "PDUSessionResourceNotifyTransfer")
+	return
+}
+
+// PDU Session Resource Notify Released Transfer
+/*
+PDUSessionResourceNotifyReleasedTransfer ::= SEQUENCE {
+    cause               Cause,
+    iE-Extensions       ProtocolExtensionContainer { {PDUSessionResourceNotifyReleasedTransfer-ExtIEs} } OPTIONAL,
+    ...
+}
+*/
+// MakePDUSessionResourceNotifyReleasedTransfer returns PDU Session Resource
+// Notify Released Transfer with the cause.
+func MakePDUSessionResourceNotifyReleasedTransfer(cause Cause) (
+	b []byte, err error) {
+
+	b, err = per.Marshal(&PDUSessionResourceNotifyReleasedTransfer{
+		Cause: cause,
+	})
+	err = per.WithPath(err, "PDUSessionResourceNotifyReleasedTransfer")
+	return
+}
+
+// PDU Session Resource Release Command Transfer
+/*
+PDUSessionResourceReleaseCommandTransfer ::= SEQUENCE {
+    cause               Cause,
+    iE-Extensions       ProtocolExtensionContainer { {PDUSessionResourceReleaseCommandTransfer-ExtIEs} } OPTIONAL,
+    ...
+}
+*/
+// DecodePDUSessionResourceReleaseCommandTransfer decodes PDU Session Resource
+// Release Command Transfer in the item of PDU Session Resource Release
+// Command.
+func DecodePDUSessionResourceReleaseCommandTransfer(b []byte) (
+	v *PDUSessionResourceReleaseCommandTransfer, err error) {
+
+	v = &PDUSessionResourceReleaseCommandTransfer{}
+	if err = per.Unmarshal(b, v); err != nil {
+		err = per.WithPath(err, "PDUSessionResourceReleaseCommandTransfer")
+		v = nil
+	}
+	return
+}
+
+// PDU Session Resource Release Response Transfer
+/*
+PDUSessionResourceReleaseResponseTransfer ::= SEQUENCE {
+    iE-Extensions       ProtocolExtensionContainer { {PDUSessionResourceReleaseResponseTransfer-ExtIEs} } OPTIONAL,
+    ...
+}
+*/
+// MakePDUSessionResourceReleaseResponseTransfer returns PDU Session Resource
+// Release Response Transfer.
+func MakePDUSessionResourceReleaseResponseTransfer() (b []byte, err error) {
+	b, err = per.Marshal(&PDUSessionResourceReleaseResponseTransfer{})
+	err = per.WithPath(err, "PDUSessionResourceReleaseResponseTransfer")
+	return
+}
+
+// Tunnel is the endpoint of GTP-U tunnel. Addr is an IPv4 or IPv6 address.
+type Tunnel struct {
+	Addr net.IP
+	TEID uint32
+}
+
+// 9.3.2.2 UP Transport Layer Information
+/*
+UPTransportLayerInformation ::= CHOICE {
+    gTPTunnel               GTPTunnel,
+    choice-Extensions       ProtocolIE-SingleContainer { {UPTransportLayerInformation-ExtIEs} }
+}
+
+GTPTunnel ::= SEQUENCE {
+    transportLayerAddress       TransportLayerAddress,
+    gTP-TEID                    GTP-TEID,
+    iE-Extensions       ProtocolExtensionContainer { {GTPTunnel-ExtIEs} } OPTIONAL,
+    ...
+}
+*/
+func (t *Tunnel) upTransportLayerInformation() (
+	v UPTransportLayerInformation, err error) {
+
+	addr := t.Addr.To4()
+	if addr == nil {
+		addr = t.Addr.To16()
+	}
+	if addr == nil {
+		err = fmt.Errorf("invalid transport layer address %v", t.Addr)
+		return
+	}
+	v.GTPTunnel = &GTPTunnel{
+		TransportLayerAddress: TransportLayerAddress{
+			Bytes: addr, BitLength: len(addr) * 8},
+		GTPTEID: GTPTEID{uint8(t.TEID >> 24), uint8(t.TEID >> 16),
+			uint8(t.TEID >> 8), uint8(t.TEID)},
+	}
+	return
+}
+
+// QoS Flow per TNL Information
+/*
+QosFlowPerTNLInformation ::= SEQUENCE {
+    uPTransportLayerInformation     UPTransportLayerInformation,
+    associatedQosFlowList           AssociatedQosFlowList,
+    iE-Extensions       ProtocolExtensionContainer { { QosFlowPerTNLInformation-ExtIEs} } OPTIONAL,
+    ...
+}
+*/
+func (t *Tunnel) qosFlowPerTNLInformation(qfis []uint8) (
+	v QosFlowPerTNLInformation, err error) {
+
+	tnl, err := t.upTransportLayerInformation()
+	if err != nil {
+		return
+	}
+	flows := make(AssociatedQosFlowList, len(qfis))
+	for i, qfi := range qfis {
+		flows[i].QosFlowIdentifier = QosFlowIdentifier(qfi)
+	}
+	v.UPTransportLayerInformation = tnl
+	v.AssociatedQosFlowList = flows
+	return
+}
+
+// Tunnel returns the endpoint of GTP-U tunnel in v. If both IPv4 and IPv6
+// addresses are in Transport Layer Address, the IPv4 address is returned.
+func (v *UPTransportLayerInformation) Tunnel() (t Tunnel, err error) {
+	if v.GTPTunnel == nil {
+		err = fmt.Errorf("no GTP tunnel")
+		return
+	}
+	addr := v.GTPTunnel.TransportLayerAddress
+	switch addr.BitLength {
+	case 32, 160:
+		t.Addr = net.IP(append([]uint8(nil), addr.Bytes[:4]...))
+	case 128:
+		t.Addr = net.IP(append([]uint8(nil), addr.Bytes...))
+	default:
+		err = fmt.Errorf("invalid transport layer address length=%d",
+			addr.BitLength)
+		return
+	}
+	teid := v.GTPTunnel.GTPTEID
+	t.TEID = uint32(teid[0])<<24 | uint32(teid[1])<<16 |
+		uint32(teid[2])<<8 | uint32(teid[3])
+	return
+}
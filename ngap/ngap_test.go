@@ -1,11 +1,16 @@
 package ngap
 
 import (
+	"../encoding/per"
+	"errors"
 	"fmt"
+	"net"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
-
 func compareSlice(actual, expect []uint8) bool {
 	if len(actual) != len(expect) {
 		return false
@@ -19,43 +24,1561 @@ func compareSlice(actual, expect []uint8) bool {
 	return true
 }
 
-/*
-//func encGlobalRANNodeID() (v []uint8, err error) {
+// checkInvalidCause checks that err is the error of Cause at path which
+// has no alternative or more than one alternative.
+func checkInvalidCause(t *testing.T, err error, path string) {
+	t.Helper()
+	var ferr *per.FieldError
+	var cerr *per.ConstraintError
+	if errors.As(err, &ferr) == false || ferr.Path != path ||
+		errors.As(err, &cerr) == false {
+		t.Errorf("expect ConstraintError at %s, actual %v", path, err)
+	}
+}
+
+func testGNBConfig() *GNBConfig {
+	plmn := PLMN{MCC: "123", MNC: "45"}
+	return &GNBConfig{
+		PLMN:        plmn,
+		GNBID:       1,
+		GNBIDLength: 22,
+		Name:        "gNB",
+		TAs: []TA{{
+			TAC: []uint8{0x00, 0x01, 0x02},
+			BroadcastPLMNs: []BroadcastPLMN{{
+				PLMN:   plmn,
+				Slices: []Slice{{SST: 1, SD: []uint8{0x00, 0x00, 0x7b}}},
+			}},
+		}},
+		PagingDRX: 128,
+	}
+}
+
 func TestMakeGlobalRANNodeID(t *testing.T) {
-	v, _ := encGlobalRANNodeID()
-	fmt.Printf("v = 0x%02x\n", v)
+	v, err := testGNBConfig().globalRANNodeID()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w := per.NewBitWriter()
+	v.MarshalPER(w)
+	expect := []uint8{0x00, 0x21, 0xf3, 0x54, 0x00, 0x00, 0x00, 0x04}
+	if compareSlice(w.Bytes(), expect) == false {
+		t.Errorf("expect: 0x%02x, actual 0x%02x", expect, w.Bytes())
+	}
+
+	// the gNB ID of 32 bits.
+	id, err := gnbID(0x12345678, 32)
+	expect = []uint8{0x12, 0x34, 0x56, 0x78}
+	if err != nil || compareSlice(id.GNBID.Bytes, expect) == false {
+		t.Errorf("expect: 0x%02x, actual 0x%02x", expect, id.GNBID.Bytes)
+	}
+	var cerr *per.ConstraintError
+	if _, err = gnbID(0x400000, 22); errors.As(err, &cerr) == false {
+		t.Errorf("expect ConstraintError for gNB ID over 22 bits, "+
+			"actual %v", err)
+	}
+	var rerr *per.RangeError
+	if _, err = gnbID(1, 21); errors.As(err, &rerr) == false ||
+		rerr.Value != 21 {
+		t.Errorf("expect RangeError for gNB ID length=21, actual %v", err)
+	}
+
+	c := testGNBConfig()
+	c.GNBIDLength = 33
+	_, err = c.globalRANNodeID()
+	var ferr *per.FieldError
+	if errors.As(err, &ferr) == false ||
+		ferr.Path != "globalGNB-ID.gNB-ID.gNB-ID" {
+		t.Errorf("unexpected error: %v", err)
+	}
 }
 
-//func encSliceSupportList() (v []uint8, bitlen int) {
-func TestMakeSliceSupportList(t *testing.T) {
-	v := encSliceSupportList()
-	fmt.Printf("v = 0x%02x\n", v)
+func TestMakeSliceSupportItem(t *testing.T) {
+	v := sliceSupportList([]Slice{{SST: 1, SD: []uint8{0x00, 0x00, 0x7b}}})
+	w := per.NewBitWriter()
+	v[0].MarshalPER(w)
+	expect := []uint8{0x10, 0x08, 0x00, 0x00, 0x7b}
+	if compareSlice(w.Bytes(), expect) == false {
+		t.Errorf("expect: 0x%02x, actual 0x%02x", expect, w.Bytes())
+	}
+
+	// SD is absent.
+	v = sliceSupportList([]Slice{{SST: 1}})
+	w = per.NewBitWriter()
+	v[0].MarshalPER(w)
+	expect = []uint8{0x00, 0x08}
+	if compareSlice(w.Bytes(), expect) == false {
+		t.Errorf("expect: 0x%02x, actual 0x%02x", expect, w.Bytes())
+	}
 }
 
 func TestMakePLMNIdentity(t *testing.T) {
+	cases := []struct {
+		plmn   PLMN
+		expect []uint8
+	}{
+		{PLMN{MCC: "123", MNC: "45"}, []uint8{0x21, 0xf3, 0x54}},
+		{PLMN{MCC: "001", MNC: "01"}, []uint8{0x00, 0xf1, 0x10}},
+		{PLMN{MCC: "310", MNC: "410"}, []uint8{0x13, 0x00, 0x14}},
+	}
+	for _, c := range cases {
+		v, err := c.plmn.identity()
+		if err != nil || compareSlice(v, c.expect) == false {
+			t.Errorf("expect: 0x%02x, actual 0x%02x", c.expect, v)
+		}
+	}
 
-	expect := make([]uint8, 3, 3)
-	expect[0] = 0x21
-	expect[1] = 0xf3
-	expect[2] = 0x54
-	actual := encPLMNIdentity(123, 45)
-	if compareSlice(actual, expect) == false {
-		t.Errorf("expect: 0x%02x, actual 0x%02x", expect, actual)
+	for _, plmn := range []PLMN{
+		{MCC: "12", MNC: "45"},
+		{MCC: "123", MNC: "4"},
+		{MCC: "12a", MNC: "45"},
+	} {
+		var cerr *per.ConstraintError
+		if _, err := plmn.identity(); errors.As(err, &cerr) == false {
+			t.Errorf("expect ConstraintError for %+v, actual %v", plmn, err)
+		}
 	}
 }
 
 func TestMakeNGSetupRequest(t *testing.T) {
-	MakeNGSetupRequest()
+	pdu, err := MakeNGSetupRequest(testGNBConfig())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expect := []uint8{
+		0x00, 0x15, 0x00, 0x31,
+		0x00, 0x00, 0x04,
+		// GlobalRANNodeID
+		0x00, 0x1b, 0x00, 0x08,
+		0x00, 0x21, 0xf3, 0x54, 0x00, 0x00, 0x00, 0x04,
+		// RANNodeName
+		0x00, 0x52, 0x40, 0x05,
+		0x01, 0x00, 0x67, 0x4e, 0x42,
+		// SupportedTAList
+		0x00, 0x66, 0x00, 0x10,
+		0x00, 0x00, 0x00, 0x01, 0x02, 0x00, 0x21, 0xf3,
+		0x54, 0x00, 0x00, 0x10, 0x08, 0x00, 0x00, 0x7b,
+		// DefaultPagingDRX
+		0x00, 0x15, 0x40, 0x01,
+		0x40}
+	if compareSlice(pdu, expect) == false {
+		t.Errorf("expect: 0x%02x, actual 0x%02x", expect, pdu)
+	}
+
+	// RANNodeName is optional.
+	c := testGNBConfig()
+	c.Name = ""
+	pdu, err = MakeNGSetupRequest(c)
+	if err != nil || len(pdu) != len(expect)-9 || pdu[6] != 0x03 {
+		t.Errorf("unexpected PDU without RANNodeName: 0x%02x, %v", pdu, err)
+	}
+
+	c = testGNBConfig()
+	c.PagingDRX = 100
+	_, err = MakeNGSetupRequest(c)
+	var ferr *per.FieldError
+	var cerr *per.ConstraintError
+	path := "NGAP-PDU.initiatingMessage.value.NGSetupRequest." +
+		"protocolIEs[3].value.PagingDRX"
+	if errors.As(err, &ferr) == false || ferr.Path != path ||
+		errors.As(err, &cerr) == false {
+		t.Errorf("expect: %s, actual %v", path, err)
+	}
+
+	c = testGNBConfig()
+	c.TAs = nil
+	_, err = MakeNGSetupRequest(c)
+	path = "NGAP-PDU.initiatingMessage.value.NGSetupRequest." +
+		"protocolIEs[2].value.SupportedTAList"
+	if errors.As(err, &ferr) == false || ferr.Path != path {
+		t.Errorf("expect: %s, actual %v", path, err)
+	}
+
+	// the invalid PLMN of the third TA.
+	c = testGNBConfig()
+	c.TAs = append(c.TAs, c.TAs[0], TA{
+		TAC:            []uint8{0x00, 0x01, 0x03},
+		BroadcastPLMNs: []BroadcastPLMN{{PLMN: PLMN{MCC: "123", MNC: "4"}}},
+	})
+	_, err = MakeNGSetupRequest(c)
+	path = "NGAP-PDU.initiatingMessage.value.NGSetupRequest." +
+		"protocolIEs[2].value.SupportedTAList[2].broadcastPLMNList[0]." +
+		"pLMNIdentity"
+	if errors.As(err, &ferr) == false || ferr.Path != path ||
+		errors.As(err, &cerr) == false {
+		t.Errorf("expect: %s, actual %v", path, err)
+	}
+
+	// the invalid gNB ID without RANNodeName.
+	c = testGNBConfig()
+	c.Name = ""
+	c.GNBID = 1 << 22
+	_, err = MakeNGSetupRequest(c)
+	path = "NGAP-PDU.initiatingMessage.value.NGSetupRequest." +
+		"protocolIEs[0].value.GlobalRANNodeID.globalGNB-ID.gNB-ID.gNB-ID"
+	if errors.As(err, &ferr) == false || ferr.Path != path {
+		t.Errorf("expect: %s, actual %v", path, err)
+	}
+	c.GNBID = 1
+	c.PagingDRX = 0
+	_, err = MakeNGSetupRequest(c)
+	path = "NGAP-PDU.initiatingMessage.value.NGSetupRequest." +
+		"protocolIEs[2].value.PagingDRX"
+	if errors.As(err, &ferr) == false || ferr.Path != path {
+		t.Errorf("expect: %s, actual %v", path, err)
+	}
 }
 
-func TestMakeGlobalRANNodeID(t *testing.T) {
-	encGlobalRANNodeID(123, 45)
+func testNGSetupRequest() *NGSetupRequest {
+	plmn := PLMNIdentity{0x21, 0xf3, 0x54}
+	sd := SD{0x00, 0x00, 0x7b}
+	name := RANNodeName("gNB")
+	return &NGSetupRequest{
+		GlobalRANNodeID: GlobalRANNodeID{GlobalGNBID: &GlobalGNBID{
+			PLMNIdentity: plmn,
+			GNBID: GNBID{GNBID: &per.BitString{
+				Bytes: []uint8{0x00, 0x00, 0x01}, BitLength: 22}},
+		}},
+		RANNodeName: &name,
+		SupportedTAList: SupportedTAList{{
+			TAC: TAC{0x00, 0x01, 0x02},
+			BroadcastPLMNList: BroadcastPLMNList{{
+				PLMNIdentity: plmn,
+				TAISliceSupportList: SliceSupportList{{
+					SNSSAI: SNSSAI{SST: SST{0x01}, SD: &sd},
+				}},
+			}},
+		}},
+		DefaultPagingDRX: PagingDRXV128,
+	}
 }
-*/
 
-func TestMakeNGSetupRequest(t *testing.T) {
-	MakeNGSetupRequest()
+func TestGeneratedTypes(t *testing.T) {
+	v := testNGSetupRequest()
+
+	w := per.NewBitWriter()
+	v.GlobalRANNodeID.MarshalPER(w)
+	expect := []uint8{0x00, 0x21, 0xf3, 0x54, 0x00, 0x00, 0x00, 0x04}
+	if compareSlice(w.Bytes(), expect) == false {
+		t.Errorf("expect: 0x%02x, actual 0x%02x", expect, w.Bytes())
+	}
+
+	w = per.NewBitWriter()
+	v.SupportedTAList[0].BroadcastPLMNList[0].TAISliceSupportList[0].
+		MarshalPER(w)
+	expect = []uint8{0x10, 0x08, 0x00, 0x00, 0x7b}
+	if compareSlice(w.Bytes(), expect) == false {
+		t.Errorf("expect: 0x%02x, actual 0x%02x", expect, w.Bytes())
+	}
+}
+
+func TestNGSetupRequestRoundTrip(t *testing.T) {
+	v := testNGSetupRequest()
+	w := per.NewBitWriter()
+	if err := v.MarshalPER(w); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var out NGSetupRequest
+	if err := out.UnmarshalPER(per.NewBitReader(w.Bytes())); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reflect.DeepEqual(v, &out) == false {
+		t.Errorf("expect: %+v, actual %+v", v, &out)
+	}
+
+	// the mandatory IE is missing.
+	v.SupportedTAList = nil
+	w = per.NewBitWriter()
+	if err := v.MarshalPER(w); err == nil {
+		t.Errorf("expect error for empty SupportedTAList")
+	}
+}
+
+func TestNGSetupRequestError(t *testing.T) {
+	v := testNGSetupRequest()
+	v.SupportedTAList[0].TAC = TAC{0x00, 0x01}
+
+	err := v.MarshalPER(per.NewBitWriter())
+	var ferr *per.FieldError
+	var rerr *per.RangeError
+	expect := "NGSetupRequest.protocolIEs[2].value.SupportedTAList[0].tAC"
+	if errors.As(err, &ferr) == false || ferr.Path != expect {
+		t.Errorf("expect: %s, actual %v", expect, err)
+	}
+	if errors.As(err, &rerr) == false || rerr.Value != 2 {
+		t.Errorf("expect RangeError, actual %v", err)
+	}
+
+	// the value of the IE is truncated.
+	v = testNGSetupRequest()
+	w := per.NewBitWriter()
+	if err = v.MarshalPER(w); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b := w.Bytes()
+	b[6] = 0x09 // the length of GlobalRANNodeID
+	err = new(NGSetupRequest).UnmarshalPER(per.NewBitReader(b[:15]))
+	var terr *per.TruncatedError
+	expect = "NGSetupRequest.protocolIEs[0].value.GlobalRANNodeID"
+	if errors.As(err, &ferr) == false || ferr.Path != expect ||
+		errors.As(err, &terr) == false {
+		t.Errorf("expect: %s, actual %v", expect, err)
+	}
+
+	// the container has no IE.
+	w = per.NewBitWriter()
+	w.EncSequence(true, 0, 0)
+	w.EncSequenceOf(0, 0, maxProtocolIEs, false)
+	err = new(NGSetupRequest).UnmarshalPER(per.NewBitReader(w.Bytes()))
+	var merr *per.MissingIEError
+	expect = "NGSetupRequest.protocolIEs"
+	if errors.As(err, &ferr) == false || ferr.Path != expect {
+		t.Errorf("expect: %s, actual %v", expect, err)
+	}
+	if errors.As(err, &merr) == false || merr.IE != "GlobalRANNodeID" {
+		t.Errorf("expect MissingIEError, actual %v", err)
+	}
+}
+
+func TestDecodeNGSetupResult(t *testing.T) {
+	// NG Setup Failure with Cause misc:unspecified and TimeToWait v10s.
+	b := []uint8{
+		0x40, 0x15, 0x00, 0x0d,
+		0x00, 0x00, 0x02,
+		0x00, 0x0f, 0x40, 0x01, 0x8a,
+		0x00, 0x6b, 0x40, 0x01, 0x30}
+	resp, fail, err := DecodeNGSetupResult(b)
+	if err != nil || resp != nil || fail == nil {
+		t.Fatalf("unexpected result: %v, %v, %v", resp, fail, err)
+	}
+	if fail.Cause.Misc == nil || *fail.Cause.Misc != CauseMiscUnspecified {
+		t.Errorf("unexpected cause: %+v", fail.Cause)
+	}
+	if fail.TimeToWait == nil || fail.TimeToWait.Duration() != 10*time.Second {
+		t.Errorf("unexpected time to wait: %v", fail.TimeToWait)
+	}
+
+	plmn := PLMNIdentity{0x21, 0xf3, 0x54}
+	capacity := RelativeAMFCapacity(255)
+	expect := &NGSetupResponse{
+		AMFName: "AMF",
+		ServedGUAMIList: ServedGUAMIList{{GUAMI: GUAMI{
+			PLMNIdentity: plmn,
+			AMFRegionID:  AMFRegionID{Bytes: []uint8{0xca}, BitLength: 8},
+			AMFSetID:     AMFSetID{Bytes: []uint8{0x03, 0xf8}, BitLength: 10},
+			AMFPointer:   AMFPointer{Bytes: []uint8{0x01}, BitLength: 6},
+		}}},
+		RelativeAMFCapacity: capacity,
+		PLMNSupportList: PLMNSupportList{{
+			PLMNIdentity:     plmn,
+			SliceSupportList: sliceSupportList([]Slice{{SST: 1}}),
+		}},
+	}
+	w := per.NewBitWriter()
+	expect.MarshalPER(w)
+	pdu := NGAPPDU{SuccessfulOutcome: &SuccessfulOutcome{
+		ProcedureCode: procCodeNGSetup,
+		Criticality:   CriticalityReject,
+		Value:         w.Bytes(),
+	}}
+	w = per.NewBitWriter()
+	pdu.MarshalPER(w)
+	resp, fail, err = DecodeNGSetupResult(w.Bytes())
+	if err != nil || fail != nil || reflect.DeepEqual(resp, expect) == false {
+		t.Errorf("expect: %+v, actual %+v, %+v, %v", expect, resp, fail, err)
+	}
+
+	// the procedure is not NG Setup.
+	b[1] = 0x14
+	if _, _, err = DecodeNGSetupResult(b); err == nil {
+		t.Errorf("expect error for procedure code=%d", b[1])
+	}
+	b[1] = 0x15
+	if _, _, err = DecodeNGSetupResult(b[:10]); err == nil {
+		t.Errorf("expect error for truncated PDU")
+	}
+	if TimeToWait(6).Duration() != 0 {
+		t.Errorf("expect 0 for unknown time to wait")
+	}
+}
+
+func TestDecode(t *testing.T) {
+	b, err := MakeNGSetupRequest(testGNBConfig())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pdu, err := Decode(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pdu.Type != InitiatingMessageType ||
+		pdu.ProcedureCode != procCodeNGSetup ||
+		pdu.Criticality != CriticalityReject {
+		t.Errorf("unexpected PDU: %+v", pdu)
+	}
+	if expect := testNGSetupRequest(); reflect.DeepEqual(pdu.Value,
+		expect) == false {
+		t.Errorf("expect: %+v, actual %+v", expect, pdu.Value)
+	}
+
+	// the procedure unknown to this package.
+	b = []uint8{0x20, 0x7f, 0x40, 0x03, 0x01, 0x02, 0x03}
+	pdu, err = Decode(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pdu.Type != SuccessfulOutcomeType || pdu.ProcedureCode != 127 ||
+		pdu.Criticality != CriticalityIgnore {
+		t.Errorf("unexpected PDU: %+v", pdu)
+	}
+	if v, ok := pdu.Value.(UnknownMessage); ok == false ||
+		compareSlice(v, b[4:]) == false {
+		t.Errorf("expect: %x, actual %x", b[4:], pdu.Value)
+	}
+
+	// the message of the known procedure is broken.
+	b = []uint8{0x40, 0x15, 0x00, 0x02, 0x00, 0x00}
+	_, err = Decode(b)
+	var fe *per.FieldError
+	if errors.As(err, &fe) == false ||
+		strings.HasPrefix(fe.Path, "NGAP-PDU.unsuccessfulOutcome.value") ==
+			false {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// the message type after the extension marker.
+	b = []uint8{0x80, 0x01, 0x00}
+	_, err = Decode(b)
+	var ee *per.ExtensionError
+	if errors.As(err, &fe) == false || fe.Path != "NGAP-PDU" ||
+		errors.As(err, &ee) == false {
+		t.Errorf("expect ExtensionError, actual %v", err)
+	}
+}
+
+func testInitialUE() *InitialUE {
+	return &InitialUE{
+		RANUENGAPID: 1,
+		NASPDU:      []uint8{0x7e, 0x00, 0x41},
+		Location: NRLocation{
+			PLMN:   PLMN{MCC: "123", MNC: "45"},
+			CellID: 0x10,
+			TAC:    []uint8{0x00, 0x01, 0x02},
+		},
+		RRCEstablishmentCause: RRCEstablishmentCauseMoSignalling,
+		UEContextRequest:      true,
+	}
+}
+
+func TestMakeInitialUEMessage(t *testing.T) {
+	expect := []uint8{
+		0x00, 0x0f, 0x40, 0x2e,
+		0x00, 0x00, 0x05,
+		// RAN-UE-NGAP-ID
+		0x00, 0x55, 0x00, 0x02, 0x00, 0x01,
+		// NAS-PDU
+		0x00, 0x26, 0x00, 0x04, 0x03, 0x7e, 0x00, 0x41,
+		// UserLocationInformation
+		0x00, 0x79, 0x00, 0x0f, 0x40, 0x21, 0xf3, 0x54,
+		0x00, 0x00, 0x00, 0x01, 0x00, 0x21, 0xf3, 0x54,
+		0x00, 0x01, 0x02,
+		// RRCEstablishmentCause
+		0x00, 0x5a, 0x40, 0x01, 0x18,
+		// UEContextRequest
+		0x00, 0x70, 0x40, 0x01, 0x00}
+	actual, err := MakeInitialUEMessage(testInitialUE())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compareSlice(actual, expect) == false {
+		t.Errorf("expect: %x, actual %x", expect, actual)
+	}
+
+	ue := testInitialUE()
+	ue.Location.Time = time.Unix(0, 0)
+	ue.STMSI = &STMSI{AMFSetID: 1, AMFPointer: 2, TMSI: 0x12345678}
+	setID := uint16(1)
+	ue.AMFSetID = &setID
+	b, err := MakeInitialUEMessage(ue)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pdu, err := Decode(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	v, ok := pdu.Value.(*InitialUEMessage)
+	if ok == false || pdu.ProcedureCode != procCodeInitialUEMessage {
+		t.Fatalf("unexpected PDU: %+v", pdu)
+	}
+	nr := v.UserLocationInformation.UserLocationInformationNR
+	if nr == nil || compareSlice(*nr.TimeStamp,
+		[]uint8{0x83, 0xaa, 0x7e, 0x80}) == false {
+		t.Errorf("unexpected location: %+v", v.UserLocationInformation)
+	}
+	if s := v.FiveGSTMSI; s == nil ||
+		compareSlice(s.AMFSetID.Bytes, []uint8{0x00, 0x01}) == false ||
+		compareSlice(s.AMFPointer.Bytes, []uint8{0x02}) == false ||
+		compareSlice(s.FiveGTMSI, []uint8{0x12, 0x34, 0x56, 0x78}) == false {
+		t.Errorf("unexpected 5G-S-TMSI: %+v", v.FiveGSTMSI)
+	}
+	if v.AMFSetID == nil || v.AMFSetID.BitLength != 10 {
+		t.Errorf("unexpected AMF set ID: %+v", v.AMFSetID)
+	}
+
+	const prefix = "NGAP-PDU.initiatingMessage.value.InitialUEMessage."
+	for _, c := range []struct {
+		invalidate func(ue *InitialUE)
+		path       string
+	}{
+		{func(ue *InitialUE) { ue.Location.CellID = 1 << 36 },
+			"protocolIEs[2].value.UserLocationInformation." +
+				"userLocationInformationNR.nR-CGI.nRCellIdentity"},
+		{func(ue *InitialUE) { ue.Location.PLMN.MCC = "1" },
+			"protocolIEs[2].value.UserLocationInformation." +
+				"userLocationInformationNR.nR-CGI.pLMNIdentity"},
+		{func(ue *InitialUE) { ue.Location.TAC = []uint8{0x00} },
+			"protocolIEs[2].value.UserLocationInformation." +
+				"userLocationInformationNR.tAI.tAC"},
+		{func(ue *InitialUE) { ue.STMSI = &STMSI{AMFPointer: 64} },
+			"protocolIEs[4].value.FiveG-S-TMSI.aMFPointer"},
+		{func(ue *InitialUE) { ue.STMSI = &STMSI{AMFSetID: 1024} },
+			"protocolIEs[4].value.FiveG-S-TMSI.aMFSetID"},
+		{func(ue *InitialUE) { setID := uint16(1024); ue.AMFSetID = &setID },
+			"protocolIEs[4].value.AMFSetID"},
+		{func(ue *InitialUE) {
+			setID := uint16(1024)
+			ue.STMSI = &STMSI{}
+			ue.AMFSetID = &setID
+		}, "protocolIEs[5].value.AMFSetID"},
+	} {
+		ue := testInitialUE()
+		c.invalidate(ue)
+		_, err := MakeInitialUEMessage(ue)
+		var ferr *per.FieldError
+		if errors.As(err, &ferr) == false || ferr.Path != prefix+c.path {
+			t.Errorf("expect: %s, actual %v", prefix+c.path, err)
+		}
+	}
+
+	var rerr *per.RangeError
+	if _, err := amfSetID(1024); errors.As(err, &rerr) == false ||
+		rerr.Value != 1024 || rerr.Max != 1023 {
+		t.Errorf("expect RangeError for AMF set ID=1024, actual %v", err)
+	}
+}
+
+func TestMakeUplinkNASTransport(t *testing.T) {
+	expect := []uint8{
+		0x00, 0x2e, 0x40, 0x2a,
+		0x00, 0x00, 0x04,
+		// AMF-UE-NGAP-ID
+		0x00, 0x0a, 0x00, 0x02, 0x00, 0x01,
+		// RAN-UE-NGAP-ID
+		0x00, 0x55, 0x00, 0x02, 0x00, 0x01,
+		// NAS-PDU
+		0x00, 0x26, 0x00, 0x04, 0x03, 0x7e, 0x00, 0x41,
+		// UserLocationInformation
+		0x00, 0x79, 0x40, 0x0f, 0x40, 0x21, 0xf3, 0x54,
+		0x00, 0x00, 0x00, 0x01, 0x00, 0x21, 0xf3, 0x54,
+		0x00, 0x01, 0x02}
+	ue := testInitialUE()
+	actual, err := MakeUplinkNASTransport(&UplinkNAS{
+		AMFUENGAPID: 1,
+		RANUENGAPID: ue.RANUENGAPID,
+		NASPDU:      ue.NASPDU,
+		Location:    ue.Location,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compareSlice(actual, expect) == false {
+		t.Errorf("expect: %x, actual %x", expect, actual)
+	}
+
+	_, err = MakeUplinkNASTransport(&UplinkNAS{AMFUENGAPID: 1 << 40,
+		Location: ue.Location})
+	var fe *per.FieldError
+	if errors.As(err, &fe) == false || fe.Path !=
+		"NGAP-PDU.initiatingMessage.value.UplinkNASTransport.protocolIEs[0].value.AMF-UE-NGAP-ID" {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	loc := ue.Location
+	loc.CellID = 1 << 36
+	_, err = MakeUplinkNASTransport(&UplinkNAS{Location: loc})
+	var cerr *per.ConstraintError
+	if errors.As(err, &fe) == false || fe.Path !=
+		"NGAP-PDU.initiatingMessage.value.UplinkNASTransport.protocolIEs[3].value.UserLocationInformation.userLocationInformationNR.nR-CGI.nRCellIdentity" ||
+		errors.As(err, &cerr) == false {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestDecodeDownlinkNASTransport(t *testing.T) {
+	plmn := PLMNIdentity{0x21, 0xf3, 0x54}
+	priority := RANPagingPriority(256)
+	rfsp := IndexToRFSP(1)
+	expect := &DownlinkNASTransport{
+		AMFUENGAPID:       1099511627775,
+		RANUENGAPID:       4294967295,
+		RANPagingPriority: &priority,
+		NASPDU:            NASPDU{0x7e, 0x00, 0x42},
+		MobilityRestrictionList: &MobilityRestrictionList{
+			ServingPLMN:     plmn,
+			EquivalentPLMNs: &EquivalentPLMNs{plmn},
+			ServiceAreaInformation: &ServiceAreaInformation{{
+				PLMNIdentity: plmn,
+				AllowedTACs:  &AllowedTACs{TAC{0x00, 0x01, 0x02}},
+			}},
+		},
+		IndexToRFSP: &rfsp,
+		UEAggregateMaximumBitRate: &UEAggregateMaximumBitRate{
+			UEAggregateMaximumBitRateDL: 4000000000000,
+			UEAggregateMaximumBitRateUL: 1000000,
+		},
+	}
+	b, err := encPDU(InitiatingMessageType, procCodeDownlinkNASTransport,
+		CriticalityIgnore, expect)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	actual, err := DecodeDownlinkNASTransport(b)
+	if err != nil || reflect.DeepEqual(actual, expect) == false {
+		t.Errorf("expect: %+v, actual %+v, %v", expect, actual, err)
+	}
+
+	b, _ = MakeInitialUEMessage(testInitialUE())
+	if _, err = DecodeDownlinkNASTransport(b); err == nil {
+		t.Errorf("expect error for Initial UE Message")
+	}
+}
+
+func testInitialContextSetupRequest() *InitialContextSetupRequest {
+	plmn := PLMNIdentity{0x21, 0xf3, 0x54}
+	nas := NASPDU{0x7e, 0x00, 0x42}
+	return &InitialContextSetupRequest{
+		AMFUENGAPID: 1,
+		RANUENGAPID: 1,
+		GUAMI: GUAMI{
+			PLMNIdentity: plmn,
+			AMFRegionID:  AMFRegionID{Bytes: []uint8{0xca}, BitLength: 8},
+			AMFSetID:     AMFSetID{Bytes: []uint8{0x03, 0xf8}, BitLength: 10},
+			AMFPointer:   AMFPointer{Bytes: []uint8{0x01}, BitLength: 6},
+		},
+		PDUSessionResourceSetupListCxtReq: &PDUSessionResourceSetupListCxtReq{{
+			PDUSessionID:                           1,
+			SNSSAI:                                 SNSSAI{SST: SST{0x01}},
+			PDUSessionResourceSetupRequestTransfer: []uint8{0x00, 0x00, 0x00},
+		}},
+		AllowedNSSAI: AllowedNSSAI{{SNSSAI: SNSSAI{SST: SST{0x01}}}},
+		UESecurityCapabilities: UESecurityCapabilities{
+			NRencryptionAlgorithms: NRencryptionAlgorithms{
+				Bytes: []uint8{0xe0, 0x00}, BitLength: 16},
+			NRintegrityProtectionAlgorithms: NRintegrityProtectionAlgorithms{
+				Bytes: []uint8{0xe0, 0x00}, BitLength: 16},
+			EUTRAencryptionAlgorithms: EUTRAencryptionAlgorithms{
+				Bytes: []uint8{0x00, 0x00}, BitLength: 16},
+			EUTRAintegrityProtectionAlgorithms: EUTRAintegrityProtectionAlgorithms{
+				Bytes: []uint8{0x00, 0x00}, BitLength: 16},
+		},
+		SecurityKey: SecurityKey{Bytes: make([]uint8, 32), BitLength: 256},
+		NASPDU:      &nas,
+	}
+}
+
+func TestDecodeInitialContextSetupRequest(t *testing.T) {
+	expect := testInitialContextSetupRequest()
+	b, err := encPDU(InitiatingMessageType, procCodeInitialContextSetup,
+		CriticalityReject, expect)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	actual, err := DecodeInitialContextSetupRequest(b)
+	if err != nil || reflect.DeepEqual(actual, expect) == false {
+		t.Errorf("expect: %+v, actual %+v, %v", expect, actual, err)
+	}
+
+	// with Core Network Assistance Information and Expected Activity
+	// Period in the value set constraint.
+	period := ExpectedActivityPeriod(40)
+	expect.CoreNetworkAssistanceInformation = &CoreNetworkAssistanceInformation{
+		UEIdentityIndexValue: UEIdentityIndexValue{
+			IndexLength10: &per.BitString{
+				Bytes: []uint8{0x02, 0x40}, BitLength: 10}},
+		PeriodicRegistrationUpdateTimer: PeriodicRegistrationUpdateTimer{
+			Bytes: []uint8{0x21}, BitLength: 8},
+		TAIListForInactive: TAIListForInactive{{
+			TAI: TAI{PLMNIdentity: PLMNIdentity{0x21, 0xf3, 0x54},
+				TAC: TAC{0x00, 0x00, 0x01}}}},
+		ExpectedUEBehaviour: &ExpectedUEBehaviour{
+			ExpectedUEActivityBehaviour: &ExpectedUEActivityBehaviour{
+				ExpectedActivityPeriod: &period}},
+	}
+	b, err = encPDU(InitiatingMessageType, procCodeInitialContextSetup,
+		CriticalityReject, expect)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	actual, err = DecodeInitialContextSetupRequest(b)
+	if err != nil || reflect.DeepEqual(actual, expect) == false {
+		t.Errorf("expect: %+v, actual %+v, %v", expect, actual, err)
+	}
+
+	b, _ = MakeInitialUEMessage(testInitialUE())
+	if _, err = DecodeInitialContextSetupRequest(b); err == nil {
+		t.Errorf("expect error for Initial UE Message")
+	}
+}
+
+func TestMakeInitialContextSetupResponse(t *testing.T) {
+	req := testInitialContextSetupRequest()
+	expect := []uint8{
+		0x20, 0x0e, 0x00, 0x19,
+		0x00, 0x00, 0x03,
+		0x00, 0x0a, 0x40, 0x02, 0x00, 0x01,
+		0x00, 0x55, 0x40, 0x02, 0x00, 0x01,
+		// PDUSessionResourceSetupListCxtRes
+		0x00, 0x48, 0x40, 0x06, 0x00, 0x00, 0x01, 0x02, 0x01, 0x02}
+	actual, err := MakeInitialContextSetupResponse(req,
+		[]PDUSessionResult{{ID: 1, Transfer: []uint8{0x01, 0x02}}}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compareSlice(actual, expect) == false {
+		t.Errorf("expect: %x, actual %x", expect, actual)
+	}
+	pdu, err := Decode(actual)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v, ok := pdu.Value.(*InitialContextSetupResponse); ok == false ||
+		v.PDUSessionResourceFailedToSetupListCxtRes != nil {
+		t.Errorf("unexpected PDU: %+v", pdu)
+	}
+
+	expect = []uint8{
+		0x40, 0x0e, 0x00, 0x1d,
+		0x00, 0x00, 0x04,
+		0x00, 0x0a, 0x40, 0x02, 0x00, 0x01,
+		0x00, 0x55, 0x40, 0x02, 0x00, 0x01,
+		// PDUSessionResourceFailedToSetupListCxtFail
+		0x00, 0x84, 0x40, 0x05, 0x00, 0x00, 0x02, 0x01, 0x03,
+		// Cause
+		0x00, 0x0f, 0x40, 0x01, 0x8a}
+	misc := CauseMiscUnspecified
+	actual, err = MakeInitialContextSetupFailure(req, Cause{Misc: &misc},
+		[]PDUSessionResult{{ID: 2, Transfer: []uint8{0x03}}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compareSlice(actual, expect) == false {
+		t.Errorf("expect: %x, actual %x", expect, actual)
+	}
+
+	_, err = MakeInitialContextSetupFailure(req, Cause{}, nil)
+	checkInvalidCause(t, err, "NGAP-PDU.unsuccessfulOutcome.value."+
+		"InitialContextSetupFailure.protocolIEs[2].value.Cause")
+}
+
+func TestPDUSessionResourceSetup(t *testing.T) {
+	transfer := &PDUSessionResourceSetupRequestTransfer{
+		ULNGUUPTNLInformation: UPTransportLayerInformation{
+			GTPTunnel: &GTPTunnel{
+				TransportLayerAddress: TransportLayerAddress{
+					Bytes: []uint8{0x0a, 0x00, 0x00, 0x01}, BitLength: 32},
+				GTPTEID: GTPTEID{0x00, 0x00, 0x00, 0x02},
+			},
+		},
+		PDUSessionType: PDUSessionTypeIpv4,
+		QosFlowSetupRequestList: QosFlowSetupRequestList{{
+			QosFlowIdentifier: 1,
+			QosFlowLevelQosParameters: QosFlowLevelQosParameters{
+				QosCharacteristics: QosCharacteristics{
+					NonDynamic5QI: &NonDynamic5QIDescriptor{FiveQI: 9},
+				},
+				AllocationAndRetentionPriority: AllocationAndRetentionPriority{
+					PriorityLevelARP:        8,
+					PreEmptionCapability:    PreEmptionCapabilityShallNotTriggerPreEmption,
+					PreEmptionVulnerability: PreEmptionVulnerabilityNotPreEmptable,
+				},
+			},
+		}},
+	}
+	tb, err := per.Marshal(transfer)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expect := &PDUSessionResourceSetupRequest{
+		AMFUENGAPID: 1,
+		RANUENGAPID: 1,
+		PDUSessionResourceSetupListSUReq: PDUSessionResourceSetupListSUReq{{
+			PDUSessionID:                           1,
+			SNSSAI:                                 SNSSAI{SST: SST{0x01}},
+			PDUSessionResourceSetupRequestTransfer: tb,
+		}},
+	}
+	b, err := encPDU(InitiatingMessageType, procCodePDUSessionResourceSetup,
+		CriticalityReject, expect)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req, err := DecodePDUSessionResourceSetupRequest(b)
+	if err != nil || reflect.DeepEqual(req, expect) == false {
+		t.Fatalf("expect: %+v, actual %+v, %v", expect, req, err)
+	}
+	actual, err := DecodePDUSessionResourceSetupRequestTransfer(
+		req.PDUSessionResourceSetupListSUReq[0].
+			PDUSessionResourceSetupRequestTransfer)
+	if err != nil || reflect.DeepEqual(actual, transfer) == false {
+		t.Errorf("expect: %+v, actual %+v, %v", transfer, actual, err)
+	}
+	ul, err := actual.ULNGUUPTNLInformation.Tunnel()
+	if err != nil || ul.Addr.Equal(net.IPv4(10, 0, 0, 1)) == false ||
+		ul.TEID != 2 {
+		t.Errorf("unexpected UL tunnel: %+v, %v", ul, err)
+	}
+	_, err = DecodePDUSessionResourceSetupRequestTransfer(tb[:len(tb)-1])
+	var ferr *per.FieldError
+	if errors.As(err, &ferr) == false || strings.HasPrefix(ferr.Path,
+		"PDUSessionResourceSetupRequestTransfer.protocolIEs[") == false {
+		t.Errorf("expect error for truncated transfer, actual %v", err)
+	}
+
+	expectTransfer := []uint8{
+		0x00, 0x03, 0xe0, 0xc0, 0xa8, 0x00, 0x01,
+		0x00, 0x00, 0x00, 0x01, 0x00, 0x01}
+	dl := Tunnel{Addr: net.ParseIP("192.168.0.1"), TEID: 1}
+	tb, err = MakePDUSessionResourceSetupResponseTransfer(dl, []uint8{1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compareSlice(tb, expectTransfer) == false {
+		t.Errorf("expect: %x, actual %x", expectTransfer, tb)
+	}
+	var resTransfer PDUSessionResourceSetupResponseTransfer
+	if err = per.Unmarshal(tb, &resTransfer); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actual, err := resTransfer.QosFlowPerTNLInformation.
+		UPTransportLayerInformation.Tunnel(); err != nil ||
+		actual.Addr.Equal(dl.Addr) == false || actual.TEID != dl.TEID {
+		t.Errorf("expect: %+v, actual %+v, %v", dl, actual, err)
+	}
+	if _, err = MakePDUSessionResourceSetupResponseTransfer(Tunnel{},
+		[]uint8{1}); err == nil {
+		t.Errorf("expect error for no address")
+	}
+
+	misc := CauseMiscUnspecified
+	ub, err := MakePDUSessionResourceSetupUnsuccessfulTransfer(
+		Cause{Misc: &misc})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, err = MakePDUSessionResourceSetupResponse(req,
+		[]PDUSessionResult{{ID: 1, Transfer: tb}},
+		[]PDUSessionResult{{ID: 2, Transfer: ub}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pdu, err := Decode(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	res, ok := pdu.Value.(*PDUSessionResourceSetupResponse)
+	if ok == false || res.PDUSessionResourceSetupListSURes == nil ||
+		res.PDUSessionResourceFailedToSetupListSURes == nil {
+		t.Fatalf("unexpected PDU: %+v", pdu)
+	}
+	if item := (*res.PDUSessionResourceFailedToSetupListSURes)[0]; item.
+		PDUSessionID != 2 || compareSlice(item.
+		PDUSessionResourceSetupUnsuccessfulTransfer, ub) == false {
+		t.Errorf("unexpected failed item: %+v", item)
+	}
 }
 
+func TestPDUSessionResourceRelease(t *testing.T) {
+	misc := CauseMiscUnspecified
+	transfer := &PDUSessionResourceReleaseCommandTransfer{
+		Cause: Cause{Misc: &misc},
+	}
+	tb, err := per.Marshal(transfer)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expect := &PDUSessionResourceReleaseCommand{
+		AMFUENGAPID: 1,
+		RANUENGAPID: 1,
+		PDUSessionResourceToReleaseListRelCmd: PDUSessionResourceToReleaseListRelCmd{{
+			PDUSessionID:                             1,
+			PDUSessionResourceReleaseCommandTransfer: tb,
+		}},
+	}
+	b, err := encPDU(InitiatingMessageType, procCodePDUSessionResourceRelease,
+		CriticalityReject, expect)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cmd, err := DecodePDUSessionResourceReleaseCommand(b)
+	if err != nil || reflect.DeepEqual(cmd, expect) == false {
+		t.Fatalf("expect: %+v, actual %+v, %v", expect, cmd, err)
+	}
+	actual, err := DecodePDUSessionResourceReleaseCommandTransfer(
+		cmd.PDUSessionResourceToReleaseListRelCmd[0].
+			PDUSessionResourceReleaseCommandTransfer)
+	if err != nil || reflect.DeepEqual(actual, transfer) == false {
+		t.Errorf("expect: %+v, actual %+v, %v", transfer, actual, err)
+	}
+	if _, err = DecodePDUSessionResourceReleaseCommandTransfer(nil); err == nil {
+		t.Errorf("expect error for empty transfer")
+	}
 
+	rb, err := MakePDUSessionResourceReleaseResponseTransfer()
+	if err != nil || compareSlice(rb, []uint8{0x00}) == false {
+		t.Fatalf("unexpected transfer: %x, %v", rb, err)
+	}
+	expectPDU := []uint8{
+		0x20, 0x1c, 0x00, 0x18,
+		0x00, 0x00, 0x03,
+		0x00, 0x0a, 0x40, 0x02, 0x00, 0x01,
+		0x00, 0x55, 0x40, 0x02, 0x00, 0x01,
+		// PDUSessionResourceReleasedListRelRes
+		0x00, 0x46, 0x40, 0x05, 0x00, 0x00, 0x01, 0x01, 0x00}
+	b, err = MakePDUSessionResourceReleaseResponse(cmd,
+		[]PDUSessionResult{{ID: 1, Transfer: rb}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compareSlice(b, expectPDU) == false {
+		t.Errorf("expect: %x, actual %x", expectPDU, b)
+	}
+	if _, err = DecodePDUSessionResourceReleaseCommand(b); err == nil {
+		t.Errorf("expect error for PDU Session Resource Release Response")
+	}
+	if _, err = MakePDUSessionResourceReleaseResponse(cmd, nil); err == nil {
+		t.Errorf("expect error for no released PDU session resource")
+	}
+}
+
+func TestPDUSessionResourceModify(t *testing.T) {
+	release := QosFlowList{{QosFlowIdentifier: 2,
+		Cause: Cause{Misc: new(CauseMisc)}}}
+	transfer := &PDUSessionResourceModifyRequestTransfer{
+		QosFlowToReleaseList: &release,
+	}
+	tb, err := per.Marshal(transfer)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expect := &PDUSessionResourceModifyRequest{
+		AMFUENGAPID: 1,
+		RANUENGAPID: 1,
+		PDUSessionResourceModifyListModReq: PDUSessionResourceModifyListModReq{{
+			PDUSessionID:                            1,
+			PDUSessionResourceModifyRequestTransfer: tb,
+		}},
+	}
+	b, err := encPDU(InitiatingMessageType, procCodePDUSessionResourceModify,
+		CriticalityReject, expect)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req, err := DecodePDUSessionResourceModifyRequest(b)
+	if err != nil || reflect.DeepEqual(req, expect) == false {
+		t.Fatalf("expect: %+v, actual %+v, %v", expect, req, err)
+	}
+	actual, err := DecodePDUSessionResourceModifyRequestTransfer(
+		req.PDUSessionResourceModifyListModReq[0].
+			PDUSessionResourceModifyRequestTransfer)
+	if err != nil || reflect.DeepEqual(actual, transfer) == false {
+		t.Errorf("expect: %+v, actual %+v, %v", transfer, actual, err)
+	}
+	_, err = DecodePDUSessionResourceModifyRequestTransfer(tb[:len(tb)-1])
+	var ferr *per.FieldError
+	if errors.As(err, &ferr) == false || strings.HasPrefix(ferr.Path,
+		"PDUSessionResourceModifyRequestTransfer.protocolIEs[") == false {
+		t.Errorf("expect error for truncated transfer, actual %v", err)
+	}
+
+	mb, err := MakePDUSessionResourceModifyResponseTransfer([]uint8{1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var resTransfer PDUSessionResourceModifyResponseTransfer
+	if err = per.Unmarshal(mb, &resTransfer); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if list := resTransfer.QosFlowAddOrModifyResponseList; list == nil ||
+		len(*list) != 1 || (*list)[0].QosFlowIdentifier != 1 {
+		t.Errorf("unexpected transfer: %+v", resTransfer)
+	}
+	misc := CauseMiscUnspecified
+	ub, err := MakePDUSessionResourceModifyUnsuccessfulTransfer(
+		Cause{Misc: &misc})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, err = MakePDUSessionResourceModifyResponse(req,
+		[]PDUSessionResult{{ID: 1, Transfer: mb}},
+		[]PDUSessionResult{{ID: 2, Transfer: ub}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pdu, err := Decode(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	res, ok := pdu.Value.(*PDUSessionResourceModifyResponse)
+	if ok == false || pdu.Type != SuccessfulOutcomeType ||
+		res.PDUSessionResourceModifyListModRes == nil ||
+		res.PDUSessionResourceFailedToModifyListModRes == nil {
+		t.Fatalf("unexpected PDU: %+v", pdu)
+	}
+	if item := (*res.PDUSessionResourceFailedToModifyListModRes)[0]; item.
+		PDUSessionID != 2 || compareSlice(item.
+		PDUSessionResourceModifyUnsuccessfulTransfer, ub) == false {
+		t.Errorf("unexpected failed item: %+v", item)
+	}
+	_, err = MakePDUSessionResourceModifyUnsuccessfulTransfer(Cause{})
+	checkInvalidCause(t, err, "PDUSessionResourceModifyUnsuccessfulTransfer.cause")
+}
 
+func TestPDUSessionResourceModifyIndication(t *testing.T) {
+	dl := Tunnel{Addr: net.ParseIP("192.168.0.2"), TEID: 3}
+	tb, err := MakePDUSessionResourceModifyIndicationTransfer(dl,
+		[]uint8{1, 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, err := MakePDUSessionResourceModifyIndication(1, 2,
+		[]PDUSessionResult{{ID: 1, Transfer: tb}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pdu, err := Decode(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ind, ok := pdu.Value.(*PDUSessionResourceModifyIndication)
+	if ok == false || ind.AMFUENGAPID != 1 || ind.RANUENGAPID != 2 ||
+		len(ind.PDUSessionResourceModifyListModInd) != 1 {
+		t.Fatalf("unexpected PDU: %+v", pdu)
+	}
+	var transfer PDUSessionResourceModifyIndicationTransfer
+	if err = per.Unmarshal(ind.PDUSessionResourceModifyListModInd[0].
+		PDUSessionResourceModifyIndicationTransfer, &transfer); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	info := transfer.DLQosFlowPerTNLInformation
+	if actual, err := info.UPTransportLayerInformation.Tunnel(); err != nil ||
+		actual.Addr.Equal(dl.Addr) == false || actual.TEID != dl.TEID {
+		t.Errorf("expect: %+v, actual %+v, %v", dl, actual, err)
+	}
+	if len(info.AssociatedQosFlowList) != 2 ||
+		info.AssociatedQosFlowList[1].QosFlowIdentifier != 2 {
+		t.Errorf("unexpected QoS flows: %+v", info.AssociatedQosFlowList)
+	}
+	if _, err = MakePDUSessionResourceModifyIndication(1, 2, nil); err == nil {
+		t.Errorf("expect error for no PDU session resource")
+	}
+
+	ul, err := dl.upTransportLayerInformation()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	confirm := &PDUSessionResourceModifyConfirmTransfer{
+		QosFlowModifyConfirmList: QosFlowModifyConfirmList{
+			{QosFlowIdentifier: 1}, {QosFlowIdentifier: 2}},
+		ULNGUUPTNLInformation: ul,
+	}
+	cb, err := per.Marshal(confirm)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expect := &PDUSessionResourceModifyConfirm{
+		AMFUENGAPID: 1,
+		RANUENGAPID: 2,
+		PDUSessionResourceModifyListModCfm: PDUSessionResourceModifyListModCfm{{
+			PDUSessionID:                            1,
+			PDUSessionResourceModifyConfirmTransfer: cb,
+		}},
+	}
+	b, err = encPDU(SuccessfulOutcomeType,
+		procCodePDUSessionResourceModifyIndication, CriticalityReject, expect)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cfm, err := DecodePDUSessionResourceModifyConfirm(b)
+	if err != nil || reflect.DeepEqual(cfm, expect) == false {
+		t.Fatalf("expect: %+v, actual %+v, %v", expect, cfm, err)
+	}
+	actual, err := DecodePDUSessionResourceModifyConfirmTransfer(
+		cfm.PDUSessionResourceModifyListModCfm[0].
+			PDUSessionResourceModifyConfirmTransfer)
+	if err != nil || reflect.DeepEqual(actual, confirm) == false {
+		t.Errorf("expect: %+v, actual %+v, %v", confirm, actual, err)
+	}
+	_, err = DecodePDUSessionResourceModifyConfirmTransfer(cb[:1])
+	var fe *per.FieldError
+	if errors.As(err, &fe) == false || strings.HasPrefix(fe.Path,
+		"PDUSessionResourceModifyConfirmTransfer.") == false {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestMakePDUSessionResourceNotify(t *testing.T) {
+	expectTransfer := []uint8{0x40, 0x00, 0x20}
+	tb, err := MakePDUSessionResourceNotifyTransfer(QosFlowNotifyList{{
+		QosFlowIdentifier: 1,
+		NotificationCause: NotificationCauseFulfilled,
+	}}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compareSlice(tb, expectTransfer) == false {
+		t.Errorf("expect: %x, actual %x", expectTransfer, tb)
+	}
+	var transfer PDUSessionResourceNotifyTransfer
+	if err = per.Unmarshal(tb, &transfer); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if transfer.QosFlowNotifyList == nil ||
+		transfer.QosFlowReleasedList != nil {
+		t.Errorf("unexpected transfer: %+v", transfer)
+	}
+
+	misc := CauseMiscUnspecified
+	rb, err := MakePDUSessionResourceNotifyReleasedTransfer(Cause{Misc: &misc})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, err := MakePDUSessionResourceNotify(1, 2,
+		[]PDUSessionResult{{ID: 1, Transfer: tb}},
+		[]PDUSessionResult{{ID: 2, Transfer: rb}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pdu, err := Decode(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	v, ok := pdu.Value.(*PDUSessionResourceNotify)
+	if ok == false || pdu.Criticality != CriticalityIgnore ||
+		v.PDUSessionResourceNotifyList == nil ||
+		v.PDUSessionResourceReleasedListNot == nil {
+		t.Fatalf("unexpected PDU: %+v", pdu)
+	}
+	if item := (*v.PDUSessionResourceReleasedListNot)[0]; item.
+		PDUSessionID != 2 || compareSlice(item.
+		PDUSessionResourceNotifyReleasedTransfer, rb) == false {
+		t.Errorf("unexpected released item: %+v", item)
+	}
+}
+
+func TestMakeUEContextReleaseRequest(t *testing.T) {
+	expect := []uint8{
+		0x00, 0x2a, 0x40, 0x1b,
+		0x00, 0x00, 0x04,
+		0x00, 0x0a, 0x00, 0x02, 0x00, 0x01,
+		0x00, 0x55, 0x00, 0x02, 0x00, 0x01,
+		// PDUSessionResourceListCxtRelReq
+		0x00, 0x85, 0x00, 0x03, 0x00, 0x00, 0x01,
+		// Cause
+		0x00, 0x0f, 0x40, 0x01, 0x8a}
+	misc := CauseMiscUnspecified
+	actual, err := MakeUEContextReleaseRequest(1, 1, Cause{Misc: &misc},
+		[]uint8{1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compareSlice(actual, expect) == false {
+		t.Errorf("expect: %x, actual %x", expect, actual)
+	}
+
+	_, err = MakeUEContextReleaseRequest(1, 1, Cause{}, nil)
+	checkInvalidCause(t, err, "NGAP-PDU.initiatingMessage.value."+
+		"UEContextReleaseRequest.protocolIEs[2].value.Cause")
+}
+
+func TestDecodeUEContextReleaseCommand(t *testing.T) {
+	misc := CauseMiscUnspecified
+	amfID := AMFUENGAPID(2)
+	cases := []struct {
+		ids      UENGAPIDs
+		amfID    uint64
+		ranID    uint32
+		hasRANID bool
+	}{
+		{UENGAPIDs{UENGAPIDPair: &UENGAPIDPair{AMFUENGAPID: 1,
+			RANUENGAPID: 3}}, 1, 3, true},
+		{UENGAPIDs{AMFUENGAPID: &amfID}, 2, 0, false},
+	}
+	for _, c := range cases {
+		expect := &UEContextReleaseCommand{
+			UENGAPIDs: c.ids,
+			Cause:     Cause{Misc: &misc},
+		}
+		b, err := encPDU(InitiatingMessageType, procCodeUEContextRelease,
+			CriticalityReject, expect)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		cmd, err := DecodeUEContextReleaseCommand(b)
+		if err != nil || reflect.DeepEqual(cmd, expect) == false {
+			t.Fatalf("expect: %+v, actual %+v, %v", expect, cmd, err)
+		}
+		amfID, ranID, hasRANID, err := cmd.UENGAPIDs.IDs()
+		if err != nil || amfID != c.amfID || ranID != c.ranID ||
+			hasRANID != c.hasRANID {
+			t.Errorf("unexpected IDs: %d, %d, %v, %v", amfID, ranID,
+				hasRANID, err)
+		}
+	}
+	if _, _, _, err := (&UENGAPIDs{}).IDs(); err == nil {
+		t.Errorf("expect error for no UE NGAP IDs")
+	}
+
+	b, _ := MakeUEContextReleaseRequest(1, 1, Cause{Misc: &misc}, nil)
+	if _, err := DecodeUEContextReleaseCommand(b); err == nil {
+		t.Errorf("expect error for UE Context Release Request")
+	}
+}
+
+func TestMakeUEContextReleaseComplete(t *testing.T) {
+	plmn := PLMN{MCC: "123", MNC: "45"}
+	ue := &ReleasedUE{
+		AMFUENGAPID: 1,
+		RANUENGAPID: 2,
+		Location: &NRLocation{PLMN: plmn, CellID: 0x10,
+			TAC: []uint8{0x00, 0x01, 0x02}},
+		PDUSessionIDs: []uint8{1, 2},
+		Paging: &PagingRecommendation{
+			Cells: []RecommendedCell{
+				{PLMN: plmn, CellID: 0x10, Stayed: 90 * time.Second},
+				{PLMN: plmn, CellID: 0x20},
+				{PLMN: plmn, CellID: 0x30, Stayed: 2 * time.Hour},
+			},
+			GNB: testGNBConfig(),
+		},
+	}
+	b, err := MakeUEContextReleaseComplete(ue)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pdu, err := Decode(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	v, ok := pdu.Value.(*UEContextReleaseComplete)
+	if ok == false || pdu.Type != SuccessfulOutcomeType ||
+		v.AMFUENGAPID != 1 || v.RANUENGAPID != 2 ||
+		v.UserLocationInformation == nil ||
+		v.InfoOnRecommendedCellsAndRANNodesForPaging == nil ||
+		v.PDUSessionResourceListCxtRelCpl == nil ||
+		len(*v.PDUSessionResourceListCxtRelCpl) != 2 {
+		t.Fatalf("unexpected PDU: %+v", pdu)
+	}
+	info := v.InfoOnRecommendedCellsAndRANNodesForPaging
+	var stayed []int
+	for _, c := range info.RecommendedCellsForPaging.RecommendedCellList {
+		if c.TimeStayedInCell == nil {
+			stayed = append(stayed, -1)
+		} else {
+			stayed = append(stayed, *c.TimeStayedInCell)
+		}
+	}
+	if reflect.DeepEqual(stayed, []int{90, -1, 4095}) == false {
+		t.Errorf("unexpected time stayed in cell: %v", stayed)
+	}
+	node := info.RecommendRANNodesForPaging.RecommendedRANNodeList[0].
+		AMFPagingTarget.GlobalRANNodeID
+	if expect, _ := testGNBConfig().globalRANNodeID(); node == nil ||
+		reflect.DeepEqual(*node, expect) == false {
+		t.Errorf("expect: %+v, actual %+v", expect, node)
+	}
+
+	ue = &ReleasedUE{AMFUENGAPID: 1, RANUENGAPID: 2}
+	if b, err = MakeUEContextReleaseComplete(ue); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expect := []uint8{
+		0x20, 0x29, 0x00, 0x0f,
+		0x00, 0x00, 0x02,
+		0x00, 0x0a, 0x40, 0x02, 0x00, 0x01,
+		0x00, 0x55, 0x40, 0x02, 0x00, 0x02}
+	if compareSlice(b, expect) == false {
+		t.Errorf("expect: %x, actual %x", expect, b)
+	}
+
+	invalidGNB := testGNBConfig()
+	invalidGNB.PLMN.MNC = "4"
+	const prefix = "NGAP-PDU.successfulOutcome.value." +
+		"UEContextReleaseComplete.protocolIEs[2].value."
+	const paging = prefix + "InfoOnRecommendedCellsAndRANNodesForPaging."
+	for _, c := range []struct {
+		ue   *ReleasedUE
+		path string
+	}{
+		{&ReleasedUE{Paging: &PagingRecommendation{
+			Cells: []RecommendedCell{{PLMN: plmn}}}},
+			paging + "recommendRANNodesForPaging.recommendedRANNodeList"},
+		{&ReleasedUE{Paging: &PagingRecommendation{
+			Cells: []RecommendedCell{{PLMN: plmn},
+				{PLMN: plmn, CellID: 1 << 36}},
+			GNB: testGNBConfig()}},
+			paging + "recommendedCellsForPaging.recommendedCellList[1]." +
+				"nGRAN-CGI.nR-CGI.nRCellIdentity"},
+		{&ReleasedUE{Paging: &PagingRecommendation{
+			Cells: []RecommendedCell{{PLMN: plmn}},
+			GNB:   invalidGNB}},
+			paging + "recommendRANNodesForPaging.recommendedRANNodeList[0]." +
+				"aMFPagingTarget.globalRANNodeID.globalGNB-ID.pLMNIdentity"},
+		{&ReleasedUE{Paging: &PagingRecommendation{GNB: testGNBConfig()}},
+			paging + "recommendedCellsForPaging.recommendedCellList"},
+		{&ReleasedUE{Location: &NRLocation{PLMN: PLMN{MCC: "1"}}},
+			prefix + "UserLocationInformation.userLocationInformationNR." +
+				"nR-CGI.pLMNIdentity"},
+	} {
+		_, err = MakeUEContextReleaseComplete(c.ue)
+		var ferr *per.FieldError
+		if errors.As(err, &ferr) == false || ferr.Path != c.path {
+			t.Errorf("expect: %s, actual %v", c.path, err)
+		}
+	}
+
+	// InfoOnRecommendedCellsAndRANNodesForPaging follows
+	// UserLocationInformation.
+	_, err = MakeUEContextReleaseComplete(&ReleasedUE{
+		Location: &NRLocation{PLMN: plmn, TAC: []uint8{0x00, 0x01, 0x02}},
+		Paging:   &PagingRecommendation{}})
+	var ferr *per.FieldError
+	var cerr *per.ConstraintError
+	path := "NGAP-PDU.successfulOutcome.value.UEContextReleaseComplete." +
+		"protocolIEs[3].value.InfoOnRecommendedCellsAndRANNodesForPaging." +
+		"recommendRANNodesForPaging.recommendedRANNodeList"
+	if errors.As(err, &ferr) == false || ferr.Path != path ||
+		errors.As(err, &cerr) == false {
+		t.Errorf("expect: %s, actual %v", path, err)
+	}
+}
+
+type testIdleUE struct {
+	stmsi STMSI
+	paged *Paging
+}
+
+func (ue *testIdleUE) STMSI() STMSI { return ue.stmsi }
+
+func (ue *testIdleUE) ServiceRequest(p *Paging) error {
+	ue.paged = p
+	return nil
+}
+
+func TestDecodePaging(t *testing.T) {
+	s := &STMSI{AMFSetID: 0x3ff, AMFPointer: 0x3f, TMSI: 0x12345678}
+	id, err := s.fiveGSTMSI()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	drx := PagingDRXV64
+	priority := PagingPriorityPriolevel2
+	origin := PagingOriginNon3gpp
+	expect := &Paging{
+		UEPagingIdentity: UEPagingIdentity{FiveGSTMSI: id},
+		PagingDRX:        &drx,
+		TAIListForPaging: TAIListForPaging{{
+			TAI: TAI{PLMNIdentity: PLMNIdentity{0x21, 0xf3, 0x54},
+				TAC: TAC{0x00, 0x01, 0x02}},
+		}},
+		PagingPriority: &priority,
+		UERadioCapabilityForPaging: &UERadioCapabilityForPaging{
+			UERadioCapabilityForPagingOfNR: &UERadioCapabilityForPagingOfNR{
+				0x01},
+		},
+		PagingOrigin: &origin,
+		AssistanceDataForPaging: &AssistanceDataForPaging{
+			PagingAttemptInformation: &PagingAttemptInformation{
+				PagingAttemptCount:             1,
+				IntendedNumberOfPagingAttempts: 2,
+			},
+		},
+	}
+	b, err := encPDU(InitiatingMessageType, procCodePaging,
+		CriticalityIgnore, expect)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	actual, err := DecodePaging(b)
+	if err != nil || reflect.DeepEqual(actual, expect) == false {
+		t.Fatalf("expect: %+v, actual %+v, %v", expect, actual, err)
+	}
+	if n := actual.PagingDRX.Frames(); n != 64 {
+		t.Errorf("paging DRX expect: %d, actual %d", 64, n)
+	}
+	if n := PagingDRX(-1).Frames(); n != 0 {
+		t.Errorf("paging DRX expect: %d, actual %d", 0, n)
+	}
+	if stmsi, err := actual.UEPagingIdentity.STMSI(); err != nil ||
+		stmsi != *s {
+		t.Errorf("expect: %+v, actual %+v, %v", *s, stmsi, err)
+	}
+
+	other := &testIdleUE{stmsi: STMSI{TMSI: 1}}
+	paged := &testIdleUE{stmsi: *s}
+	ue, err := WakeUp(actual, []IdleUE{other, paged})
+	if err != nil || ue != paged || paged.paged != actual ||
+		other.paged != nil {
+		t.Errorf("unexpected UE woken up: %+v, %v", ue, err)
+	}
+	if ue, err = WakeUp(actual, []IdleUE{other}); err != nil || ue != nil {
+		t.Errorf("unexpected UE woken up: %+v, %v", ue, err)
+	}
+	if _, err = WakeUp(&Paging{}, nil); err == nil {
+		t.Errorf("expect error for no 5G-S-TMSI")
+	}
+
+	b, _ = MakeInitialUEMessage(testInitialUE())
+	if _, err = DecodePaging(b); err == nil {
+		t.Errorf("expect error for Initial UE Message")
+	}
+}
+
+func TestNGReset(t *testing.T) {
+	expect := []uint8{
+		0x00, 0x14, 0x00, 0x0d,
+		0x00, 0x00, 0x02,
+		// Cause
+		0x00, 0x0f, 0x40, 0x01, 0x8a,
+		// ResetType
+		0x00, 0x58, 0x00, 0x01, 0x00}
+	misc := CauseMiscUnspecified
+	actual, err := MakeNGReset(Cause{Misc: &misc}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compareSlice(actual, expect) == false {
+		t.Errorf("expect: %x, actual %x", expect, actual)
+	}
+	reset, err := DecodeNGReset(actual)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if conns, err := reset.ResetType.Connections(); err != nil ||
+		conns != nil {
+		t.Errorf("expect all connections, actual %+v, %v", conns, err)
+	}
+	b, err := MakeNGResetAcknowledge(reset)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ack, err := DecodeNGResetAcknowledge(b)
+	if err != nil || ack.UEAssociatedLogicalNGConnectionList != nil {
+		t.Errorf("unexpected NG Reset Acknowledge: %+v, %v", ack, err)
+	}
+
+	amfID, ranID := uint64(1), uint32(2)
+	conns := []UEConnection{
+		{AMFUENGAPID: &amfID, RANUENGAPID: &ranID},
+		{RANUENGAPID: &ranID},
+	}
+	if b, err = MakeNGReset(Cause{Misc: &misc}, conns); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reset, err = DecodeNGReset(b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actual, err := reset.ResetType.Connections(); err != nil ||
+		reflect.DeepEqual(actual, conns) == false {
+		t.Errorf("expect: %+v, actual %+v, %v", conns, actual, err)
+	}
+	if b, err = MakeNGResetAcknowledge(reset); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ack, err = DecodeNGResetAcknowledge(b); err != nil ||
+		reflect.DeepEqual(ack.UEAssociatedLogicalNGConnectionList,
+			reset.ResetType.PartOfNGInterface) == false {
+		t.Errorf("unexpected NG Reset Acknowledge: %+v, %v", ack, err)
+	}
+
+	if _, err = (&ResetType{}).Connections(); err == nil {
+		t.Errorf("expect error for no reset type")
+	}
+	_, err = MakeNGReset(Cause{}, nil)
+	checkInvalidCause(t, err, "NGAP-PDU.initiatingMessage.value."+
+		"NGReset.protocolIEs[0].value.Cause")
+	misc = CauseMiscUnspecified
+	nas := CauseNasNormalRelease
+	_, err = MakeNGReset(Cause{Misc: &misc, Nas: &nas}, nil)
+	checkInvalidCause(t, err, "NGAP-PDU.initiatingMessage.value."+
+		"NGReset.protocolIEs[0].value.Cause")
+	if _, err = DecodeNGReset(b); err == nil {
+		t.Errorf("expect error for NG Reset Acknowledge")
+	}
+	if _, err = DecodeNGResetAcknowledge(actual); err == nil {
+		t.Errorf("expect error for NG Reset")
+	}
+}
+
+func TestErrorIndication(t *testing.T) {
+	amfID, ranID := uint64(1), uint32(2)
+	misc := CauseMiscUnspecified
+	b, err := MakeErrorIndication(&ErrorReport{
+		AMFUENGAPID: &amfID,
+		RANUENGAPID: &ranID,
+		Cause:       &Cause{Misc: &misc},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	v, err := DecodeErrorIndication(b)
+	if err != nil || v.AMFUENGAPID == nil || *v.AMFUENGAPID != 1 ||
+		v.RANUENGAPID == nil || *v.RANUENGAPID != 2 || v.Cause == nil ||
+		v.CriticalityDiagnostics != nil {
+		t.Fatalf("unexpected Error Indication: %+v, %v", v, err)
+	}
+	if _, err = MakeErrorIndication(&ErrorReport{}); err == nil {
+		t.Errorf("expect error for neither cause nor diagnostics")
+	}
+
+	cases := []struct {
+		pdu  []uint8
+		diag *CriticalityDiagnostics
+	}{
+		{[]uint8{0xff}, nil},
+		// Downlink NAS Transport with the truncated value
+		{[]uint8{0x00, 0x04, 0x40, 0x01, 0x00}, &CriticalityDiagnostics{
+			ProcedureCode:        new(ProcedureCode),
+			TriggeringMessage:    new(TriggeringMessage),
+			ProcedureCriticality: new(Criticality),
+		}},
+	}
+	*cases[1].diag.ProcedureCode = procCodeDownlinkNASTransport
+	*cases[1].diag.TriggeringMessage = TriggeringMessageInitiatingMessage
+	*cases[1].diag.ProcedureCriticality = CriticalityIgnore
+	for _, c := range cases {
+		if _, err = Decode(c.pdu); err == nil {
+			t.Fatalf("expect error for %x", c.pdu)
+		}
+		b, err = MakeErrorIndicationForPDU(c.pdu)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v, err = DecodeErrorIndication(b); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v.Cause == nil || v.Cause.Protocol == nil ||
+			*v.Cause.Protocol != CauseProtocolTransferSyntaxError {
+			t.Errorf("unexpected cause: %+v", v.Cause)
+		}
+		if reflect.DeepEqual(v.CriticalityDiagnostics, c.diag) == false {
+			t.Errorf("expect: %+v, actual %+v", c.diag,
+				v.CriticalityDiagnostics)
+		}
+	}
+
+	if _, err = DecodeErrorIndication(b[:len(b)-1]); err == nil {
+		t.Errorf("expect error for truncated Error Indication")
+	}
+}